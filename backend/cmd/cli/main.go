@@ -0,0 +1,164 @@
+package main
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"log"
+	"log/slog"
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+
+	"github.com/CodeWarrior-debug/perspectize/backend/internal/adapters/repositories/postgres"
+	"github.com/CodeWarrior-debug/perspectize/backend/internal/adapters/youtube"
+	"github.com/CodeWarrior-debug/perspectize/backend/internal/config"
+	"github.com/CodeWarrior-debug/perspectize/backend/internal/core/domain"
+	"github.com/CodeWarrior-debug/perspectize/backend/internal/core/services"
+	"github.com/CodeWarrior-debug/perspectize/backend/pkg/database"
+	"github.com/joho/godotenv"
+)
+
+const usage = `Usage: perspectize-cli <command> [arguments]
+
+Commands:
+  import <file>   Import YouTube URLs as content, one URL per line.
+                  Blank lines and lines starting with # are ignored.
+                  Use - to read from stdin.
+`
+
+func main() {
+	if len(os.Args) < 2 {
+		fmt.Fprint(os.Stderr, usage)
+		os.Exit(2)
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
+
+	switch os.Args[1] {
+	case "import":
+		if len(os.Args) != 3 {
+			fmt.Fprint(os.Stderr, usage)
+			os.Exit(2)
+		}
+		os.Exit(runImport(ctx, os.Args[2]))
+	case "help", "-h", "--help":
+		fmt.Print(usage)
+	default:
+		fmt.Fprintf(os.Stderr, "unknown command %q\n\n%s", os.Args[1], usage)
+		os.Exit(2)
+	}
+}
+
+// runImport imports every URL in path and returns the process exit code:
+// 0 when all URLs were created or already existed, 1 otherwise
+func runImport(ctx context.Context, path string) int {
+	urls, err := readURLs(path)
+	if err != nil {
+		log.Fatalf("Failed to read URLs: %v", err)
+	}
+	if len(urls) == 0 {
+		fmt.Println("no URLs to import")
+		return 0
+	}
+
+	contentService := newContentService(ctx)
+
+	counts := make(map[domain.ContentImportStatus]int)
+	for start := 0; start < len(urls); start += services.MaxImportURLs {
+		batch := urls[start:min(start+services.MaxImportURLs, len(urls))]
+		results, err := contentService.ImportFromURLs(ctx, batch)
+		if err != nil {
+			log.Fatalf("Import failed: %v", err)
+		}
+		for _, r := range results {
+			counts[r.Status]++
+			printResult(r)
+		}
+	}
+
+	fmt.Printf("\n%d URL(s): %d created, %d existing, %d invalid, %d provider errors, %d failed\n",
+		len(urls),
+		counts[domain.ContentImportStatusCreated],
+		counts[domain.ContentImportStatusExists],
+		counts[domain.ContentImportStatusInvalidURL],
+		counts[domain.ContentImportStatusProviderError],
+		counts[domain.ContentImportStatusFailed],
+	)
+
+	if counts[domain.ContentImportStatusCreated]+counts[domain.ContentImportStatusExists] != len(urls) {
+		return 1
+	}
+	return 0
+}
+
+// printResult writes one tab-separated line per import result
+func printResult(r *domain.ContentImportResult) {
+	switch {
+	case r.Content != nil:
+		fmt.Printf("%-14s\t%s\tid=%d\n", r.Status, r.URL, r.Content.ID)
+	default:
+		fmt.Printf("%-14s\t%s\t%s\n", r.Status, r.URL, r.Error)
+	}
+}
+
+// readURLs reads non-blank, non-comment lines from path ("-" for stdin)
+func readURLs(path string) ([]string, error) {
+	var r io.Reader = os.Stdin
+	if path != "-" {
+		f, err := os.Open(path)
+		if err != nil {
+			return nil, err
+		}
+		defer f.Close()
+		r = f
+	}
+
+	var urls []string
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		urls = append(urls, line)
+	}
+	return urls, scanner.Err()
+}
+
+// newContentService wires a ContentService the same way the server does
+func newContentService(ctx context.Context) *services.ContentService {
+	if err := godotenv.Load(); err != nil && os.Getenv("APP_ENV") != "production" {
+		slog.Warn(".env file not found", "hint", "set APP_ENV=production to suppress")
+	}
+
+	configPath := os.Getenv("CONFIG_PATH")
+	if configPath == "" {
+		configPath = "config/config.example.json"
+	}
+	cfg, err := config.Load(configPath)
+	if err != nil {
+		log.Fatalf("Failed to load config: %v", err)
+	}
+	if cfg.YouTube.APIKey == "" {
+		log.Fatal("YOUTUBE_API_KEY is required to import content")
+	}
+
+	dsn := cfg.Database.GetDSN()
+	db, err := database.ConnectGORM(dsn, database.PoolConfigFromEnv())
+	if err != nil {
+		log.Fatalf("Failed to connect to database %s: %v", config.SanitizeDSN(dsn), err)
+	}
+	if err := database.PingGORM(ctx, db); err != nil {
+		log.Fatalf("Database ping failed for %s: %v", config.SanitizeDSN(dsn), err)
+	}
+
+	yt, err := youtube.NewStack(cfg.YouTube, postgres.NewGormQuotaUsageRepository(db), postgres.NewGormYouTubeCacheRepository(db))
+	if err != nil {
+		log.Fatalf("Invalid YouTube config: %v", err)
+	}
+	return services.NewContentService(postgres.NewGormContentRepository(db), yt.Videos)
+}
@@ -14,6 +14,7 @@ import (
 	"github.com/99designs/gqlgen/graphql/handler"
 	"github.com/99designs/gqlgen/graphql/playground"
 	"github.com/CodeWarrior-debug/perspectize/backend/internal/adapters/graphql/generated"
+	"github.com/CodeWarrior-debug/perspectize/backend/internal/adapters/graphql/idempotency"
 	"github.com/CodeWarrior-debug/perspectize/backend/internal/adapters/graphql/resolvers"
 	"github.com/CodeWarrior-debug/perspectize/backend/internal/adapters/repositories/postgres"
 	"github.com/CodeWarrior-debug/perspectize/backend/internal/adapters/youtube"
@@ -28,6 +29,11 @@ import (
 )
 
 func main() {
+	// Root context canceled on SIGINT/SIGTERM; background workers and
+	// graceful shutdown both key off it
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
+
 	// Load .env file
 	if err := godotenv.Load(); err != nil {
 		if os.Getenv("APP_ENV") != "production" {
@@ -74,7 +80,7 @@ func main() {
 	database.RegisterSlowQueryLogger(db)
 
 	// Test connection
-	if err := database.PingGORM(context.Background(), db); err != nil {
+	if err := database.PingGORM(ctx, db); err != nil {
 		log.Fatalf("Database ping failed for %s: %v", config.SanitizeDSN(dsn), err)
 	}
 
@@ -93,20 +99,54 @@ func main() {
 	}
 
 	// Initialize adapters
-	youtubeClient := youtube.NewClient(cfg.YouTube.APIKey)
-	contentRepo := postgres.NewGormContentRepository(db)
-	userRepo := postgres.NewGormUserRepository(db)
-	perspectiveRepo := postgres.NewGormPerspectiveRepository(db)
+	yt, err := youtube.NewStack(cfg.YouTube, postgres.NewGormQuotaUsageRepository(db), postgres.NewGormYouTubeCacheRepository(db))
+	if err != nil {
+		log.Fatalf("Invalid YouTube config: %v", err)
+	}
+	searchOpt := postgres.WithSimilarityThreshold(cfg.Search.SimilarityThreshold)
+	countCacheOpt := postgres.WithCountCacheTTL(time.Duration(cfg.Pagination.CountCacheTTLSeconds) * time.Second)
+	cursorKeys := make([][]byte, 0, len(cfg.Pagination.CursorSecrets))
+	for _, secret := range cfg.Pagination.CursorSecrets {
+		cursorKeys = append(cursorKeys, []byte(secret))
+	}
+	if len(cursorKeys) == 0 {
+		// A per-process key rejects cursors after a restart or on another
+		// instance, which only development can live with
+		if appEnv := os.Getenv("APP_ENV"); appEnv != "" && appEnv != "development" {
+			log.Fatalf("CURSOR_SECRETS is required when APP_ENV=%s", appEnv)
+		}
+		slog.Warn("CURSOR_SECRETS is empty — pagination cursors will not survive a restart or work across instances")
+	}
+	cursorOpt := postgres.WithCursorKeys(cursorKeys...)
+	contentRepo := postgres.NewGormContentRepository(db, searchOpt, countCacheOpt, cursorOpt)
+	userRepo := postgres.NewGormUserRepository(db, searchOpt)
+	perspectiveRepo := postgres.NewGormPerspectiveRepository(db, countCacheOpt, cursorOpt)
+	idempotencyRepo := postgres.NewGormIdempotencyRepository(db)
 
 	// Initialize services
-	contentService := services.NewContentService(contentRepo, youtubeClient)
+	contentService := services.NewContentService(contentRepo, yt.Videos)
 	userService := services.NewUserService(userRepo, contentRepo, perspectiveRepo)
-	perspectiveService := services.NewPerspectiveService(perspectiveRepo, userRepo)
+	perspectiveService := services.NewPerspectiveService(perspectiveRepo, userRepo, contentRepo)
+	transcriptService := services.NewTranscriptService(postgres.NewGormTranscriptRepository(db), contentRepo, yt.Videos, yt.API)
+	searchService := services.NewSearchService(postgres.NewGormSearchRepository(db, searchOpt))
+
+	// Start background YouTube metadata refresher
+	if cfg.YouTube.Refresh.Enabled {
+		refresher := services.NewContentRefresher(contentRepo, yt.Videos, services.RefresherConfig{
+			Interval:          time.Duration(cfg.YouTube.Refresh.IntervalMinutes) * time.Minute,
+			MaxAge:            time.Duration(cfg.YouTube.Refresh.MaxAgeHours) * time.Hour,
+			BatchSize:         cfg.YouTube.Refresh.BatchSize,
+			RequestsPerSecond: cfg.YouTube.Refresh.RequestsPerSecond,
+		})
+		go refresher.Run(ctx)
+		slog.Info("content refresher started")
+	}
 
 	// Initialize GraphQL
-	resolver := resolvers.NewResolver(contentService, userService, perspectiveService)
+	resolver := resolvers.NewResolver(contentService, userService, perspectiveService, yt.Quota, transcriptService, searchService)
 	srv := handler.NewDefaultServer(generated.NewExecutableSchema(generated.Config{Resolvers: resolver}))
 	srv.AroundOperations(gqltiming.OperationTimer())
+	srv.AroundOperations(idempotency.Middleware(idempotencyRepo, 24*time.Hour, 5*time.Minute))
 
 	// Setup chi router
 	r := chi.NewRouter()
@@ -122,7 +162,7 @@ func main() {
 		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 			w.Header().Set("Access-Control-Allow-Origin", "*")
 			w.Header().Set("Access-Control-Allow-Methods", "POST, GET, OPTIONS")
-			w.Header().Set("Access-Control-Allow-Headers", "Content-Type")
+			w.Header().Set("Access-Control-Allow-Headers", "Content-Type, "+idempotency.KeyHeader)
 			if r.Method == http.MethodOptions {
 				w.WriteHeader(http.StatusOK)
 				return
@@ -168,13 +208,11 @@ func main() {
 
 	// Graceful shutdown
 	go func() {
-		sigChan := make(chan os.Signal, 1)
-		signal.Notify(sigChan, syscall.SIGINT, syscall.SIGTERM)
-		<-sigChan
+		<-ctx.Done()
 		slog.Info("shutting down gracefully")
-		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
 		defer cancel()
-		if err := server.Shutdown(ctx); err != nil {
+		if err := server.Shutdown(shutdownCtx); err != nil {
 			slog.Error("shutdown failed", "error", err)
 		}
 	}()
@@ -0,0 +1,103 @@
+package idempotency
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"log/slog"
+	"time"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/CodeWarrior-debug/perspectize/backend/internal/core/ports/repositories"
+	"github.com/vektah/gqlparser/v2/ast"
+)
+
+const (
+	// KeyHeader is the request header clients set to make a mutation safe to retry
+	KeyHeader = "Idempotency-Key"
+
+	// maxIdempotencyKeyLength bounds the header value stored per request
+	maxIdempotencyKeyLength = 255
+)
+
+// Middleware returns a gqlgen OperationMiddleware that honors the
+// Idempotency-Key header on mutations. The first request with a key runs
+// normally and its successful response is stored; retries with the same key
+// and the same query and variables replay that response without running the
+// mutation again. Responses with errors are not stored, so the request can be
+// retried. Stored responses expire after ttl. A key whose request has not
+// finished within lease, e.g. because the server crashed, is free to reuse.
+func Middleware(repo repositories.IdempotencyRepository, ttl, lease time.Duration) graphql.OperationMiddleware {
+	return func(ctx context.Context, next graphql.OperationHandler) graphql.ResponseHandler {
+		oc := graphql.GetOperationContext(ctx)
+		if oc == nil || oc.Operation == nil || oc.Operation.Operation != ast.Mutation {
+			return next(ctx)
+		}
+		key := oc.Headers.Get(KeyHeader)
+		if key == "" {
+			return next(ctx)
+		}
+		if len(key) > maxIdempotencyKeyLength {
+			return graphql.OneShot(graphql.ErrorResponse(ctx, "idempotency key must be at most %d characters", maxIdempotencyKeyLength))
+		}
+
+		hash := requestHash(oc)
+		now := time.Now()
+		existing, reserved, err := repo.Reserve(ctx, key, hash, now.Add(-ttl), now.Add(-lease))
+		if err != nil {
+			slog.ErrorContext(ctx, "reserving idempotency key failed", "error", err)
+			return graphql.OneShot(graphql.ErrorResponse(ctx, "failed to check idempotency key"))
+		}
+		if !reserved {
+			switch {
+			case existing.RequestHash != hash:
+				return graphql.OneShot(graphql.ErrorResponse(ctx, "idempotency key was already used for a different request"))
+			case existing.Response == nil:
+				return graphql.OneShot(graphql.ErrorResponse(ctx, "a request with this idempotency key is still in progress"))
+			}
+			var resp graphql.Response
+			if err := json.Unmarshal(existing.Response, &resp); err != nil {
+				slog.ErrorContext(ctx, "decoding stored idempotent response failed", "error", err)
+				return graphql.OneShot(graphql.ErrorResponse(ctx, "failed to replay idempotent response"))
+			}
+			return graphql.OneShot(&resp)
+		}
+
+		rh := next(ctx)
+		return func(ctx context.Context) *graphql.Response {
+			resp := rh(ctx)
+			// Store even if the client has gone away, so its retry is answered
+			storeCtx := context.WithoutCancel(ctx)
+			if resp == nil || len(resp.Errors) > 0 {
+				if err := repo.Release(storeCtx, key); err != nil {
+					slog.ErrorContext(ctx, "releasing idempotency key failed", "error", err)
+				}
+				return resp
+			}
+
+			body, err := json.Marshal(resp)
+			if err == nil {
+				err = repo.Complete(storeCtx, key, body)
+			}
+			if err != nil {
+				slog.ErrorContext(ctx, "storing idempotent response failed", "error", err)
+			}
+			return resp
+		}
+	}
+}
+
+// requestHash fingerprints an operation so a key reused for a different
+// request can be rejected. json.Marshal sorts map keys, so equal variables
+// hash equally.
+func requestHash(oc *graphql.OperationContext) string {
+	variables, _ := json.Marshal(oc.Variables)
+	h := sha256.New()
+	h.Write([]byte(oc.OperationName))
+	h.Write([]byte{0})
+	h.Write([]byte(oc.RawQuery))
+	h.Write([]byte{0})
+	h.Write(variables)
+	return hex.EncodeToString(h.Sum(nil))
+}
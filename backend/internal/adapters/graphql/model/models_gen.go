@@ -11,57 +11,123 @@ type CategorizedRating struct {
 	Rating   int    `json:"rating"`
 }
 
+type CategorizedRatingFilter struct {
+	Category  string `json:"category"`
+	MinRating *int   `json:"minRating,omitempty"`
+}
+
 type CategorizedRatingInput struct {
 	Category string `json:"category"`
 	Rating   int    `json:"rating"`
 }
 
 type Content struct {
-	ID            string         `json:"id"`
-	Name          string         `json:"name"`
-	URL           *string        `json:"url,omitempty"`
-	ContentType   string         `json:"contentType"`
-	AddedByUserID string         `json:"addedByUserID"`
-	AddedBy       *User          `json:"addedBy,omitempty"`
-	Length        *int           `json:"length,omitempty"`
-	LengthUnits   *string        `json:"lengthUnits,omitempty"`
-	ViewCount     *int           `json:"viewCount,omitempty"`
-	LikeCount     *int           `json:"likeCount,omitempty"`
-	CommentCount  *int           `json:"commentCount,omitempty"`
-	ChannelTitle  *string        `json:"channelTitle,omitempty"`
-	PublishedAt   *string        `json:"publishedAt,omitempty"`
-	Tags          []string       `json:"tags,omitempty"`
-	Description   *string        `json:"description,omitempty"`
-	Response      map[string]any `json:"response,omitempty"`
-	CreatedAt     string         `json:"createdAt"`
-	UpdatedAt     string         `json:"updatedAt"`
+	ID            string               `json:"id"`
+	Name          string               `json:"name"`
+	URL           *string              `json:"url,omitempty"`
+	ContentType   string               `json:"contentType"`
+	AddedByUserID string               `json:"addedByUserID"`
+	AddedBy       *User                `json:"addedBy,omitempty"`
+	Length        *int                 `json:"length,omitempty"`
+	LengthUnits   *string              `json:"lengthUnits,omitempty"`
+	ViewCount     *int                 `json:"viewCount,omitempty"`
+	LikeCount     *int                 `json:"likeCount,omitempty"`
+	CommentCount  *int                 `json:"commentCount,omitempty"`
+	ChannelTitle  *string              `json:"channelTitle,omitempty"`
+	PublishedAt   *string              `json:"publishedAt,omitempty"`
+	Tags          []string             `json:"tags,omitempty"`
+	Description   *string              `json:"description,omitempty"`
+	Response      map[string]any       `json:"response,omitempty"`
+	UnavailableAt *string              `json:"unavailableAt,omitempty"`
+	CreatedAt     string               `json:"createdAt"`
+	UpdatedAt     string               `json:"updatedAt"`
+	StatsHistory  []*ContentStatsPoint `json:"statsHistory"`
+	Transcript    []*TranscriptSegment `json:"transcript"`
+	SearchMatch   *ContentSearchMatch  `json:"searchMatch,omitempty"`
+}
+
+type ContentFacetCounts struct {
+	Facet  domain.ContentFacet `json:"facet"`
+	Values []*FacetValue       `json:"values"`
 }
 
 type ContentFilter struct {
-	ContentType      *domain.ContentType `json:"contentType,omitempty"`
-	MinLengthSeconds *int                `json:"minLengthSeconds,omitempty"`
-	MaxLengthSeconds *int                `json:"maxLengthSeconds,omitempty"`
-	Search           *string             `json:"search,omitempty"`
+	ContentType                   *domain.ContentType `json:"contentType,omitempty"`
+	MinLengthSeconds              *int                `json:"minLengthSeconds,omitempty"`
+	MaxLengthSeconds              *int                `json:"maxLengthSeconds,omitempty"`
+	Search                        *string             `json:"search,omitempty"`
+	SearchMode                    *domain.SearchMode  `json:"searchMode,omitempty"`
+	PublishedAt                   *DateRange          `json:"publishedAt,omitempty"`
+	CreatedAt                     *DateRange          `json:"createdAt,omitempty"`
+	ChannelTitle                  *string             `json:"channelTitle,omitempty"`
+	ChannelTitles                 []string            `json:"channelTitles,omitempty"`
+	TagsAny                       []string            `json:"tagsAny,omitempty"`
+	TagsAll                       []string            `json:"tagsAll,omitempty"`
+	AddedByUserID                 *int                `json:"addedByUserID,omitempty"`
+	MinViewCount                  *int                `json:"minViewCount,omitempty"`
+	MinPerspectives               *int                `json:"minPerspectives,omitempty"`
+	WithoutPerspectivesFromUserID *int                `json:"withoutPerspectivesFromUserID,omitempty"`
+}
+
+type ContentImportResult struct {
+	URL     string                     `json:"url"`
+	Status  domain.ContentImportStatus `json:"status"`
+	Content *Content                   `json:"content,omitempty"`
+	Error   *string                    `json:"error,omitempty"`
+}
+
+type ContentPage struct {
+	Items           []*Content `json:"items"`
+	Page            int        `json:"page"`
+	PageSize        int        `json:"pageSize"`
+	TotalCount      int        `json:"totalCount"`
+	TotalPages      int        `json:"totalPages"`
+	TotalCountExact bool       `json:"totalCountExact"`
+	HasNextPage     bool       `json:"hasNextPage"`
+	HasPreviousPage bool       `json:"hasPreviousPage"`
+	StartCursor     *string    `json:"startCursor,omitempty"`
+	EndCursor       *string    `json:"endCursor,omitempty"`
+}
+
+type ContentSearchMatch struct {
+	Rank        float64 `json:"rank"`
+	Name        string  `json:"name"`
+	Description *string `json:"description,omitempty"`
+}
+
+type ContentStatsPoint struct {
+	BucketStart  string `json:"bucketStart"`
+	ViewCount    *int   `json:"viewCount,omitempty"`
+	LikeCount    *int   `json:"likeCount,omitempty"`
+	CommentCount *int   `json:"commentCount,omitempty"`
+	Samples      int    `json:"samples"`
 }
 
 type CreateContentFromYouTubeInput struct {
-	URL string `json:"url"`
+	URL      string                 `json:"url"`
+	IfExists *domain.IfExistsPolicy `json:"ifExists,omitempty"`
+}
+
+type CreateContentPayload struct {
+	Content *Content `json:"content"`
+	Created bool     `json:"created"`
 }
 
 type CreatePerspectiveInput struct {
-	UserID             int                       `json:"userID"`
-	ContentID          *int                      `json:"contentID,omitempty"`
-	Quality            *int                      `json:"quality,omitempty"`
-	Agreement          *int                      `json:"agreement,omitempty"`
-	Importance         *int                      `json:"importance,omitempty"`
-	Confidence         *int                      `json:"confidence,omitempty"`
-	Like               *string                   `json:"like,omitempty"`
-	Privacy            *domain.Privacy           `json:"privacy,omitempty"`
-	Description        *string                   `json:"description,omitempty"`
-	Category           *string                   `json:"category,omitempty"`
-	Parts              []int                     `json:"parts,omitempty"`
-	Labels             []string                  `json:"labels,omitempty"`
-	CategorizedRatings []*CategorizedRatingInput `json:"categorizedRatings,omitempty"`
+	UserID             int                        `json:"userID"`
+	ContentID          *int                       `json:"contentID,omitempty"`
+	Quality            *int                       `json:"quality,omitempty"`
+	Agreement          *int                       `json:"agreement,omitempty"`
+	Importance         *int                       `json:"importance,omitempty"`
+	Confidence         *int                       `json:"confidence,omitempty"`
+	Like               *string                    `json:"like,omitempty"`
+	Privacy            *domain.Privacy            `json:"privacy,omitempty"`
+	Description        *string                    `json:"description,omitempty"`
+	Category           *string                    `json:"category,omitempty"`
+	Parts              []int                      `json:"parts,omitempty"`
+	Segments           []*PerspectiveSegmentInput `json:"segments,omitempty"`
+	Labels             []string                   `json:"labels,omitempty"`
+	CategorizedRatings []*CategorizedRatingInput  `json:"categorizedRatings,omitempty"`
 }
 
 type CreateUserInput struct {
@@ -69,6 +135,21 @@ type CreateUserInput struct {
 	Email    *string `json:"email,omitempty"`
 }
 
+type DateRange struct {
+	From *string `json:"from,omitempty"`
+	To   *string `json:"to,omitempty"`
+}
+
+type FacetValue struct {
+	Value string `json:"value"`
+	Count int    `json:"count"`
+}
+
+type IntRange struct {
+	Min *int `json:"min,omitempty"`
+	Max *int `json:"max,omitempty"`
+}
+
 type Mutation struct {
 }
 
@@ -77,6 +158,7 @@ type PageInfo struct {
 	HasPreviousPage bool    `json:"hasPreviousPage"`
 	StartCursor     *string `json:"startCursor,omitempty"`
 	EndCursor       *string `json:"endCursor,omitempty"`
+	TotalCountExact *bool   `json:"totalCountExact,omitempty"`
 }
 
 type PaginatedContent struct {
@@ -92,51 +174,138 @@ type PaginatedPerspectives struct {
 }
 
 type Perspective struct {
-	ID                 string               `json:"id"`
-	UserID             string               `json:"userID"`
-	User               *User                `json:"user,omitempty"`
-	ContentID          *string              `json:"contentID,omitempty"`
-	Content            *Content             `json:"content,omitempty"`
-	Quality            *int                 `json:"quality,omitempty"`
-	Agreement          *int                 `json:"agreement,omitempty"`
-	Importance         *int                 `json:"importance,omitempty"`
-	Confidence         *int                 `json:"confidence,omitempty"`
-	Like               *string              `json:"like,omitempty"`
-	Privacy            domain.Privacy       `json:"privacy"`
-	Description        *string              `json:"description,omitempty"`
-	Category           *string              `json:"category,omitempty"`
-	ReviewStatus       *domain.ReviewStatus `json:"reviewStatus,omitempty"`
-	Parts              []int                `json:"parts,omitempty"`
-	Labels             []string             `json:"labels,omitempty"`
-	CategorizedRatings []*CategorizedRating `json:"categorizedRatings,omitempty"`
-	CreatedAt          string               `json:"createdAt"`
-	UpdatedAt          string               `json:"updatedAt"`
+	ID                 string                  `json:"id"`
+	UserID             string                  `json:"userID"`
+	User               *User                   `json:"user,omitempty"`
+	ContentID          *string                 `json:"contentID,omitempty"`
+	Content            *Content                `json:"content,omitempty"`
+	Quality            *int                    `json:"quality,omitempty"`
+	Agreement          *int                    `json:"agreement,omitempty"`
+	Importance         *int                    `json:"importance,omitempty"`
+	Confidence         *int                    `json:"confidence,omitempty"`
+	Like               *string                 `json:"like,omitempty"`
+	Privacy            domain.Privacy          `json:"privacy"`
+	Description        *string                 `json:"description,omitempty"`
+	Category           *string                 `json:"category,omitempty"`
+	ReviewStatus       *domain.ReviewStatus    `json:"reviewStatus,omitempty"`
+	Parts              []int                   `json:"parts,omitempty"`
+	Segments           []*PerspectiveSegment   `json:"segments"`
+	Labels             []string                `json:"labels,omitempty"`
+	CategorizedRatings []*CategorizedRating    `json:"categorizedRatings,omitempty"`
+	CreatedAt          string                  `json:"createdAt"`
+	UpdatedAt          string                  `json:"updatedAt"`
+	SearchMatch        *PerspectiveSearchMatch `json:"searchMatch,omitempty"`
 }
 
 type PerspectiveFilter struct {
-	UserID    *int            `json:"userID,omitempty"`
-	ContentID *int            `json:"contentID,omitempty"`
-	Privacy   *domain.Privacy `json:"privacy,omitempty"`
+	UserID            *int                     `json:"userID,omitempty"`
+	ContentID         *int                     `json:"contentID,omitempty"`
+	Privacy           *domain.Privacy          `json:"privacy,omitempty"`
+	Search            *string                  `json:"search,omitempty"`
+	Quality           *IntRange                `json:"quality,omitempty"`
+	Agreement         *IntRange                `json:"agreement,omitempty"`
+	Importance        *IntRange                `json:"importance,omitempty"`
+	Confidence        *IntRange                `json:"confidence,omitempty"`
+	LabelsAny         []string                 `json:"labelsAny,omitempty"`
+	LabelsAll         []string                 `json:"labelsAll,omitempty"`
+	Category          *string                  `json:"category,omitempty"`
+	ReviewStatus      *domain.ReviewStatus     `json:"reviewStatus,omitempty"`
+	CategorizedRating *CategorizedRatingFilter `json:"categorizedRating,omitempty"`
+	CreatedAt         *DateRange               `json:"createdAt,omitempty"`
+	UpdatedAt         *DateRange               `json:"updatedAt,omitempty"`
+}
+
+type PerspectiveSearchMatch struct {
+	Rank        float64 `json:"rank"`
+	Description *string `json:"description,omitempty"`
+	Like        *string `json:"like,omitempty"`
+}
+
+type PerspectiveSegment struct {
+	Start      float64 `json:"start"`
+	End        float64 `json:"end"`
+	Note       *string `json:"note,omitempty"`
+	Quality    *int    `json:"quality,omitempty"`
+	Agreement  *int    `json:"agreement,omitempty"`
+	Importance *int    `json:"importance,omitempty"`
+	Confidence *int    `json:"confidence,omitempty"`
+}
+
+type PerspectiveSegmentInput struct {
+	Start      float64 `json:"start"`
+	End        float64 `json:"end"`
+	Note       *string `json:"note,omitempty"`
+	Quality    *int    `json:"quality,omitempty"`
+	Agreement  *int    `json:"agreement,omitempty"`
+	Importance *int    `json:"importance,omitempty"`
+	Confidence *int    `json:"confidence,omitempty"`
+}
+
+type PlaylistImportSummary struct {
+	Total   int `json:"total"`
+	Created int `json:"created"`
+	Skipped int `json:"skipped"`
+	Failed  int `json:"failed"`
 }
 
 type Query struct {
 }
 
+type QuotaMethodUsage struct {
+	Method string `json:"method"`
+	Units  int    `json:"units"`
+}
+
+type SearchSuggestion struct {
+	Text       string                      `json:"text"`
+	Kind       domain.SearchSuggestionKind `json:"kind"`
+	Similarity float64                     `json:"similarity"`
+}
+
+type Transcript struct {
+	ContentID    string                  `json:"contentID"`
+	Language     string                  `json:"language"`
+	Source       domain.TranscriptSource `json:"source"`
+	SegmentCount int                     `json:"segmentCount"`
+	CreatedAt    string                  `json:"createdAt"`
+	UpdatedAt    string                  `json:"updatedAt"`
+}
+
+type TranscriptSearchResult struct {
+	Segment  *TranscriptSegment `json:"segment"`
+	Headline string             `json:"headline"`
+	Rank     float64            `json:"rank"`
+}
+
+type TranscriptSegment struct {
+	ContentID string  `json:"contentID"`
+	Start     float64 `json:"start"`
+	End       float64 `json:"end"`
+	Text      string  `json:"text"`
+}
+
+type UpdateContentInput struct {
+	ID   int     `json:"id"`
+	Name *string `json:"name,omitempty"`
+	URL  *string `json:"url,omitempty"`
+}
+
 type UpdatePerspectiveInput struct {
-	ID                 int                       `json:"id"`
-	ContentID          *int                      `json:"contentID,omitempty"`
-	Quality            *int                      `json:"quality,omitempty"`
-	Agreement          *int                      `json:"agreement,omitempty"`
-	Importance         *int                      `json:"importance,omitempty"`
-	Confidence         *int                      `json:"confidence,omitempty"`
-	Like               *string                   `json:"like,omitempty"`
-	Privacy            *domain.Privacy           `json:"privacy,omitempty"`
-	Description        *string                   `json:"description,omitempty"`
-	Category           *string                   `json:"category,omitempty"`
-	ReviewStatus       *domain.ReviewStatus      `json:"reviewStatus,omitempty"`
-	Parts              []int                     `json:"parts,omitempty"`
-	Labels             []string                  `json:"labels,omitempty"`
-	CategorizedRatings []*CategorizedRatingInput `json:"categorizedRatings,omitempty"`
+	ID                 int                        `json:"id"`
+	ContentID          *int                       `json:"contentID,omitempty"`
+	Quality            *int                       `json:"quality,omitempty"`
+	Agreement          *int                       `json:"agreement,omitempty"`
+	Importance         *int                       `json:"importance,omitempty"`
+	Confidence         *int                       `json:"confidence,omitempty"`
+	Like               *string                    `json:"like,omitempty"`
+	Privacy            *domain.Privacy            `json:"privacy,omitempty"`
+	Description        *string                    `json:"description,omitempty"`
+	Category           *string                    `json:"category,omitempty"`
+	ReviewStatus       *domain.ReviewStatus       `json:"reviewStatus,omitempty"`
+	Parts              []int                      `json:"parts,omitempty"`
+	Segments           []*PerspectiveSegmentInput `json:"segments,omitempty"`
+	Labels             []string                   `json:"labels,omitempty"`
+	CategorizedRatings []*CategorizedRatingInput  `json:"categorizedRatings,omitempty"`
 }
 
 type UpdateUserInput struct {
@@ -145,6 +314,13 @@ type UpdateUserInput struct {
 	Email    *string `json:"email,omitempty"`
 }
 
+type UploadTranscriptInput struct {
+	ContentID int                  `json:"contentID"`
+	Language  *string              `json:"language,omitempty"`
+	Format    domain.CaptionFormat `json:"format"`
+	Data      string               `json:"data"`
+}
+
 type User struct {
 	ID        string `json:"id"`
 	Username  string `json:"username"`
@@ -153,3 +329,14 @@ type User struct {
 	CreatedAt string `json:"createdAt"`
 	UpdatedAt string `json:"updatedAt"`
 }
+
+type YouTubeQuotaUsage struct {
+	Day              string              `json:"day"`
+	ResetsAt         string              `json:"resetsAt"`
+	Budget           *int                `json:"budget,omitempty"`
+	Used             int                 `json:"used"`
+	Remaining        *int                `json:"remaining,omitempty"`
+	ByMethod         []*QuotaMethodUsage `json:"byMethod"`
+	Breaker          domain.CircuitState `json:"breaker"`
+	BreakerOpenUntil *string             `json:"breakerOpenUntil,omitempty"`
+}
@@ -2,8 +2,11 @@ package resolvers
 
 import (
 	"encoding/json"
+	"fmt"
 	"log/slog"
+	"math"
 	"strconv"
+	"time"
 
 	"github.com/CodeWarrior-debug/perspectize/backend/internal/adapters/graphql/model"
 	"github.com/CodeWarrior-debug/perspectize/backend/internal/core/domain"
@@ -31,10 +34,34 @@ func domainToModel(c *domain.Content) *model.Content {
 		AddedByUserID: strconv.Itoa(c.AddedByUserID),
 		Length:        c.Length,
 		LengthUnits:   c.LengthUnits,
+		ViewCount:     int64PtrToIntPtr(c.ViewCount),
+		LikeCount:     int64PtrToIntPtr(c.LikeCount),
+		CommentCount:  int64PtrToIntPtr(c.CommentCount),
+		ChannelTitle:  c.ChannelTitle,
+		Description:   c.Description,
 		CreatedAt:     c.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
 		UpdatedAt:     c.UpdatedAt.Format("2006-01-02T15:04:05Z07:00"),
 	}
 
+	if len(c.Tags) > 0 {
+		m.Tags = c.Tags
+	}
+	if c.PublishedAt != nil {
+		publishedAt := c.PublishedAt.UTC().Format(time.RFC3339)
+		m.PublishedAt = &publishedAt
+	}
+	if c.UnavailableAt != nil {
+		unavailableAt := c.UnavailableAt.UTC().Format(time.RFC3339)
+		m.UnavailableAt = &unavailableAt
+	}
+	if c.SearchMatch != nil {
+		m.SearchMatch = &model.ContentSearchMatch{
+			Rank:        c.SearchMatch.Rank,
+			Name:        c.SearchMatch.NameHighlight,
+			Description: c.SearchMatch.DescriptionHighlight,
+		}
+	}
+
 	// Parse the raw response JSON into a map for GraphQL
 	if len(c.Response) > 0 {
 		var responseMap map[string]interface{}
@@ -43,68 +70,11 @@ func domainToModel(c *domain.Content) *model.Content {
 		} else {
 			m.Response = responseMap
 		}
-
-		// Extract fields from the YouTube API response
-		var resp struct {
-			Items []struct {
-				Snippet struct {
-					ChannelTitle string   `json:"channelTitle"`
-					PublishedAt  string   `json:"publishedAt"`
-					Tags         []string `json:"tags"`
-					Description  string   `json:"description"`
-				} `json:"snippet"`
-				Statistics struct {
-					ViewCount    string `json:"viewCount"`
-					LikeCount    string `json:"likeCount"`
-					CommentCount string `json:"commentCount"`
-				} `json:"statistics"`
-			} `json:"items"`
-		}
-		if err := json.Unmarshal(c.Response, &resp); err != nil {
-			slog.Warn("failed to parse YouTube response JSON", "contentID", c.ID, "error", err)
-		} else if len(resp.Items) > 0 {
-			item := resp.Items[0]
-
-			// Extract snippet fields
-			if item.Snippet.ChannelTitle != "" {
-				m.ChannelTitle = &item.Snippet.ChannelTitle
-			}
-			if item.Snippet.PublishedAt != "" {
-				m.PublishedAt = &item.Snippet.PublishedAt
-			}
-			if len(item.Snippet.Tags) > 0 {
-				m.Tags = item.Snippet.Tags
-			}
-			if item.Snippet.Description != "" {
-				m.Description = &item.Snippet.Description
-			}
-
-			// Extract statistics — empty strings from YouTube API default to 0
-			stats := item.Statistics
-			m.ViewCount = parseStatCount(stats.ViewCount, "viewCount", c.ID)
-			m.LikeCount = parseStatCount(stats.LikeCount, "likeCount", c.ID)
-			m.CommentCount = parseStatCount(stats.CommentCount, "commentCount", c.ID)
-		}
 	}
 
 	return m
 }
 
-// parseStatCount parses a YouTube statistics string to *int.
-// Returns pointer to 0 for empty strings, nil for non-numeric values.
-func parseStatCount(value, field string, contentID int) *int {
-	if value == "" {
-		zero := 0
-		return &zero
-	}
-	v, err := strconv.Atoi(value)
-	if err != nil {
-		slog.Warn("failed to parse "+field, "value", value, "contentID", contentID, "error", err)
-		return nil
-	}
-	return &v
-}
-
 // perspectiveDomainToModel converts a domain Perspective to a GraphQL model Perspective
 func perspectiveDomainToModel(p *domain.Perspective) *model.Perspective {
 	m := &model.Perspective{
@@ -119,6 +89,7 @@ func perspectiveDomainToModel(p *domain.Perspective) *model.Perspective {
 		Description:  p.Description,
 		Category:     p.Category,
 		Parts:        p.Parts,
+		Segments:     make([]*model.PerspectiveSegment, len(p.Segments)),
 		Labels:       p.Labels,
 		ReviewStatus: p.ReviewStatus,
 		CreatedAt:    p.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
@@ -130,6 +101,26 @@ func perspectiveDomainToModel(p *domain.Perspective) *model.Perspective {
 		m.ContentID = &contentID
 	}
 
+	if p.SearchMatch != nil {
+		m.SearchMatch = &model.PerspectiveSearchMatch{
+			Rank:        p.SearchMatch.Rank,
+			Description: p.SearchMatch.DescriptionHighlight,
+			Like:        p.SearchMatch.LikeHighlight,
+		}
+	}
+
+	for i, s := range p.Segments {
+		m.Segments[i] = &model.PerspectiveSegment{
+			Start:      float64(s.StartMs) / 1000,
+			End:        float64(s.EndMs) / 1000,
+			Note:       s.Note,
+			Quality:    s.Quality,
+			Agreement:  s.Agreement,
+			Importance: s.Importance,
+			Confidence: s.Confidence,
+		}
+	}
+
 	// Convert categorized ratings
 	if len(p.CategorizedRatings) > 0 {
 		m.CategorizedRatings = make([]*model.CategorizedRating, len(p.CategorizedRatings))
@@ -143,3 +134,228 @@ func perspectiveDomainToModel(p *domain.Perspective) *model.Perspective {
 
 	return m
 }
+
+// statsBucketToModel converts a domain ContentStatsBucket to a GraphQL model ContentStatsPoint
+func statsBucketToModel(b *domain.ContentStatsBucket) *model.ContentStatsPoint {
+	return &model.ContentStatsPoint{
+		BucketStart:  b.BucketStart.Format("2006-01-02T15:04:05Z07:00"),
+		ViewCount:    int64PtrToIntPtr(b.ViewCount),
+		LikeCount:    int64PtrToIntPtr(b.LikeCount),
+		CommentCount: int64PtrToIntPtr(b.CommentCount),
+		Samples:      b.Samples,
+	}
+}
+
+// totalCountExact reports a listing's count exactness for PageInfo; nil
+// when no count was requested
+func totalCountExact(count *int, exact bool) *bool {
+	if count == nil {
+		return nil
+	}
+	return &exact
+}
+
+// int64PtrToIntPtr converts an optional int64 to the int used by GraphQL Int fields
+func int64PtrToIntPtr(v *int64) *int {
+	if v == nil {
+		return nil
+	}
+	n := int(*v)
+	return &n
+}
+
+// parseDateArg parses an optional RFC 3339 timestamp or YYYY-MM-DD date (UTC)
+func parseDateArg(s *string) (*time.Time, error) {
+	if s == nil {
+		return nil, nil
+	}
+	if t, err := time.Parse(time.RFC3339, *s); err == nil {
+		return &t, nil
+	}
+	t, err := time.Parse("2006-01-02", *s)
+	if err != nil {
+		return nil, err
+	}
+	return &t, nil
+}
+
+// intRangeToDomain converts an optional GraphQL IntRange to a domain IntRange
+func intRangeToDomain(r *model.IntRange) *domain.IntRange {
+	if r == nil {
+		return nil
+	}
+	return &domain.IntRange{Min: r.Min, Max: r.Max}
+}
+
+// dateRangeToDomain parses an optional GraphQL DateRange; see parseDateArg
+func dateRangeToDomain(r *model.DateRange) (*domain.TimeRange, error) {
+	if r == nil {
+		return nil, nil
+	}
+	from, err := parseDateArg(r.From)
+	if err != nil {
+		return nil, fmt.Errorf("invalid from date: %s", *r.From)
+	}
+	to, err := parseDateArg(r.To)
+	if err != nil {
+		return nil, fmt.Errorf("invalid to date: %s", *r.To)
+	}
+	return &domain.TimeRange{From: from, To: to}, nil
+}
+
+// contentFilterToDomain converts an optional GraphQL ContentFilter;
+// channelTitle joins channelTitles as one more accepted value
+func contentFilterToDomain(filter *model.ContentFilter) (*domain.ContentFilter, error) {
+	if filter == nil {
+		return nil, nil
+	}
+	f := &domain.ContentFilter{
+		ContentType:                   filter.ContentType,
+		MinLengthSeconds:              filter.MinLengthSeconds,
+		MaxLengthSeconds:              filter.MaxLengthSeconds,
+		Search:                        filter.Search,
+		TagsAny:                       filter.TagsAny,
+		TagsAll:                       filter.TagsAll,
+		AddedByUserID:                 filter.AddedByUserID,
+		MinPerspectives:               filter.MinPerspectives,
+		WithoutPerspectivesFromUserID: filter.WithoutPerspectivesFromUserID,
+	}
+	if filter.SearchMode != nil {
+		f.SearchMode = *filter.SearchMode
+	}
+	if filter.ChannelTitle != nil {
+		f.ChannelTitles = append(f.ChannelTitles, *filter.ChannelTitle)
+	}
+	f.ChannelTitles = append(f.ChannelTitles, filter.ChannelTitles...)
+	if filter.MinViewCount != nil {
+		minViewCount := int64(*filter.MinViewCount)
+		f.MinViewCount = &minViewCount
+	}
+
+	var err error
+	if f.PublishedAt, err = dateRangeToDomain(filter.PublishedAt); err != nil {
+		return nil, fmt.Errorf("invalid publishedAt: %w", err)
+	}
+	if f.CreatedAt, err = dateRangeToDomain(filter.CreatedAt); err != nil {
+		return nil, fmt.Errorf("invalid createdAt: %w", err)
+	}
+	return f, nil
+}
+
+// facetCountsToModel converts domain ContentFacetCounts to the GraphQL model
+func facetCountsToModel(c *domain.ContentFacetCounts) *model.ContentFacetCounts {
+	values := make([]*model.FacetValue, len(c.Values))
+	for i, v := range c.Values {
+		values[i] = &model.FacetValue{Value: v.Value, Count: v.Count}
+	}
+	return &model.ContentFacetCounts{Facet: c.Facet, Values: values}
+}
+
+// importResultToModel converts a domain ContentImportResult to a GraphQL model ContentImportResult
+func importResultToModel(r *domain.ContentImportResult) *model.ContentImportResult {
+	m := &model.ContentImportResult{
+		URL:    r.URL,
+		Status: r.Status,
+	}
+	if r.Content != nil {
+		m.Content = domainToModel(r.Content)
+	}
+	if r.Error != "" {
+		errMsg := r.Error
+		m.Error = &errMsg
+	}
+	return m
+}
+
+// quotaUsageDomainToModel converts domain YouTubeQuotaUsage to the GraphQL model
+func quotaUsageDomainToModel(u *domain.YouTubeQuotaUsage) *model.YouTubeQuotaUsage {
+	m := &model.YouTubeQuotaUsage{
+		Day:      u.Day.Format(time.DateOnly),
+		ResetsAt: u.ResetsAt.Format(time.RFC3339),
+		Used:     u.Used,
+		ByMethod: make([]*model.QuotaMethodUsage, len(u.ByMethod)),
+		Breaker:  u.Breaker,
+	}
+	if u.Budget > 0 {
+		budget, remaining := u.Budget, u.Remaining()
+		m.Budget = &budget
+		m.Remaining = &remaining
+	}
+	for i, mu := range u.ByMethod {
+		m.ByMethod[i] = &model.QuotaMethodUsage{Method: mu.Method, Units: mu.Units}
+	}
+	if u.BreakerOpenUntil != nil {
+		openUntil := u.BreakerOpenUntil.Format(time.RFC3339)
+		m.BreakerOpenUntil = &openUntil
+	}
+	return m
+}
+
+// transcriptToModel converts a domain Transcript to the GraphQL model
+func transcriptToModel(t *domain.Transcript) *model.Transcript {
+	return &model.Transcript{
+		ContentID:    strconv.Itoa(t.ContentID),
+		Language:     t.Language,
+		Source:       t.Source,
+		SegmentCount: t.SegmentCount,
+		CreatedAt:    t.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
+		UpdatedAt:    t.UpdatedAt.Format("2006-01-02T15:04:05Z07:00"),
+	}
+}
+
+// transcriptSegmentToModel converts a domain TranscriptSegment to the GraphQL
+// model, with times in seconds
+func transcriptSegmentToModel(s *domain.TranscriptSegment) *model.TranscriptSegment {
+	return &model.TranscriptSegment{
+		ContentID: strconv.Itoa(s.ContentID),
+		Start:     float64(s.StartMs) / 1000,
+		End:       float64(s.EndMs) / 1000,
+		Text:      s.Text,
+	}
+}
+
+// perspectiveSegmentsToDomain converts segment inputs to domain segments,
+// keeping nil distinct from an empty list
+func perspectiveSegmentsToDomain(inputs []*model.PerspectiveSegmentInput) ([]domain.PerspectiveSegment, error) {
+	if inputs == nil {
+		return nil, nil
+	}
+	segments := make([]domain.PerspectiveSegment, len(inputs))
+	for i, in := range inputs {
+		startMs, err := secondsToMs(fmt.Sprintf("segments[%d].start", i), &in.Start)
+		if err != nil {
+			return nil, err
+		}
+		endMs, err := secondsToMs(fmt.Sprintf("segments[%d].end", i), &in.End)
+		if err != nil {
+			return nil, err
+		}
+		segments[i] = domain.PerspectiveSegment{
+			StartMs:    *startMs,
+			EndMs:      *endMs,
+			Note:       in.Note,
+			Quality:    in.Quality,
+			Agreement:  in.Agreement,
+			Importance: in.Importance,
+			Confidence: in.Confidence,
+		}
+	}
+	return segments, nil
+}
+
+// secondsToMs converts an optional time in seconds, named name in errors,
+// to milliseconds. Times must be finite and fit an int32 of milliseconds.
+func secondsToMs(name string, seconds *float64) (*int, error) {
+	if seconds == nil {
+		return nil, nil
+	}
+	if math.IsNaN(*seconds) || math.IsInf(*seconds, 0) {
+		return nil, fmt.Errorf("%w: %s must be a finite number of seconds", domain.ErrInvalidInput, name)
+	}
+	ms := math.Round(*seconds * 1000)
+	if ms < math.MinInt32 || ms > math.MaxInt32 {
+		return nil, fmt.Errorf("%w: %s of %gs is out of range", domain.ErrInvalidInput, name, *seconds)
+	}
+	out := int(ms)
+	return &out, nil
+}
@@ -13,6 +13,9 @@ type Resolver struct {
 	ContentService     portservices.ContentService
 	UserService        portservices.UserService
 	PerspectiveService portservices.PerspectiveService
+	QuotaService       portservices.QuotaService
+	TranscriptService  portservices.TranscriptService
+	SearchService      portservices.SearchService
 }
 
 // NewResolver creates a new resolver with dependencies
@@ -20,10 +23,16 @@ func NewResolver(
 	contentService portservices.ContentService,
 	userService portservices.UserService,
 	perspectiveService portservices.PerspectiveService,
+	quotaService portservices.QuotaService,
+	transcriptService portservices.TranscriptService,
+	searchService portservices.SearchService,
 ) *Resolver {
 	return &Resolver{
 		ContentService:     contentService,
 		UserService:        userService,
 		PerspectiveService: perspectiveService,
+		QuotaService:       quotaService,
+		TranscriptService:  transcriptService,
+		SearchService:      searchService,
 	}
 }
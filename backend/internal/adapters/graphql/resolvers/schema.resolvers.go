@@ -18,9 +18,86 @@ import (
 	portservices "github.com/CodeWarrior-debug/perspectize/backend/internal/core/ports/services"
 )
 
+// StatsHistory is the resolver for the statsHistory field.
+func (r *contentResolver) StatsHistory(ctx context.Context, obj *model.Content, from *string, to *string, granularity *domain.StatsGranularity) ([]*model.ContentStatsPoint, error) {
+	contentID, err := strconv.Atoi(obj.ID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid content ID: %s", obj.ID)
+	}
+
+	params := domain.ContentStatsHistoryParams{
+		ContentID:   contentID,
+		Granularity: domain.StatsGranularityDay,
+	}
+	if granularity != nil {
+		params.Granularity = *granularity
+	}
+	if params.From, err = parseDateArg(from); err != nil {
+		return nil, fmt.Errorf("invalid from date: %s", *from)
+	}
+	if params.To, err = parseDateArg(to); err != nil {
+		return nil, fmt.Errorf("invalid to date: %s", *to)
+	}
+
+	buckets, err := r.ContentService.StatsHistory(ctx, params)
+	if err != nil {
+		if errors.Is(err, domain.ErrInvalidInput) {
+			return nil, fmt.Errorf("invalid input: %w", err)
+		}
+		slog.Error("fetching stats history failed", "contentID", contentID, "error", err)
+		return nil, fmt.Errorf("failed to fetch stats history")
+	}
+
+	points := make([]*model.ContentStatsPoint, len(buckets))
+	for i, b := range buckets {
+		points[i] = statsBucketToModel(b)
+	}
+	return points, nil
+}
+
+// Transcript is the resolver for the transcript field.
+func (r *contentResolver) Transcript(ctx context.Context, obj *model.Content, from *float64, to *float64) ([]*model.TranscriptSegment, error) {
+	contentID, err := strconv.Atoi(obj.ID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid content ID: %s", obj.ID)
+	}
+
+	fromMs, err := secondsToMs("from", from)
+	if err != nil {
+		return nil, err
+	}
+	toMs, err := secondsToMs("to", to)
+	if err != nil {
+		return nil, err
+	}
+	segments, err := r.TranscriptService.Segments(ctx, domain.TranscriptRangeParams{
+		ContentID: contentID,
+		FromMs:    fromMs,
+		ToMs:      toMs,
+	})
+	if err != nil {
+		if errors.Is(err, domain.ErrInvalidInput) {
+			return nil, fmt.Errorf("invalid input: %w", err)
+		}
+		slog.Error("fetching transcript failed", "contentID", contentID, "error", err)
+		return nil, fmt.Errorf("failed to fetch transcript")
+	}
+
+	result := make([]*model.TranscriptSegment, len(segments))
+	for i, segment := range segments {
+		result[i] = transcriptSegmentToModel(segment)
+	}
+	return result, nil
+}
+
 // CreateContentFromYouTube is the resolver for the createContentFromYouTube field.
-func (r *mutationResolver) CreateContentFromYouTube(ctx context.Context, input model.CreateContentFromYouTubeInput) (*model.Content, error) {
-	content, err := r.ContentService.CreateFromYouTube(ctx, input.URL)
+func (r *mutationResolver) CreateContentFromYouTube(ctx context.Context, input model.CreateContentFromYouTubeInput) (*model.CreateContentPayload, error) {
+	ifExists := domain.IfExistsError
+	if input.IfExists != nil {
+		ifExists = *input.IfExists
+	}
+
+	content, created, err := r.ContentService.CreateOrGetFromYouTube(ctx, input.URL, ifExists)
 	if err != nil {
 		if errors.Is(err, domain.ErrAlreadyExists) {
 			return nil, fmt.Errorf("content already exists for this URL")
@@ -28,13 +105,231 @@ func (r *mutationResolver) CreateContentFromYouTube(ctx context.Context, input m
 		if errors.Is(err, domain.ErrInvalidURL) {
 			return nil, fmt.Errorf("invalid YouTube URL")
 		}
+		if errors.Is(err, domain.ErrInvalidInput) {
+			return nil, fmt.Errorf("invalid input: %w", err)
+		}
+		if errors.Is(err, domain.ErrQuotaExceeded) {
+			return nil, fmt.Errorf("YouTube quota exhausted, try again later")
+		}
+		if errors.Is(err, domain.ErrYouTubeAPI) {
+			return nil, fmt.Errorf("failed to fetch video metadata from YouTube")
+		}
 		slog.Error("creating content failed", "error", err)
 		return nil, fmt.Errorf("failed to create content")
 	}
 
+	return &model.CreateContentPayload{
+		Content: domainToModel(content),
+		Created: created,
+	}, nil
+}
+
+// UpdateContent is the resolver for the updateContent field.
+func (r *mutationResolver) UpdateContent(ctx context.Context, input model.UpdateContentInput) (*model.Content, error) {
+	serviceInput := portservices.UpdateContentInput{
+		ID:   input.ID,
+		Name: input.Name,
+		URL:  input.URL,
+	}
+
+	content, err := r.ContentService.Update(ctx, serviceInput)
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return nil, fmt.Errorf("content not found")
+		}
+		if errors.Is(err, domain.ErrAlreadyExists) {
+			return nil, fmt.Errorf("content already exists: %w", err)
+		}
+		if errors.Is(err, domain.ErrInvalidURL) {
+			return nil, fmt.Errorf("invalid YouTube URL")
+		}
+		if errors.Is(err, domain.ErrInvalidInput) {
+			return nil, fmt.Errorf("invalid input: %w", err)
+		}
+		slog.Error("updating content failed", "error", err)
+		return nil, fmt.Errorf("failed to update content")
+	}
+
 	return domainToModel(content), nil
 }
 
+// DeleteContent is the resolver for the deleteContent field.
+func (r *mutationResolver) DeleteContent(ctx context.Context, id int, policy *domain.ContentDeletePolicy) (bool, error) {
+	deletePolicy := domain.ContentDeletePolicyRestrict
+	if policy != nil {
+		deletePolicy = *policy
+	}
+
+	err := r.ContentService.Delete(ctx, id, deletePolicy)
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return false, fmt.Errorf("content not found")
+		}
+		if errors.Is(err, domain.ErrInvalidInput) {
+			return false, fmt.Errorf("invalid content ID")
+		}
+		if errors.Is(err, domain.ErrContentInUse) {
+			return false, fmt.Errorf("content has perspectives; delete with policy DETACH to detach them")
+		}
+		slog.Error("deleting content failed", "error", err)
+		return false, fmt.Errorf("failed to delete content")
+	}
+
+	return true, nil
+}
+
+// RefreshContent is the resolver for the refreshContent field.
+func (r *mutationResolver) RefreshContent(ctx context.Context, id int) (*model.Content, error) {
+	content, err := r.ContentService.RefreshContent(ctx, id)
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return nil, fmt.Errorf("content not found")
+		}
+		if errors.Is(err, domain.ErrInvalidURL) {
+			return nil, fmt.Errorf("invalid YouTube URL")
+		}
+		if errors.Is(err, domain.ErrInvalidInput) {
+			return nil, fmt.Errorf("invalid input: %w", err)
+		}
+		if errors.Is(err, domain.ErrQuotaExceeded) {
+			return nil, fmt.Errorf("YouTube quota exhausted, try again later")
+		}
+		if errors.Is(err, domain.ErrYouTubeAPI) {
+			return nil, fmt.Errorf("failed to fetch video metadata from YouTube")
+		}
+		slog.Error("refreshing content failed", "error", err)
+		return nil, fmt.Errorf("failed to refresh content")
+	}
+
+	return domainToModel(content), nil
+}
+
+// MergeContent is the resolver for the mergeContent field.
+func (r *mutationResolver) MergeContent(ctx context.Context, sourceID int, targetID int) (*model.Content, error) {
+	result, err := r.ContentService.MergeContent(ctx, sourceID, targetID)
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return nil, fmt.Errorf("content not found")
+		}
+		if errors.Is(err, domain.ErrInvalidInput) {
+			return nil, fmt.Errorf("invalid input: %w", err)
+		}
+		slog.Error("merging content failed", "error", err)
+		return nil, fmt.Errorf("failed to merge content")
+	}
+
+	return domainToModel(result.Target), nil
+}
+
+// ImportContent is the resolver for the importContent field.
+func (r *mutationResolver) ImportContent(ctx context.Context, urls []string) ([]*model.ContentImportResult, error) {
+	results, err := r.ContentService.ImportFromURLs(ctx, urls)
+	if err != nil {
+		if errors.Is(err, domain.ErrInvalidInput) {
+			return nil, fmt.Errorf("invalid input: %w", err)
+		}
+		slog.Error("importing content failed", "error", err)
+		return nil, fmt.Errorf("failed to import content")
+	}
+
+	out := make([]*model.ContentImportResult, len(results))
+	for i, result := range results {
+		out[i] = importResultToModel(result)
+	}
+	return out, nil
+}
+
+// ImportYouTubePlaylist is the resolver for the importYouTubePlaylist field.
+func (r *mutationResolver) ImportYouTubePlaylist(ctx context.Context, url string, limit *int) (*model.PlaylistImportSummary, error) {
+	importLimit := 0
+	if limit != nil {
+		importLimit = *limit
+	}
+
+	summary, err := r.ContentService.ImportYouTubeCollection(ctx, url, importLimit)
+	if err != nil {
+		if errors.Is(err, domain.ErrInvalidInput) {
+			return nil, fmt.Errorf("invalid input: %w", err)
+		}
+		if errors.Is(err, domain.ErrInvalidURL) {
+			return nil, fmt.Errorf("invalid YouTube playlist or channel URL")
+		}
+		if errors.Is(err, domain.ErrNotFound) {
+			return nil, fmt.Errorf("playlist or channel not found")
+		}
+		if errors.Is(err, domain.ErrQuotaExceeded) {
+			return nil, fmt.Errorf("YouTube quota exhausted, try again later")
+		}
+		if errors.Is(err, domain.ErrYouTubeAPI) {
+			return nil, fmt.Errorf("failed to fetch playlist from YouTube")
+		}
+		slog.Error("importing playlist failed", "error", err)
+		return nil, fmt.Errorf("failed to import playlist")
+	}
+
+	return &model.PlaylistImportSummary{
+		Total:   summary.Total,
+		Created: summary.Created,
+		Skipped: summary.Skipped,
+		Failed:  summary.Failed,
+	}, nil
+}
+
+// FetchTranscript is the resolver for the fetchTranscript field.
+func (r *mutationResolver) FetchTranscript(ctx context.Context, contentID int, language *string) (*model.Transcript, error) {
+	var lang string
+	if language != nil {
+		lang = *language
+	}
+
+	transcript, err := r.TranscriptService.FetchFromYouTube(ctx, contentID, lang)
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return nil, fmt.Errorf("content or captions not found")
+		}
+		if errors.Is(err, domain.ErrInvalidURL) {
+			return nil, fmt.Errorf("invalid YouTube URL")
+		}
+		if errors.Is(err, domain.ErrInvalidInput) {
+			return nil, fmt.Errorf("invalid input: %w", err)
+		}
+		if errors.Is(err, domain.ErrYouTubeAPI) {
+			return nil, fmt.Errorf("failed to fetch captions from YouTube")
+		}
+		slog.Error("fetching transcript from YouTube failed", "contentID", contentID, "error", err)
+		return nil, fmt.Errorf("failed to fetch transcript")
+	}
+
+	return transcriptToModel(transcript), nil
+}
+
+// UploadTranscript is the resolver for the uploadTranscript field.
+func (r *mutationResolver) UploadTranscript(ctx context.Context, input model.UploadTranscriptInput) (*model.Transcript, error) {
+	var lang string
+	if input.Language != nil {
+		lang = *input.Language
+	}
+
+	transcript, err := r.TranscriptService.Upload(ctx, portservices.UploadTranscriptInput{
+		ContentID: input.ContentID,
+		Language:  lang,
+		Format:    input.Format,
+		Data:      input.Data,
+	})
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return nil, fmt.Errorf("content not found")
+		}
+		if errors.Is(err, domain.ErrInvalidInput) {
+			return nil, fmt.Errorf("invalid input: %w", err)
+		}
+		slog.Error("uploading transcript failed", "contentID", input.ContentID, "error", err)
+		return nil, fmt.Errorf("failed to upload transcript")
+	}
+
+	return transcriptToModel(transcript), nil
+}
+
 // CreateUser is the resolver for the createUser field.
 func (r *mutationResolver) CreateUser(ctx context.Context, input model.CreateUserInput) (*model.User, error) {
 	email := ""
@@ -112,6 +407,10 @@ func (r *mutationResolver) DeleteUser(ctx context.Context, id string) (bool, err
 
 // CreatePerspective is the resolver for the createPerspective field.
 func (r *mutationResolver) CreatePerspective(ctx context.Context, input model.CreatePerspectiveInput) (*model.Perspective, error) {
+	segments, err := perspectiveSegmentsToDomain(input.Segments)
+	if err != nil {
+		return nil, err
+	}
 	serviceInput := portservices.CreatePerspectiveInput{
 		UserID:      input.UserID,
 		Quality:     input.Quality,
@@ -123,6 +422,7 @@ func (r *mutationResolver) CreatePerspective(ctx context.Context, input model.Cr
 		Description: input.Description,
 		Category:    input.Category,
 		Parts:       input.Parts,
+		Segments:    segments,
 		Labels:      input.Labels,
 	}
 
@@ -150,7 +450,7 @@ func (r *mutationResolver) CreatePerspective(ctx context.Context, input model.Cr
 			return nil, fmt.Errorf("invalid input: %w", err)
 		}
 		if errors.Is(err, domain.ErrNotFound) {
-			return nil, fmt.Errorf("user not found: %w", err)
+			return nil, fmt.Errorf("user or content not found: %w", err)
 		}
 		slog.Error("creating perspective failed", "error", err)
 		return nil, fmt.Errorf("failed to create perspective")
@@ -161,6 +461,10 @@ func (r *mutationResolver) CreatePerspective(ctx context.Context, input model.Cr
 
 // UpdatePerspective is the resolver for the updatePerspective field.
 func (r *mutationResolver) UpdatePerspective(ctx context.Context, input model.UpdatePerspectiveInput) (*model.Perspective, error) {
+	segments, err := perspectiveSegmentsToDomain(input.Segments)
+	if err != nil {
+		return nil, err
+	}
 	serviceInput := portservices.UpdatePerspectiveInput{
 		ID:           input.ID,
 		Quality:      input.Quality,
@@ -173,6 +477,7 @@ func (r *mutationResolver) UpdatePerspective(ctx context.Context, input model.Up
 		Category:     input.Category,
 		ReviewStatus: input.ReviewStatus,
 		Parts:        input.Parts,
+		Segments:     segments,
 		Labels:       input.Labels,
 	}
 
@@ -254,7 +559,7 @@ func (r *queryResolver) ContentByID(ctx context.Context, id string) (*model.Cont
 }
 
 // Content is the resolver for the content field.
-func (r *queryResolver) Content(ctx context.Context, first *int, after *string, last *int, before *string, sortBy *domain.ContentSortBy, sortOrder *domain.SortOrder, includeTotalCount *bool, filter *model.ContentFilter) (*model.PaginatedContent, error) {
+func (r *queryResolver) Content(ctx context.Context, first *int, after *string, last *int, before *string, sortBy *domain.ContentSortBy, sortOrder *domain.SortOrder, includeTotalCount *bool, countStrategy *domain.CountStrategy, filter *model.ContentFilter) (*model.PaginatedContent, error) {
 	params := domain.ContentListParams{
 		First:  first,
 		After:  after,
@@ -278,16 +583,14 @@ func (r *queryResolver) Content(ctx context.Context, first *int, after *string,
 	if includeTotalCount != nil {
 		params.IncludeTotalCount = *includeTotalCount
 	}
+	if countStrategy != nil {
+		params.CountStrategy = *countStrategy
+	}
 
 	// Map filter
-	if filter != nil {
-		params.Filter = &domain.ContentFilter{}
-		if filter.ContentType != nil {
-			params.Filter.ContentType = filter.ContentType
-		}
-		params.Filter.MinLengthSeconds = filter.MinLengthSeconds
-		params.Filter.MaxLengthSeconds = filter.MaxLengthSeconds
-		params.Filter.Search = filter.Search
+	var err error
+	if params.Filter, err = contentFilterToDomain(filter); err != nil {
+		return nil, err
 	}
 
 	result, err := r.ContentService.ListContent(ctx, params)
@@ -312,6 +615,7 @@ func (r *queryResolver) Content(ctx context.Context, first *int, after *string,
 			HasPreviousPage: result.HasPrev,
 			StartCursor:     result.StartCursor,
 			EndCursor:       result.EndCursor,
+			TotalCountExact: totalCountExact(result.TotalCount, result.TotalCountExact),
 		},
 		TotalCount: result.TotalCount,
 	}
@@ -319,6 +623,111 @@ func (r *queryResolver) Content(ctx context.Context, first *int, after *string,
 	return conn, nil
 }
 
+// ContentPage is the resolver for the contentPage field.
+func (r *queryResolver) ContentPage(ctx context.Context, page *int, pageSize *int, sortBy *domain.ContentSortBy, sortOrder *domain.SortOrder, countStrategy *domain.CountStrategy, filter *model.ContentFilter) (*model.ContentPage, error) {
+	params := domain.ContentPageParams{
+		Page:      1,
+		SortBy:    domain.ContentSortByCreatedAt,
+		SortOrder: domain.SortOrderDesc,
+	}
+	if page != nil {
+		params.Page = *page
+	}
+	if pageSize != nil {
+		params.PageSize = *pageSize
+	}
+	if sortBy != nil {
+		params.SortBy = *sortBy
+	}
+	if sortOrder != nil {
+		params.SortOrder = *sortOrder
+	}
+	if countStrategy != nil {
+		params.CountStrategy = *countStrategy
+	}
+
+	var err error
+	if params.Filter, err = contentFilterToDomain(filter); err != nil {
+		return nil, err
+	}
+
+	result, err := r.ContentService.ContentPage(ctx, params)
+	if err != nil {
+		if errors.Is(err, domain.ErrInvalidInput) {
+			return nil, fmt.Errorf("invalid pagination parameters: %w", err)
+		}
+		slog.Error("listing content page failed", "error", err)
+		return nil, fmt.Errorf("failed to list content")
+	}
+
+	items := make([]*model.Content, len(result.Items))
+	for i, item := range result.Items {
+		items[i] = domainToModel(item)
+	}
+	return &model.ContentPage{
+		Items:           items,
+		Page:            result.Page,
+		PageSize:        result.PageSize,
+		TotalCount:      result.TotalCount,
+		TotalPages:      result.TotalPages,
+		TotalCountExact: result.TotalCountExact,
+		HasNextPage:     result.Page < result.TotalPages,
+		HasPreviousPage: result.Page > 1,
+		StartCursor:     result.StartCursor,
+		EndCursor:       result.EndCursor,
+	}, nil
+}
+
+// ContentFacets is the resolver for the contentFacets field.
+func (r *queryResolver) ContentFacets(ctx context.Context, filter *model.ContentFilter, facets []domain.ContentFacet) ([]*model.ContentFacetCounts, error) {
+	contentFilter, err := contentFilterToDomain(filter)
+	if err != nil {
+		return nil, err
+	}
+
+	counts, err := r.ContentService.ContentFacets(ctx, domain.ContentFacetParams{Filter: contentFilter, Facets: facets})
+	if err != nil {
+		if errors.Is(err, domain.ErrInvalidInput) {
+			return nil, fmt.Errorf("invalid input: %w", err)
+		}
+		slog.Error("counting content facets failed", "error", err)
+		return nil, fmt.Errorf("failed to count content facets")
+	}
+
+	results := make([]*model.ContentFacetCounts, len(counts))
+	for i, c := range counts {
+		results[i] = facetCountsToModel(c)
+	}
+	return results, nil
+}
+
+// SearchTranscripts is the resolver for the searchTranscripts field.
+func (r *queryResolver) SearchTranscripts(ctx context.Context, query string, contentID *int, first *int) ([]*model.TranscriptSearchResult, error) {
+	params := domain.TranscriptSearchParams{Query: query, ContentID: contentID}
+	if first != nil {
+		params.Limit = *first
+	}
+
+	matches, err := r.TranscriptService.Search(ctx, params)
+	if err != nil {
+		if errors.Is(err, domain.ErrInvalidInput) {
+			return nil, fmt.Errorf("invalid input: %w", err)
+		}
+		slog.Error("searching transcripts failed", "error", err)
+		return nil, fmt.Errorf("failed to search transcripts")
+	}
+
+	results := make([]*model.TranscriptSearchResult, len(matches))
+	for i, m := range matches {
+		results[i] = &model.TranscriptSearchResult{
+			Segment:  transcriptSegmentToModel(m.Segment),
+			Headline: m.Headline,
+			Rank:     m.Rank,
+		}
+	}
+	return results, nil
+}
+
 // UserByID is the resolver for the userByID field.
 func (r *queryResolver) UserByID(ctx context.Context, id string) (*model.User, error) {
 	intID, err := strconv.Atoi(id)
@@ -375,6 +784,56 @@ func (r *queryResolver) Users(ctx context.Context) ([]*model.User, error) {
 	return modelUsers, nil
 }
 
+// SearchUsers is the resolver for the searchUsers field.
+func (r *queryResolver) SearchUsers(ctx context.Context, query string, first *int) ([]*model.User, error) {
+	limit := 0
+	if first != nil {
+		limit = *first
+	}
+
+	users, err := r.UserService.Search(ctx, query, limit)
+	if err != nil {
+		if errors.Is(err, domain.ErrInvalidInput) {
+			return nil, fmt.Errorf("invalid input: %w", err)
+		}
+		slog.Error("searching users failed", "error", err)
+		return nil, fmt.Errorf("failed to search users")
+	}
+
+	modelUsers := make([]*model.User, len(users))
+	for i, user := range users {
+		modelUsers[i] = userDomainToModel(user)
+	}
+	return modelUsers, nil
+}
+
+// SearchSuggestions is the resolver for the searchSuggestions field.
+func (r *queryResolver) SearchSuggestions(ctx context.Context, query string, first *int) ([]*model.SearchSuggestion, error) {
+	limit := 0
+	if first != nil {
+		limit = *first
+	}
+
+	suggestions, err := r.SearchService.Suggest(ctx, query, limit)
+	if err != nil {
+		if errors.Is(err, domain.ErrInvalidInput) {
+			return nil, fmt.Errorf("invalid input: %w", err)
+		}
+		slog.Error("suggesting search terms failed", "error", err)
+		return nil, fmt.Errorf("failed to suggest search terms")
+	}
+
+	results := make([]*model.SearchSuggestion, len(suggestions))
+	for i, s := range suggestions {
+		results[i] = &model.SearchSuggestion{
+			Text:       s.Text,
+			Kind:       s.Kind,
+			Similarity: s.Similarity,
+		}
+	}
+	return results, nil
+}
+
 // PerspectiveByID is the resolver for the perspectiveByID field.
 func (r *queryResolver) PerspectiveByID(ctx context.Context, id string) (*model.Perspective, error) {
 	intID, err := strconv.Atoi(id)
@@ -398,7 +857,7 @@ func (r *queryResolver) PerspectiveByID(ctx context.Context, id string) (*model.
 }
 
 // Perspectives is the resolver for the perspectives field.
-func (r *queryResolver) Perspectives(ctx context.Context, first *int, after *string, last *int, before *string, sortBy *domain.PerspectiveSortBy, sortOrder *domain.SortOrder, includeTotalCount *bool, filter *model.PerspectiveFilter) (*model.PaginatedPerspectives, error) {
+func (r *queryResolver) Perspectives(ctx context.Context, first *int, after *string, last *int, before *string, sortBy *domain.PerspectiveSortBy, sortOrder *domain.SortOrder, sortCategory *string, includeTotalCount *bool, countStrategy *domain.CountStrategy, filter *model.PerspectiveFilter) (*model.PaginatedPerspectives, error) {
 	params := domain.PerspectiveListParams{
 		First:  first,
 		After:  after,
@@ -419,9 +878,16 @@ func (r *queryResolver) Perspectives(ctx context.Context, first *int, after *str
 		params.SortOrder = domain.SortOrderDesc
 	}
 
+	if sortCategory != nil {
+		params.SortCategory = *sortCategory
+	}
+
 	if includeTotalCount != nil {
 		params.IncludeTotalCount = *includeTotalCount
 	}
+	if countStrategy != nil {
+		params.CountStrategy = *countStrategy
+	}
 
 	// Map filter
 	if filter != nil {
@@ -435,6 +901,28 @@ func (r *queryResolver) Perspectives(ctx context.Context, first *int, after *str
 		if filter.Privacy != nil {
 			params.Filter.Privacy = filter.Privacy
 		}
+		params.Filter.Search = filter.Search
+		params.Filter.Quality = intRangeToDomain(filter.Quality)
+		params.Filter.Agreement = intRangeToDomain(filter.Agreement)
+		params.Filter.Importance = intRangeToDomain(filter.Importance)
+		params.Filter.Confidence = intRangeToDomain(filter.Confidence)
+		params.Filter.LabelsAny = filter.LabelsAny
+		params.Filter.LabelsAll = filter.LabelsAll
+		params.Filter.Category = filter.Category
+		params.Filter.ReviewStatus = filter.ReviewStatus
+		if cr := filter.CategorizedRating; cr != nil {
+			params.Filter.CategorizedRating = &domain.CategorizedRatingFilter{Category: cr.Category}
+			if cr.MinRating != nil {
+				params.Filter.CategorizedRating.MinRating = *cr.MinRating
+			}
+		}
+		var err error
+		if params.Filter.CreatedAt, err = dateRangeToDomain(filter.CreatedAt); err != nil {
+			return nil, fmt.Errorf("invalid createdAt: %w", err)
+		}
+		if params.Filter.UpdatedAt, err = dateRangeToDomain(filter.UpdatedAt); err != nil {
+			return nil, fmt.Errorf("invalid updatedAt: %w", err)
+		}
 	}
 
 	result, err := r.PerspectiveService.ListPerspectives(ctx, params)
@@ -459,6 +947,7 @@ func (r *queryResolver) Perspectives(ctx context.Context, first *int, after *str
 			HasPreviousPage: result.HasPrev,
 			StartCursor:     result.StartCursor,
 			EndCursor:       result.EndCursor,
+			TotalCountExact: totalCountExact(result.TotalCount, result.TotalCountExact),
 		},
 		TotalCount: result.TotalCount,
 	}
@@ -466,11 +955,30 @@ func (r *queryResolver) Perspectives(ctx context.Context, first *int, after *str
 	return conn, nil
 }
 
+// YoutubeQuota is the resolver for the youtubeQuota field.
+func (r *queryResolver) YoutubeQuota(ctx context.Context) (*model.YouTubeQuotaUsage, error) {
+	if r.QuotaService == nil {
+		return nil, fmt.Errorf("quota tracking is not enabled")
+	}
+
+	usage, err := r.QuotaService.Usage(ctx)
+	if err != nil {
+		slog.Error("getting YouTube quota usage failed", "error", err)
+		return nil, fmt.Errorf("failed to get quota usage")
+	}
+
+	return quotaUsageDomainToModel(usage), nil
+}
+
+// Content returns generated.ContentResolver implementation.
+func (r *Resolver) Content() generated.ContentResolver { return &contentResolver{r} }
+
 // Mutation returns generated.MutationResolver implementation.
 func (r *Resolver) Mutation() generated.MutationResolver { return &mutationResolver{r} }
 
 // Query returns generated.QueryResolver implementation.
 func (r *Resolver) Query() generated.QueryResolver { return &queryResolver{r} }
 
+type contentResolver struct{ *Resolver }
 type mutationResolver struct{ *Resolver }
 type queryResolver struct{ *Resolver }
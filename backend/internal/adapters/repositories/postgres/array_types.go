@@ -108,8 +108,10 @@ func (a StringArray) Value() (driver.Value, error) {
 			buf.WriteByte(',')
 		}
 
-		// Quote strings that contain commas, quotes, backslashes, or braces
-		needsQuoting := strings.ContainsAny(s, `,"\{}`)
+		// Quote strings that contain commas, quotes, backslashes, or braces,
+		// and strings PostgreSQL would otherwise trim, reject or read as NULL
+		needsQuoting := strings.ContainsAny(s, `,"\{}`) ||
+			s == "" || strings.TrimSpace(s) != s || strings.EqualFold(s, "NULL")
 		if needsQuoting {
 			buf.WriteByte('"')
 		}
@@ -0,0 +1,157 @@
+package postgres
+
+import (
+	"encoding/json"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/CodeWarrior-debug/perspectize/backend/internal/core/domain"
+	"gorm.io/gorm"
+)
+
+const (
+	// DefaultCountCacheTTL is how long a CACHED count is reused
+	DefaultCountCacheTTL = 30 * time.Second
+
+	countCacheCapacity = 1000
+)
+
+// WithCountCacheTTL sets how long a CACHED total count is reused; values
+// of zero or less keep DefaultCountCacheTTL
+func WithCountCacheTTL(ttl time.Duration) RepositoryOption {
+	return func(o *repositoryOptions) {
+		if ttl > 0 {
+			o.countCacheTTL = ttl
+		}
+	}
+}
+
+// countEntry is a cached count and when it stops being served
+type countEntry struct {
+	count   int
+	expires time.Time
+}
+
+// countCache is a fixed-capacity, concurrency-safe cache of exact counts
+// keyed by listing and normalized filter. When full, expired entries are
+// swept and, failing that, an arbitrary entry is dropped.
+type countCache struct {
+	mu      sync.Mutex
+	ttl     time.Duration
+	entries map[string]countEntry
+}
+
+func newCountCache(ttl time.Duration) *countCache {
+	return &countCache{ttl: ttl, entries: make(map[string]countEntry)}
+}
+
+func (c *countCache) get(key string, now time.Time) (int, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	entry, ok := c.entries[key]
+	if !ok || !now.Before(entry.expires) {
+		return 0, false
+	}
+	return entry.count, true
+}
+
+func (c *countCache) put(key string, count int, now time.Time) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if _, ok := c.entries[key]; !ok && len(c.entries) >= countCacheCapacity {
+		for k, entry := range c.entries {
+			if !now.Before(entry.expires) {
+				delete(c.entries, k)
+			}
+		}
+		for k := range c.entries {
+			if len(c.entries) < countCacheCapacity {
+				break
+			}
+			delete(c.entries, k)
+		}
+	}
+	c.entries[key] = countEntry{count: count, expires: now.Add(c.ttl)}
+}
+
+// countKey identifies a listing's rows for the count cache: the table and
+// the filter as normalized by the service
+func countKey(table string, filter any) (string, error) {
+	b, err := json.Marshal(filter)
+	if err != nil {
+		return "", fmt.Errorf("failed to build count cache key: %w", err)
+	}
+	return table + ":" + string(b), nil
+}
+
+// countRows counts the rows query selects using strategy; exact reports
+// whether the result is a fresh COUNT(*). key is only built when the cache
+// is consulted.
+func (o repositoryOptions) countRows(query *gorm.DB, strategy domain.CountStrategy, key func() (string, error)) (count int, exact bool, err error) {
+	switch strategy {
+	case domain.CountStrategyEstimated:
+		estimate, err := estimateRows(query)
+		if err != nil {
+			return 0, false, err
+		}
+		if estimate >= domain.EstimatedCountExactBelow {
+			return estimate, false, nil
+		}
+	case domain.CountStrategyCached:
+		k, err := key()
+		if err != nil {
+			return 0, false, err
+		}
+		if count, ok := o.countCache.get(k, time.Now()); ok {
+			return count, false, nil
+		}
+		if count, err = exactCount(query); err != nil {
+			return 0, false, err
+		}
+		o.countCache.put(k, count, time.Now())
+		return count, true, nil
+	}
+
+	count, err = exactCount(query)
+	return count, err == nil, err
+}
+
+// exactCount runs COUNT(*) over a copy of query
+func exactCount(query *gorm.DB) (int, error) {
+	var count int64
+	if err := query.Session(&gorm.Session{}).Count(&count).Error; err != nil {
+		return 0, err
+	}
+	return int(count), nil
+}
+
+// explainPlan is the part of EXPLAIN (FORMAT JSON) output estimateRows reads
+type explainPlan struct {
+	Plan struct {
+		Rows float64 `json:"Plan Rows"`
+	} `json:"Plan"`
+}
+
+// estimateRows returns the planner's row estimate for query without running
+// it. The estimate comes from table statistics (pg_class.reltuples and
+// column histograms), so it lags recent writes and is rough under
+// selective or correlated filters.
+func estimateRows(query *gorm.DB) (int, error) {
+	stmt := query.Session(&gorm.Session{DryRun: true}).Find(&[]map[string]any{}).Statement
+	if stmt.Error != nil {
+		return 0, stmt.Error
+	}
+
+	var raw []byte
+	row := stmt.ConnPool.QueryRowContext(stmt.Context, "EXPLAIN (FORMAT JSON) "+stmt.SQL.String(), stmt.Vars...)
+	if err := row.Scan(&raw); err != nil {
+		return 0, fmt.Errorf("failed to explain query: %w", err)
+	}
+
+	var plans []explainPlan
+	if err := json.Unmarshal(raw, &plans); err != nil || len(plans) == 0 {
+		return 0, fmt.Errorf("failed to read query plan: %s", raw)
+	}
+	return int(plans[0].Plan.Rows), nil
+}
@@ -0,0 +1,151 @@
+package postgres
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/CodeWarrior-debug/perspectize/backend/internal/core/domain"
+)
+
+// cursorVersion is the envelope format written by signCursor. Cursors of
+// other versions are rejected, so the format can change without old cursors
+// being misread.
+const cursorVersion = 1
+
+// processCursorKey signs cursors when no key is configured, which is meant
+// for development and tests only. It lives as long as the process, so such
+// cursors stop working on restart and are not accepted by other instances.
+var processCursorKey = sync.OnceValue(func() []byte {
+	key := make([]byte, 32)
+	if _, err := rand.Read(key); err != nil {
+		panic(fmt.Sprintf("failed to generate cursor key: %v", err))
+	}
+	return key
+})
+
+// WithCursorKeys sets the HMAC keys of pagination cursors. The first key
+// signs new cursors and every key verifies, so a replaced key can stay
+// listed until the cursors it signed are no longer in use. Empty keys are
+// skipped; with none, cursors are signed with a key that lasts as long as
+// the process, which suits development and tests only.
+func WithCursorKeys(keys ...[]byte) RepositoryOption {
+	return func(o *repositoryOptions) {
+		for _, key := range keys {
+			if len(key) > 0 {
+				o.cursorKeys = append(o.cursorKeys, key)
+			}
+		}
+	}
+}
+
+// cursorEnvelope is the signed payload of a cursor. Pos is the paginator's
+// own cursor; the other fields bind it to the listing that issued it.
+type cursorEnvelope struct {
+	Version int    `json:"v"`
+	Sort    string `json:"s"`
+	Order   string `json:"o"`
+	Filter  string `json:"f"`
+	Pos     string `json:"p"`
+}
+
+// cursorScope is the sort and filter a cursor is valid for
+type cursorScope struct {
+	sort   string
+	order  domain.SortOrder
+	filter string // Hash of the filter
+}
+
+// newCursorScope scopes cursors to sort, order and filter, where a nil
+// filter is the same as an empty one. sort must name everything that changes
+// the ordering, such as a sort category.
+func newCursorScope[F any](sort string, order domain.SortOrder, filter *F) (cursorScope, error) {
+	var f F
+	if filter != nil {
+		f = *filter
+	}
+	data, err := json.Marshal(f)
+	if err != nil {
+		return cursorScope{}, fmt.Errorf("failed to hash cursor filter: %w", err)
+	}
+	sum := sha256.Sum256(data)
+	return cursorScope{sort: sort, order: order, filter: hex.EncodeToString(sum[:16])}, nil
+}
+
+// signCursor wraps the paginator cursor pos in an envelope signed with the
+// first cursor key; a nil pos stays nil
+func (o repositoryOptions) signCursor(scope cursorScope, pos *string) (*string, error) {
+	if pos == nil {
+		return nil, nil
+	}
+	payload, err := json.Marshal(cursorEnvelope{
+		Version: cursorVersion,
+		Sort:    scope.sort,
+		Order:   string(scope.order),
+		Filter:  scope.filter,
+		Pos:     *pos,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode cursor: %w", err)
+	}
+	enc := base64.RawURLEncoding
+	signed := enc.EncodeToString(payload) + "." + enc.EncodeToString(cursorMAC(o.cursorKeys[0], payload))
+	return &signed, nil
+}
+
+// verifyCursor checks that signed was issued by signCursor, under any cursor
+// key, for scope, and returns the paginator cursor it wraps
+func (o repositoryOptions) verifyCursor(scope cursorScope, signed string) (string, error) {
+	invalid := fmt.Errorf("%w: invalid cursor", domain.ErrInvalidInput)
+
+	encPayload, encMAC, ok := strings.Cut(signed, ".")
+	if !ok {
+		return "", invalid
+	}
+	enc := base64.RawURLEncoding
+	payload, err := enc.DecodeString(encPayload)
+	if err != nil {
+		return "", invalid
+	}
+	mac, err := enc.DecodeString(encMAC)
+	if err != nil {
+		return "", invalid
+	}
+	verified := false
+	for _, key := range o.cursorKeys {
+		if hmac.Equal(mac, cursorMAC(key, payload)) {
+			verified = true
+			break
+		}
+	}
+	if !verified {
+		return "", invalid
+	}
+
+	var env cursorEnvelope
+	if err := json.Unmarshal(payload, &env); err != nil {
+		return "", invalid
+	}
+	switch {
+	case env.Version != cursorVersion:
+		return "", fmt.Errorf("%w: cursor version %d is no longer supported; start again from the first page", domain.ErrInvalidInput, env.Version)
+	case env.Sort != scope.sort || env.Order != string(scope.order):
+		return "", fmt.Errorf("%w: cursor was issued for sort %s %s, not %s %s", domain.ErrInvalidInput, env.Sort, env.Order, scope.sort, scope.order)
+	case env.Filter != scope.filter:
+		return "", fmt.Errorf("%w: cursor was issued for a different filter", domain.ErrInvalidInput)
+	}
+	return env.Pos, nil
+}
+
+// cursorMAC is the HMAC-SHA256 of payload under key
+func cursorMAC(key, payload []byte) []byte {
+	h := hmac.New(sha256.New, key)
+	h.Write(payload)
+	return h.Sum(nil)
+}
@@ -2,27 +2,31 @@ package postgres
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/CodeWarrior-debug/perspectize/backend/internal/core/domain"
 	"github.com/CodeWarrior-debug/perspectize/backend/internal/core/ports/repositories"
 	paginator "github.com/pilagod/gorm-cursor-paginator/v2/paginator"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 // GormContentRepository implements the ContentRepository interface using GORM
 type GormContentRepository struct {
-	db *gorm.DB
+	db   *gorm.DB
+	opts repositoryOptions
 }
 
 // Compile-time interface check
 var _ repositories.ContentRepository = (*GormContentRepository)(nil)
 
 // NewGormContentRepository creates a new GORM content repository
-func NewGormContentRepository(db *gorm.DB) *GormContentRepository {
-	return &GormContentRepository{db: db}
+func NewGormContentRepository(db *gorm.DB, opts ...RepositoryOption) *GormContentRepository {
+	return &GormContentRepository{db: db, opts: newRepositoryOptions(opts)}
 }
 
 // Create inserts a new content record into the database
@@ -30,6 +34,14 @@ func (r *GormContentRepository) Create(ctx context.Context, content *domain.Cont
 	model := contentDomainToModel(content)
 
 	if err := r.db.WithContext(ctx).Create(model).Error; err != nil {
+		switch {
+		case isUniqueViolation(err, "content_unique_canonical_key"):
+			return nil, fmt.Errorf("%w: content for %s already exists", domain.ErrAlreadyExists, *model.CanonicalKey)
+		case isUniqueViolation(err, "content_unique_url"):
+			return nil, fmt.Errorf("%w: content for URL %s already exists", domain.ErrAlreadyExists, *model.URL)
+		case isUniqueViolation(err, "content_unique_name"):
+			return nil, fmt.Errorf("%w: content named %q already exists", domain.ErrAlreadyExists, model.Name)
+		}
 		return nil, fmt.Errorf("failed to insert content: %w", err)
 	}
 
@@ -50,10 +62,12 @@ func (r *GormContentRepository) GetByID(ctx context.Context, id int) (*domain.Co
 	return contentModelToDomain(&model), nil
 }
 
-// GetByURL retrieves a content record by its URL
+// GetByURL retrieves a content record by its URL or one of its aliases
 func (r *GormContentRepository) GetByURL(ctx context.Context, url string) (*domain.Content, error) {
 	var model ContentModel
-	err := r.db.WithContext(ctx).Where("url = ?", url).First(&model).Error
+	err := r.db.WithContext(ctx).
+		Where("(url = ? OR id IN (SELECT content_id FROM content_aliases WHERE url = ?))", url, url).
+		First(&model).Error
 	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return nil, domain.ErrNotFound
@@ -64,8 +78,49 @@ func (r *GormContentRepository) GetByURL(ctx context.Context, url string) (*doma
 	return contentModelToDomain(&model), nil
 }
 
+// GetByCanonicalKey retrieves a content record by its provider-level key
+func (r *GormContentRepository) GetByCanonicalKey(ctx context.Context, key string) (*domain.Content, error) {
+	var model ContentModel
+	err := r.db.WithContext(ctx).Where("canonical_key = ?", key).First(&model).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, domain.ErrNotFound
+		}
+		return nil, fmt.Errorf("failed to get content by canonical key: %w", err)
+	}
+
+	return contentModelToDomain(&model), nil
+}
+
+// AddAlias records url as another address of content. Re-adding a known
+// alias is a no-op.
+func (r *GormContentRepository) AddAlias(ctx context.Context, contentID int, url string) error {
+	alias := &ContentAliasModel{ContentID: contentID, URL: url}
+	err := r.db.WithContext(ctx).
+		Clauses(clause.OnConflict{Columns: []clause.Column{{Name: "url"}}, DoNothing: true}).
+		Create(alias).Error
+	if err != nil {
+		return fmt.Errorf("failed to insert content alias: %w", err)
+	}
+	return nil
+}
+
 // List retrieves a paginated list of content using cursor-based pagination
 func (r *GormContentRepository) List(ctx context.Context, params domain.ContentListParams) (*domain.PaginatedContent, error) {
+	if params.Filter != nil && params.Filter.SearchMode == domain.SearchModeFuzzy && hasSearch(params.Filter.Search) {
+		var result *domain.PaginatedContent
+		err := withSimilarityThreshold(ctx, r.db, r.opts.similarityThreshold, func(tx *gorm.DB) error {
+			var err error
+			result, err = r.list(tx, params)
+			return err
+		})
+		return result, err
+	}
+	return r.list(r.db.WithContext(ctx), params)
+}
+
+// list runs a List query on db, which carries the request context
+func (r *GormContentRepository) list(db *gorm.DB, params domain.ContentListParams) (*domain.PaginatedContent, error) {
 	limit := 10
 	if params.First != nil {
 		limit = *params.First
@@ -80,70 +135,673 @@ func (r *GormContentRepository) List(ctx context.Context, params domain.ContentL
 		paginator.WithLimit(limit),
 		paginator.WithAllowTupleCmp(paginator.TRUE),
 	}
+	scope, err := newCursorScope(string(params.SortBy), params.SortOrder, params.Filter)
+	if err != nil {
+		return nil, err
+	}
 	if params.After != nil {
-		opts = append(opts, paginator.WithAfter(*params.After))
+		after, err := r.opts.verifyCursor(scope, *params.After)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, paginator.WithAfter(after))
 	}
 	p := paginator.New(opts...)
 
 	// Start query with context and apply filters BEFORE pagination
-	query := r.db.WithContext(ctx).Model(&ContentModel{})
-
-	// Apply filters via GORM chaining
-	if params.Filter != nil {
-		if params.Filter.ContentType != nil {
-			query = query.Where("content_type = ?", strings.ToLower(string(*params.Filter.ContentType)))
-		}
-		if params.Filter.MinLengthSeconds != nil {
-			query = query.Where("length >= ?", *params.Filter.MinLengthSeconds)
-		}
-		if params.Filter.MaxLengthSeconds != nil {
-			query = query.Where("length <= ?", *params.Filter.MaxLengthSeconds)
-		}
-		if params.Filter.Search != nil && *params.Filter.Search != "" {
-			query = query.Where("name ILIKE ?", "%"+*params.Filter.Search+"%")
-		}
+	query, search, err := filteredContent(db, params.Filter)
+	if err != nil {
+		return nil, err
 	}
 
 	// Total count (before cursor/limit — respects filters only)
 	var totalCountInt *int
+	var totalCountExact bool
 	if params.IncludeTotalCount {
-		// Clone query to avoid Paginate() modifying count query
-		countQuery := query.Session(&gorm.Session{})
-		var count int64
-		if err := countQuery.Count(&count).Error; err != nil {
+		count, exact, err := r.opts.countRows(query, params.CountStrategy, contentCountKey(params.Filter))
+		if err != nil {
 			return nil, fmt.Errorf("failed to count content: %w", err)
 		}
-		countInt := int(count)
-		totalCountInt = &countInt
+		totalCountInt, totalCountExact = &count, exact
 	}
 
 	// Execute pagination
 	var models []ContentModel
-	_, cursor, err := p.Paginate(query, &models)
+	_, cursor, err := p.Paginate(rankedContent(db, query, search), &models)
 	if err != nil {
 		return nil, fmt.Errorf("failed to list content: %w", err)
 	}
 
-	// Map results to domain
-	items := make([]*domain.Content, len(models))
-	for i := range models {
-		items[i] = contentModelToDomain(&models[i])
+	items, err := contentModelsToDomain(db, models, search)
+	if err != nil {
+		return nil, err
 	}
 
 	result := &domain.PaginatedContent{
-		Items:      items,
-		HasNext:    cursor.After != nil,
-		HasPrev:    cursor.Before != nil,
-		TotalCount: totalCountInt,
+		Items:           items,
+		HasNext:         cursor.After != nil,
+		HasPrev:         cursor.Before != nil,
+		TotalCount:      totalCountInt,
+		TotalCountExact: totalCountExact,
 	}
 
 	// StartCursor = cursor.Before, EndCursor = cursor.After
-	result.StartCursor = cursor.Before
-	result.EndCursor = cursor.After
+	if result.StartCursor, err = r.opts.signCursor(scope, cursor.Before); err != nil {
+		return nil, err
+	}
+	if result.EndCursor, err = r.opts.signCursor(scope, cursor.After); err != nil {
+		return nil, err
+	}
 
 	return result, nil
 }
 
+// ListPage retrieves one page of content by page number, using OFFSET
+func (r *GormContentRepository) ListPage(ctx context.Context, params domain.ContentPageParams) (*domain.ContentPage, error) {
+	if params.Filter != nil && params.Filter.SearchMode == domain.SearchModeFuzzy && hasSearch(params.Filter.Search) {
+		var result *domain.ContentPage
+		err := withSimilarityThreshold(ctx, r.db, r.opts.similarityThreshold, func(tx *gorm.DB) error {
+			var err error
+			result, err = r.listPage(tx, params)
+			return err
+		})
+		return result, err
+	}
+	return r.listPage(r.db.WithContext(ctx), params)
+}
+
+// listPage runs a ListPage query on db, which carries the request context
+func (r *GormContentRepository) listPage(db *gorm.DB, params domain.ContentPageParams) (*domain.ContentPage, error) {
+	query, search, err := filteredContent(db, params.Filter)
+	if err != nil {
+		return nil, err
+	}
+
+	count, exact, err := r.opts.countRows(query, params.CountStrategy, contentCountKey(params.Filter))
+	if err != nil {
+		return nil, fmt.Errorf("failed to count content: %w", err)
+	}
+
+	// The paginator orders the rows exactly as List does. The row before the
+	// page is fetched too: its cursor is where List resumes at this page.
+	rules := buildContentSortRules(params.SortBy, params.SortOrder)
+	offset := (params.Page - 1) * params.PageSize
+	lead := 0
+	if offset > 0 {
+		lead = 1
+	}
+	p := paginator.New(
+		paginator.WithRules(rules...),
+		paginator.WithLimit(params.PageSize+lead),
+		paginator.WithAllowTupleCmp(paginator.TRUE),
+	)
+
+	var models []ContentModel
+	if _, _, err := p.Paginate(rankedContent(db, query, search).Offset(offset-lead), &models); err != nil {
+		return nil, fmt.Errorf("failed to list content page: %w", err)
+	}
+
+	page := &domain.ContentPage{
+		Page:            params.Page,
+		PageSize:        params.PageSize,
+		TotalCount:      count,
+		TotalPages:      (count + params.PageSize - 1) / params.PageSize,
+		TotalCountExact: exact,
+	}
+	if len(models) <= lead {
+		// Past the last page
+		page.Items = []*domain.Content{}
+		return page, nil
+	}
+	// Signed as List signs them, so List accepts them
+	scope, err := newCursorScope(string(params.SortBy), params.SortOrder, params.Filter)
+	if err != nil {
+		return nil, err
+	}
+	if lead > 0 {
+		if page.StartCursor, err = r.pageCursor(scope, rules, models[0]); err != nil {
+			return nil, err
+		}
+		models = models[lead:]
+	}
+	if page.EndCursor, err = r.pageCursor(scope, rules, models[len(models)-1]); err != nil {
+		return nil, err
+	}
+
+	if page.Items, err = contentModelsToDomain(db, models, search); err != nil {
+		return nil, err
+	}
+	return page, nil
+}
+
+// pageCursor returns the signed cursor of model under rules
+func (r *GormContentRepository) pageCursor(scope cursorScope, rules []paginator.Rule, model ContentModel) (*string, error) {
+	pos, err := encodeCursor(rules, model)
+	if err != nil {
+		return nil, err
+	}
+	return r.opts.signCursor(scope, pos)
+}
+
+// contentCountKey keys cached content counts by filter
+func contentCountKey(f *domain.ContentFilter) func() (string, error) {
+	return func() (string, error) { return countKey("content", f) }
+}
+
+// rankedContent returns query ready to page. Relevance paging compares on
+// the rank, so it must be a column the paginator can address: rank in a
+// subquery aliased as the content table.
+func rankedContent(db, query *gorm.DB, search *contentSearch) *gorm.DB {
+	if search == nil {
+		return query
+	}
+	ranked := query.Select("content.*, "+search.rank+" AS search_rank", search.args...)
+	return db.Table("(?) AS content", ranked)
+}
+
+// contentModelsToDomain maps listed rows to domain content, with search
+// matches when the listing searched
+func contentModelsToDomain(db *gorm.DB, models []ContentModel, search *contentSearch) ([]*domain.Content, error) {
+	items := make([]*domain.Content, len(models))
+	for i := range models {
+		items[i] = contentModelToDomain(&models[i])
+		if search != nil {
+			// Trigram matches have no matched words to highlight
+			items[i].SearchMatch = &domain.ContentSearchMatch{
+				Rank:          models[i].SearchRank,
+				NameHighlight: models[i].Name,
+			}
+		}
+	}
+	if search != nil && search.tsQuery != "" {
+		if err := highlightSearchMatches(db, search.tsQuery, items); err != nil {
+			return nil, err
+		}
+	}
+	return items, nil
+}
+
+// filteredContent narrows db to content matching f. Listings and facet
+// counts both start here so they always agree. search is nil unless f has one.
+func filteredContent(db *gorm.DB, f *domain.ContentFilter) (*gorm.DB, *contentSearch, error) {
+	query := db.Model(&ContentModel{})
+	if f == nil {
+		return query, nil, nil
+	}
+	query = applyContentFilter(query, f)
+
+	// Search: full-text over name, channel, tags and description, or trigram
+	// similarity to name and channel
+	if !hasSearch(f.Search) {
+		return query, nil, nil
+	}
+	search, err := newContentSearch(*f.Search, f.SearchMode)
+	if err != nil {
+		return nil, nil, err
+	}
+	return query.Where(search.where, search.args...), search, nil
+}
+
+// applyContentFilter adds f's conditions, other than search, to query.
+// Columns are qualified so the conditions hold inside the search subquery.
+func applyContentFilter(query *gorm.DB, f *domain.ContentFilter) *gorm.DB {
+	if f.ContentType != nil {
+		query = query.Where("content.content_type = ?", strings.ToLower(string(*f.ContentType)))
+	}
+	if f.MinLengthSeconds != nil {
+		query = query.Where("content.length >= ?", *f.MinLengthSeconds)
+	}
+	if f.MaxLengthSeconds != nil {
+		query = query.Where("content.length <= ?", *f.MaxLengthSeconds)
+	}
+
+	query = whereTimeRange(query, "content.published_at", f.PublishedAt)
+	query = whereTimeRange(query, "content.created_at", f.CreatedAt)
+
+	if len(f.ChannelTitles) > 0 {
+		query = query.Where("content.channel_title IN ?", f.ChannelTitles)
+	}
+	// Array operators, so the GIN index on tags applies
+	if len(f.TagsAny) > 0 {
+		query = query.Where("content.tags && ?::text[]", StringArray(f.TagsAny))
+	}
+	if len(f.TagsAll) > 0 {
+		query = query.Where("content.tags @> ?::text[]", StringArray(f.TagsAll))
+	}
+	if f.AddedByUserID != nil {
+		query = query.Where("content.added_by_user_id = ?", *f.AddedByUserID)
+	}
+	if f.MinViewCount != nil {
+		query = query.Where("content.view_count >= ?", *f.MinViewCount)
+	}
+
+	if f.MinPerspectives != nil && *f.MinPerspectives > 0 {
+		query = query.Where(
+			"(SELECT count(*) FROM perspectives WHERE perspectives.content_id = content.id) >= ?",
+			*f.MinPerspectives,
+		)
+	}
+	if f.WithoutPerspectivesFromUserID != nil {
+		query = query.Where(
+			"NOT EXISTS (SELECT 1 FROM perspectives WHERE perspectives.content_id = content.id AND perspectives.user_id = ?)",
+			*f.WithoutPerspectivesFromUserID,
+		)
+	}
+	return query
+}
+
+// contentSearch is the SQL for one content search. where and rank each take
+// args as their bind values.
+type contentSearch struct {
+	where   string
+	rank    string
+	args    []interface{}
+	tsQuery string // Set for full-text searches
+}
+
+// newContentSearch builds the predicate and rank expression for a search
+func newContentSearch(search string, mode domain.SearchMode) (*contentSearch, error) {
+	if mode == domain.SearchModeFuzzy {
+		term := strings.TrimSpace(search)
+		return &contentSearch{
+			where: "(content.name % ? OR content.channel_title % ?)",
+			rank:  "GREATEST(similarity(content.name, ?), similarity(COALESCE(content.channel_title, ''), ?))::float8",
+			args:  []interface{}{term, term},
+		}, nil
+	}
+
+	parsed, err := domain.ParseSearchQuery(search)
+	if err != nil {
+		return nil, err
+	}
+	tsQuery := toTSQuery(parsed)
+	return &contentSearch{
+		where:   "content.search_vector @@ to_tsquery('english', ?)",
+		rank:    "ts_rank(content.search_vector, to_tsquery('english', ?))::float8",
+		args:    []interface{}{tsQuery},
+		tsQuery: tsQuery,
+	}, nil
+}
+
+// hasSearch reports whether a search filter has anything to search for
+func hasSearch(search *string) bool {
+	return search != nil && strings.TrimSpace(*search) != ""
+}
+
+// contentHighlightRow is search headlines scanned from raw SQL
+type contentHighlightRow struct {
+	ID                   int
+	NameHighlight        string
+	DescriptionHighlight *string
+}
+
+// highlightSearchMatches fills in headlines for one page of full-text
+// results. ts_headline re-parses the text, so it runs only for the rows
+// returned.
+func highlightSearchMatches(db *gorm.DB, tsQuery string, items []*domain.Content) error {
+	if len(items) == 0 {
+		return nil
+	}
+	byID := make(map[int]*domain.Content, len(items))
+	ids := make([]int, len(items))
+	for i, c := range items {
+		byID[c.ID] = c
+		ids[i] = c.ID
+	}
+
+	var rows []contentHighlightRow
+	err := db.
+		Table("content").
+		Select(`content.id,
+			ts_headline('english', content.name, q, 'StartSel=<b>, StopSel=</b>, HighlightAll=true') AS name_highlight,
+			CASE WHEN COALESCE(content.description, '') <> '' THEN
+				ts_headline('english', content.description, q,
+					'StartSel=<b>, StopSel=</b>, MaxFragments=2, MinWords=15, MaxWords=35, FragmentDelimiter=" … "')
+			END AS description_highlight`).
+		Joins("CROSS JOIN to_tsquery('english', ?) AS q", tsQuery).
+		Where("content.id IN ?", ids).
+		Scan(&rows).Error
+	if err != nil {
+		return fmt.Errorf("failed to highlight search results: %w", err)
+	}
+
+	for _, row := range rows {
+		match := byID[row.ID].SearchMatch
+		match.NameHighlight = row.NameHighlight
+		match.DescriptionHighlight = row.DescriptionHighlight
+	}
+	return nil
+}
+
+// ListRefreshDue returns available YouTube content neither updated nor
+// unsuccessfully refreshed since before, least recently tried first, up to
+// limit rows
+func (r *GormContentRepository) ListRefreshDue(ctx context.Context, before time.Time, limit int) ([]*domain.Content, error) {
+	var models []ContentModel
+	err := r.db.WithContext(ctx).
+		Where("content_type = ? AND unavailable_at IS NULL AND GREATEST(updated_at, refresh_attempted_at) < ?",
+			contentTypeToDBValue(domain.ContentTypeYouTube), before).
+		Order("GREATEST(updated_at, refresh_attempted_at) ASC").
+		Limit(limit).
+		Find(&models).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to list stale content: %w", err)
+	}
+
+	contents := make([]*domain.Content, len(models))
+	for i := range models {
+		contents[i] = contentModelToDomain(&models[i])
+	}
+	return contents, nil
+}
+
+// MarkRefreshFailed records a failed metadata refresh of the given content
+// at at. updated_at is left alone: the content itself did not change.
+func (r *GormContentRepository) MarkRefreshFailed(ctx context.Context, ids []int, at time.Time) error {
+	if len(ids) == 0 {
+		return nil
+	}
+	err := r.db.WithContext(ctx).Model(&ContentModel{}).
+		Where("id IN ?", ids).
+		UpdateColumn("refresh_attempted_at", at).Error
+	if err != nil {
+		return fmt.Errorf("failed to record refresh attempt: %w", err)
+	}
+	return nil
+}
+
+// MarkUnavailable flags the given content as no longer returned by its
+// provider as of at
+func (r *GormContentRepository) MarkUnavailable(ctx context.Context, ids []int, at time.Time) error {
+	if len(ids) == 0 {
+		return nil
+	}
+	err := r.db.WithContext(ctx).Model(&ContentModel{}).
+		Where("id IN ? AND unavailable_at IS NULL", ids).
+		UpdateColumns(map[string]interface{}{"unavailable_at": at, "refresh_attempted_at": at}).Error
+	if err != nil {
+		return fmt.Errorf("failed to mark content unavailable: %w", err)
+	}
+	return nil
+}
+
+// Update saves changes to an existing content record
+func (r *GormContentRepository) Update(ctx context.Context, content *domain.Content) (*domain.Content, error) {
+	model := contentDomainToModel(content)
+
+	result := r.db.WithContext(ctx).Model(&ContentModel{ID: model.ID}).Updates(map[string]interface{}{
+		"name":           model.Name,
+		"url":            model.URL,
+		"canonical_key":  model.CanonicalKey,
+		"length":         model.Length,
+		"length_units":   model.LengthUnits,
+		"channel_title":  model.ChannelTitle,
+		"description":    model.Description,
+		"published_at":   model.PublishedAt,
+		"tags":           model.Tags,
+		"view_count":     model.ViewCount,
+		"like_count":     model.LikeCount,
+		"comment_count":  model.CommentCount,
+		"response":       model.Response,
+		"unavailable_at": model.UnavailableAt,
+	})
+	if result.Error != nil {
+		if isUniqueViolation(result.Error, "content_unique_name") {
+			return nil, fmt.Errorf("%w: content named %q already exists", domain.ErrAlreadyExists, model.Name)
+		}
+		return nil, fmt.Errorf("failed to update content: %w", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return nil, domain.ErrNotFound
+	}
+
+	// Re-read to get updated timestamps
+	return r.GetByID(ctx, model.ID)
+}
+
+// Delete soft-deletes a content record. Perspectives referencing the content
+// are handled according to policy inside the same transaction.
+func (r *GormContentRepository) Delete(ctx context.Context, id int, policy domain.ContentDeletePolicy) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		switch policy {
+		case domain.ContentDeletePolicyDetach:
+			if err := tx.Model(&PerspectiveModel{}).
+				Where("content_id = ?", id).
+				Update("content_id", nil).Error; err != nil {
+				return fmt.Errorf("failed to detach perspectives: %w", err)
+			}
+		default:
+			var count int64
+			if err := tx.Model(&PerspectiveModel{}).Where("content_id = ?", id).Count(&count).Error; err != nil {
+				return fmt.Errorf("failed to count perspectives: %w", err)
+			}
+			if count > 0 {
+				return fmt.Errorf("%w: %d perspective(s) reference content %d", domain.ErrContentInUse, count, id)
+			}
+		}
+
+		result := tx.Delete(&ContentModel{}, id)
+		if result.Error != nil {
+			return fmt.Errorf("failed to delete content: %w", result.Error)
+		}
+		if result.RowsAffected == 0 {
+			return domain.ErrNotFound
+		}
+		return nil
+	})
+}
+
+// Merge folds source content into target in one transaction: perspectives,
+// stats history and aliases move to target, the source URL becomes an alias
+// of target, the source is soft-deleted, and the merge is written to the
+// audit log.
+func (r *GormContentRepository) Merge(ctx context.Context, sourceID, targetID int) (*domain.ContentMergeResult, error) {
+	var target ContentModel
+	var moved int64
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var source ContentModel
+		if err := tx.First(&source, sourceID).Error; err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return fmt.Errorf("%w: source content %d", domain.ErrNotFound, sourceID)
+			}
+			return fmt.Errorf("failed to get source content: %w", err)
+		}
+		if err := tx.First(&target, targetID).Error; err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return fmt.Errorf("%w: target content %d", domain.ErrNotFound, targetID)
+			}
+			return fmt.Errorf("failed to get target content: %w", err)
+		}
+
+		result := tx.Model(&PerspectiveModel{}).
+			Where("content_id = ?", sourceID).
+			Update("content_id", targetID)
+		if result.Error != nil {
+			return fmt.Errorf("failed to reassign perspectives: %w", result.Error)
+		}
+		moved = result.RowsAffected
+
+		if err := tx.Model(&ContentStatsSnapshotModel{}).
+			Where("content_id = ?", sourceID).
+			Update("content_id", targetID).Error; err != nil {
+			return fmt.Errorf("failed to reassign stats history: %w", err)
+		}
+		if err := tx.Model(&ContentAliasModel{}).
+			Where("content_id = ?", sourceID).
+			Update("content_id", targetID).Error; err != nil {
+			return fmt.Errorf("failed to reassign aliases: %w", err)
+		}
+		// Keep the source's transcript only if target has none; segments follow
+		// via ON UPDATE CASCADE
+		if err := tx.Exec(`UPDATE content_transcripts SET content_id = ?
+			WHERE content_id = ? AND NOT EXISTS (SELECT 1 FROM content_transcripts WHERE content_id = ?)`,
+			targetID, sourceID, targetID).Error; err != nil {
+			return fmt.Errorf("failed to reassign transcript: %w", err)
+		}
+		if source.URL != nil {
+			alias := &ContentAliasModel{ContentID: targetID, URL: *source.URL}
+			if err := tx.Clauses(clause.OnConflict{Columns: []clause.Column{{Name: "url"}}, DoNothing: true}).
+				Create(alias).Error; err != nil {
+				return fmt.Errorf("failed to insert content alias: %w", err)
+			}
+		}
+
+		if err := tx.Delete(&ContentModel{}, sourceID).Error; err != nil {
+			return fmt.Errorf("failed to delete source content: %w", err)
+		}
+
+		details, err := json.Marshal(map[string]any{
+			"source_id":          sourceID,
+			"target_id":          targetID,
+			"source_url":         source.URL,
+			"perspectives_moved": moved,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to encode audit details: %w", err)
+		}
+		entry := &AuditLogModel{
+			Action:     string(domain.AuditActionContentMerge),
+			EntityType: "content",
+			EntityID:   sourceID,
+			Details:    details,
+		}
+		if err := tx.Create(entry).Error; err != nil {
+			return fmt.Errorf("failed to write audit log: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &domain.ContentMergeResult{
+		Target:            contentModelToDomain(&target),
+		PerspectivesMoved: int(moved),
+	}, nil
+}
+
+// RecordStatsSnapshot inserts a point-in-time statistics row for content
+func (r *GormContentRepository) RecordStatsSnapshot(ctx context.Context, snapshot *domain.ContentStatsSnapshot) error {
+	model := &ContentStatsSnapshotModel{
+		ContentID:    snapshot.ContentID,
+		CapturedAt:   snapshot.CapturedAt,
+		ViewCount:    snapshot.ViewCount,
+		LikeCount:    snapshot.LikeCount,
+		CommentCount: snapshot.CommentCount,
+	}
+	if err := r.db.WithContext(ctx).Create(model).Error; err != nil {
+		return fmt.Errorf("failed to insert stats snapshot: %w", err)
+	}
+	return nil
+}
+
+// statsBucketRow is the scan target for bucketed stats history
+type statsBucketRow struct {
+	BucketStart  time.Time
+	ViewCount    *int64
+	LikeCount    *int64
+	CommentCount *int64
+	Samples      int
+}
+
+// ListStatsHistory returns stats snapshots for content grouped into day or
+// week buckets (UTC), oldest first. Each bucket reports the highest count seen.
+func (r *GormContentRepository) ListStatsHistory(ctx context.Context, params domain.ContentStatsHistoryParams) ([]*domain.ContentStatsBucket, error) {
+	unit := statsGranularityToTruncUnit(params.Granularity)
+
+	query := r.db.WithContext(ctx).
+		Model(&ContentStatsSnapshotModel{}).
+		Select(`date_trunc(?, captured_at AT TIME ZONE 'UTC') AS bucket_start,
+			MAX(view_count) AS view_count,
+			MAX(like_count) AS like_count,
+			MAX(comment_count) AS comment_count,
+			COUNT(*) AS samples`, unit).
+		Where("content_id = ?", params.ContentID)
+	if params.From != nil {
+		query = query.Where("captured_at >= ?", *params.From)
+	}
+	if params.To != nil {
+		query = query.Where("captured_at < ?", *params.To)
+	}
+
+	var rows []statsBucketRow
+	if err := query.Group("bucket_start").Order("bucket_start ASC").Scan(&rows).Error; err != nil {
+		return nil, fmt.Errorf("failed to list stats history: %w", err)
+	}
+
+	buckets := make([]*domain.ContentStatsBucket, len(rows))
+	for i, row := range rows {
+		buckets[i] = &domain.ContentStatsBucket{
+			BucketStart:  row.BucketStart.UTC(),
+			ViewCount:    row.ViewCount,
+			LikeCount:    row.LikeCount,
+			CommentCount: row.CommentCount,
+			Samples:      row.Samples,
+		}
+	}
+	return buckets, nil
+}
+
+// facetValueRow is one facet value and its count scanned from SQL
+type facetValueRow struct {
+	Value string `gorm:"column:facet_value"`
+	Count int    `gorm:"column:facet_count"`
+}
+
+// Facets counts content matching params.Filter by each requested facet
+func (r *GormContentRepository) Facets(ctx context.Context, params domain.ContentFacetParams) ([]*domain.ContentFacetCounts, error) {
+	query, _, err := filteredContent(r.db.WithContext(ctx), params.Filter)
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]*domain.ContentFacetCounts, len(params.Facets))
+	for i, facet := range params.Facets {
+		// Each facet is its own GROUP BY over a fresh copy of the filtered query
+		facetQuery := query.Session(&gorm.Session{})
+		var expr string
+		switch facet {
+		case domain.ContentFacetContentType:
+			expr = "upper(content.content_type)"
+		case domain.ContentFacetChannel:
+			expr = "content.channel_title"
+		case domain.ContentFacetTag:
+			facetQuery = facetQuery.Joins("CROSS JOIN LATERAL unnest(content.tags) AS tag")
+			expr = "tag"
+		case domain.ContentFacetLengthBucket:
+			expr = fmt.Sprintf(
+				"CASE WHEN content.length IS NULL THEN NULL WHEN content.length < %d THEN '%s' WHEN content.length < %d THEN '%s' ELSE '%s' END",
+				domain.LengthBucketShortMaxSeconds, domain.LengthBucketShort,
+				domain.LengthBucketMediumMaxSeconds, domain.LengthBucketMedium,
+				domain.LengthBucketLong,
+			)
+		case domain.ContentFacetPublishYear:
+			expr = "extract(year FROM content.published_at AT TIME ZONE 'UTC')::int::text"
+		default:
+			return nil, fmt.Errorf("%w: unknown facet %q", domain.ErrInvalidInput, facet)
+		}
+
+		var rows []facetValueRow
+		err := facetQuery.
+			Select(expr + " AS facet_value, count(DISTINCT content.id) AS facet_count").
+			Where(expr + " IS NOT NULL").
+			Group("facet_value").
+			Order("facet_count DESC, facet_value ASC").
+			Limit(domain.MaxFacetValues).
+			Scan(&rows).Error
+		if err != nil {
+			return nil, fmt.Errorf("failed to count %s facet: %w", facet, err)
+		}
+
+		values := make([]domain.FacetValue, len(rows))
+		for j, row := range rows {
+			values[j] = domain.FacetValue{Value: row.Value, Count: row.Count}
+		}
+		results[i] = &domain.ContentFacetCounts{Facet: facet, Values: values}
+	}
+	return results, nil
+}
+
 // ReassignByUser updates all content owned by fromUserID to toUserID
 func (r *GormContentRepository) ReassignByUser(ctx context.Context, fromUserID, toUserID int) error {
 	return r.db.WithContext(ctx).
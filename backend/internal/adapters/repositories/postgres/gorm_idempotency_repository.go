@@ -0,0 +1,82 @@
+package postgres
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/CodeWarrior-debug/perspectize/backend/internal/core/domain"
+	"github.com/CodeWarrior-debug/perspectize/backend/internal/core/ports/repositories"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// GormIdempotencyRepository implements the IdempotencyRepository interface using GORM
+type GormIdempotencyRepository struct {
+	db *gorm.DB
+}
+
+// Compile-time interface check
+var _ repositories.IdempotencyRepository = (*GormIdempotencyRepository)(nil)
+
+// NewGormIdempotencyRepository creates a new GORM idempotency key repository
+func NewGormIdempotencyRepository(db *gorm.DB) *GormIdempotencyRepository {
+	return &GormIdempotencyRepository{db: db}
+}
+
+// Reserve inserts a pending record for key, or returns the record that
+// already holds it. A pending record older than pendingBefore belongs to a
+// request that crashed or hung before finishing, so it is taken over.
+func (r *GormIdempotencyRepository) Reserve(ctx context.Context, key, requestHash string, expiresBefore, pendingBefore time.Time) (*domain.IdempotencyRecord, bool, error) {
+	if err := r.db.WithContext(ctx).
+		Where("key = ? AND (created_at < ? OR (response IS NULL AND created_at < ?))", key, expiresBefore, pendingBefore).
+		Delete(&IdempotencyKeyModel{}).Error; err != nil {
+		return nil, false, fmt.Errorf("failed to expire idempotency key: %w", err)
+	}
+
+	model := &IdempotencyKeyModel{Key: key, RequestHash: requestHash}
+	result := r.db.WithContext(ctx).Clauses(clause.OnConflict{DoNothing: true}).Create(model)
+	if result.Error != nil {
+		return nil, false, fmt.Errorf("failed to reserve idempotency key: %w", result.Error)
+	}
+	if result.RowsAffected == 1 {
+		return nil, true, nil
+	}
+
+	var existing IdempotencyKeyModel
+	if err := r.db.WithContext(ctx).Where("key = ?", key).First(&existing).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			// Released between our insert and read; let the caller retry
+			return nil, false, fmt.Errorf("failed to reserve idempotency key: %w", domain.ErrNotFound)
+		}
+		return nil, false, fmt.Errorf("failed to get idempotency key: %w", err)
+	}
+	return &domain.IdempotencyRecord{
+		Key:         existing.Key,
+		RequestHash: existing.RequestHash,
+		Response:    existing.Response,
+		CreatedAt:   existing.CreatedAt,
+	}, false, nil
+}
+
+// Complete stores the response for a reserved key
+func (r *GormIdempotencyRepository) Complete(ctx context.Context, key string, response json.RawMessage) error {
+	err := r.db.WithContext(ctx).
+		Model(&IdempotencyKeyModel{}).
+		Where("key = ?", key).
+		Update("response", response).Error
+	if err != nil {
+		return fmt.Errorf("failed to store idempotent response: %w", err)
+	}
+	return nil
+}
+
+// Release deletes a reserved key
+func (r *GormIdempotencyRepository) Release(ctx context.Context, key string) error {
+	if err := r.db.WithContext(ctx).Where("key = ?", key).Delete(&IdempotencyKeyModel{}).Error; err != nil {
+		return fmt.Errorf("failed to release idempotency key: %w", err)
+	}
+	return nil
+}
@@ -2,6 +2,7 @@ package postgres
 
 import (
 	"encoding/json"
+	"fmt"
 	"strings"
 
 	"github.com/CodeWarrior-debug/perspectize/backend/internal/core/domain"
@@ -45,11 +46,20 @@ func contentModelToDomain(m *ContentModel) *domain.Content {
 		ID:            m.ID,
 		Name:          m.Name,
 		URL:           m.URL,
+		CanonicalKey:  m.CanonicalKey,
 		ContentType:   domain.ContentType(strings.ToUpper(m.ContentType)),
 		AddedByUserID: m.AddedByUserID,
 		Length:        m.Length,
 		LengthUnits:   m.LengthUnits,
+		ChannelTitle:  m.ChannelTitle,
+		Description:   m.Description,
+		PublishedAt:   m.PublishedAt,
+		Tags:          m.Tags,
+		ViewCount:     m.ViewCount,
+		LikeCount:     m.LikeCount,
+		CommentCount:  m.CommentCount,
 		Response:      m.Response,
+		UnavailableAt: m.UnavailableAt,
 		CreatedAt:     m.CreatedAt,
 		UpdatedAt:     m.UpdatedAt,
 	}
@@ -64,11 +74,20 @@ func contentDomainToModel(c *domain.Content) *ContentModel {
 		ID:            c.ID,
 		Name:          c.Name,
 		URL:           c.URL,
+		CanonicalKey:  c.CanonicalKey,
 		ContentType:   strings.ToLower(string(c.ContentType)),
 		AddedByUserID: c.AddedByUserID,
 		Length:        c.Length,
 		LengthUnits:   c.LengthUnits,
+		ChannelTitle:  c.ChannelTitle,
+		Description:   c.Description,
+		PublishedAt:   c.PublishedAt,
+		Tags:          c.Tags,
+		ViewCount:     c.ViewCount,
+		LikeCount:     c.LikeCount,
+		CommentCount:  c.CommentCount,
 		Response:      c.Response,
+		UnavailableAt: c.UnavailableAt,
 		// CreatedAt and UpdatedAt are managed by GORM
 	}
 }
@@ -136,6 +155,35 @@ func perspectiveModelToDomain(m *PerspectiveModel) *domain.Perspective {
 	return p
 }
 
+// perspectiveSegmentModelToDomain converts a GORM PerspectiveSegmentModel to domain.PerspectiveSegment
+func perspectiveSegmentModelToDomain(m *PerspectiveSegmentModel) domain.PerspectiveSegment {
+	return domain.PerspectiveSegment{
+		Position:   m.Position,
+		StartMs:    m.StartMs,
+		EndMs:      m.EndMs,
+		Note:       m.Note,
+		Quality:    m.Quality,
+		Agreement:  m.Agreement,
+		Importance: m.Importance,
+		Confidence: m.Confidence,
+	}
+}
+
+// perspectiveSegmentDomainToModel converts a domain.PerspectiveSegment to GORM PerspectiveSegmentModel
+func perspectiveSegmentDomainToModel(perspectiveID int, s domain.PerspectiveSegment) PerspectiveSegmentModel {
+	return PerspectiveSegmentModel{
+		PerspectiveID: perspectiveID,
+		Position:      s.Position,
+		StartMs:       s.StartMs,
+		EndMs:         s.EndMs,
+		Note:          s.Note,
+		Quality:       s.Quality,
+		Agreement:     s.Agreement,
+		Importance:    s.Importance,
+		Confidence:    s.Confidence,
+	}
+}
+
 // perspectiveDomainToModel converts a domain.Perspective to GORM PerspectiveModel
 func perspectiveDomainToModel(p *domain.Perspective) *PerspectiveModel {
 	if p == nil {
@@ -193,3 +241,52 @@ func perspectiveDomainToModel(p *domain.Perspective) *PerspectiveModel {
 
 	return m
 }
+
+// youtubeCacheModelToDomain converts a GORM YouTubeVideoCacheModel to domain.YouTubeVideoCacheEntry
+func youtubeCacheModelToDomain(m *YouTubeVideoCacheModel) (*domain.YouTubeVideoCacheEntry, error) {
+	entry := &domain.YouTubeVideoCacheEntry{
+		VideoID: m.VideoID,
+		Item:    m.Item,
+	}
+	if err := json.Unmarshal(m.FetchedAt, &entry.FetchedAt); err != nil {
+		return nil, fmt.Errorf("failed to parse fetch times for video %s: %w", m.VideoID, err)
+	}
+	if m.ETag != nil {
+		entry.ETag = *m.ETag
+	}
+	if m.ETagParts != nil {
+		entry.ETagParts = *m.ETagParts
+	}
+	return entry, nil
+}
+
+// youtubeCacheDomainToModel converts a domain.YouTubeVideoCacheEntry to GORM YouTubeVideoCacheModel
+func youtubeCacheDomainToModel(e *domain.YouTubeVideoCacheEntry) (*YouTubeVideoCacheModel, error) {
+	fetchedAt, err := json.Marshal(e.FetchedAt)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode fetch times for video %s: %w", e.VideoID, err)
+	}
+	m := &YouTubeVideoCacheModel{
+		VideoID:   e.VideoID,
+		Item:      e.Item,
+		FetchedAt: fetchedAt,
+	}
+	if e.ETag != "" {
+		m.ETag = &e.ETag
+	}
+	if e.ETagParts != "" {
+		m.ETagParts = &e.ETagParts
+	}
+	return m, nil
+}
+
+// transcriptSegmentModelToDomain converts a GORM TranscriptSegmentModel to domain.TranscriptSegment
+func transcriptSegmentModelToDomain(m *TranscriptSegmentModel) *domain.TranscriptSegment {
+	return &domain.TranscriptSegment{
+		ContentID: m.ContentID,
+		Position:  m.Position,
+		StartMs:   m.StartMs,
+		EndMs:     m.EndMs,
+		Text:      m.Text,
+	}
+}
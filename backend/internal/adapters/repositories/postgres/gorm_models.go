@@ -3,6 +3,8 @@ package postgres
 import (
 	"encoding/json"
 	"time"
+
+	"gorm.io/gorm"
 )
 
 // UserModel is the GORM persistence model for users table
@@ -25,21 +27,27 @@ type ContentModel struct {
 	ID            int             `gorm:"primaryKey;autoIncrement"`
 	Name          string          `gorm:"not null"`
 	URL           *string         `gorm:"uniqueIndex"`
+	CanonicalKey  *string         `gorm:"column:canonical_key"`
 	ContentType   string          `gorm:"column:content_type;not null"`
 	AddedByUserID int             `gorm:"column:added_by_user_id;not null"`
 	Length        *int            `gorm:""`
 	LengthUnits   *string         `gorm:""`
+	ChannelTitle  *string         `gorm:"column:channel_title"`
+	Description   *string         `gorm:""`
+	PublishedAt   *time.Time      `gorm:"column:published_at"`
+	Tags          StringArray     `gorm:"type:text[]"`
+	ViewCount     *int64          `gorm:"column:view_count"`
+	LikeCount     *int64          `gorm:"column:like_count"`
+	CommentCount  *int64          `gorm:"column:comment_count"`
 	Response      json.RawMessage `gorm:"type:jsonb"`
+	UnavailableAt *time.Time      `gorm:"column:unavailable_at"`
 
-	// Dummy fields for gorm-cursor-paginator sort key validation.
-	// These are NOT database columns — SQLRepr provides the actual SQL.
-	// The gorm:"-" tag tells GORM to ignore them for queries/migrations.
-	ViewCount   int64  `gorm:"-"`
-	LikeCount   int64  `gorm:"-"`
-	PublishedAt string `gorm:"-"`
+	// Search rank, selected only when listing with a search; never written
+	SearchRank float64 `gorm:"->;column:search_rank"`
 
-	CreatedAt time.Time `gorm:"autoCreateTime"`
-	UpdatedAt time.Time `gorm:"autoUpdateTime"`
+	CreatedAt time.Time      `gorm:"autoCreateTime"`
+	UpdatedAt time.Time      `gorm:"autoUpdateTime"`
+	DeletedAt gorm.DeletedAt `gorm:"index"` // Soft delete — GORM excludes deleted rows from queries
 }
 
 // TableName returns the table name for ContentModel
@@ -47,6 +55,92 @@ func (ContentModel) TableName() string {
 	return "content"
 }
 
+// ContentAliasModel is the GORM persistence model for content_aliases table
+type ContentAliasModel struct {
+	ID        int       `gorm:"primaryKey;autoIncrement"`
+	ContentID int       `gorm:"not null"`
+	URL       string    `gorm:"not null"`
+	CreatedAt time.Time `gorm:"autoCreateTime"`
+}
+
+// TableName returns the table name for ContentAliasModel
+func (ContentAliasModel) TableName() string {
+	return "content_aliases"
+}
+
+// AuditLogModel is the GORM persistence model for audit_log table
+type AuditLogModel struct {
+	ID         int64           `gorm:"primaryKey;autoIncrement"`
+	Action     string          `gorm:"not null"`
+	EntityType string          `gorm:"not null"`
+	EntityID   int             `gorm:"not null"`
+	Details    json.RawMessage `gorm:"type:jsonb"`
+	CreatedAt  time.Time       `gorm:"autoCreateTime"`
+}
+
+// TableName returns the table name for AuditLogModel
+func (AuditLogModel) TableName() string {
+	return "audit_log"
+}
+
+// IdempotencyKeyModel is the GORM persistence model for idempotency_keys table
+type IdempotencyKeyModel struct {
+	Key         string          `gorm:"primaryKey"`
+	RequestHash string          `gorm:"not null"`
+	Response    json.RawMessage `gorm:"type:jsonb"`
+	CreatedAt   time.Time       `gorm:"autoCreateTime"`
+}
+
+// TableName returns the table name for IdempotencyKeyModel
+func (IdempotencyKeyModel) TableName() string {
+	return "idempotency_keys"
+}
+
+// ContentStatsSnapshotModel is the GORM persistence model for content_stats_snapshots table
+type ContentStatsSnapshotModel struct {
+	ID           int64     `gorm:"primaryKey;autoIncrement"`
+	ContentID    int       `gorm:"not null"`
+	CapturedAt   time.Time `gorm:"not null"`
+	ViewCount    *int64    `gorm:""`
+	LikeCount    *int64    `gorm:""`
+	CommentCount *int64    `gorm:""`
+}
+
+// TableName returns the table name for ContentStatsSnapshotModel
+func (ContentStatsSnapshotModel) TableName() string {
+	return "content_stats_snapshots"
+}
+
+// TranscriptModel is the GORM persistence model for content_transcripts table
+type TranscriptModel struct {
+	ContentID    int       `gorm:"primaryKey;autoIncrement:false"`
+	Language     string    `gorm:"not null"`
+	Source       string    `gorm:"not null"`
+	SegmentCount int       `gorm:"not null"`
+	CreatedAt    time.Time `gorm:"autoCreateTime"`
+	UpdatedAt    time.Time `gorm:"autoUpdateTime"`
+}
+
+// TableName returns the table name for TranscriptModel
+func (TranscriptModel) TableName() string {
+	return "content_transcripts"
+}
+
+// TranscriptSegmentModel is the GORM persistence model for transcript_segments
+// table; text_search is generated by the database
+type TranscriptSegmentModel struct {
+	ContentID int    `gorm:"primaryKey;autoIncrement:false"`
+	Position  int    `gorm:"primaryKey;autoIncrement:false"`
+	StartMs   int    `gorm:"not null"`
+	EndMs     int    `gorm:"not null"`
+	Text      string `gorm:"not null"`
+}
+
+// TableName returns the table name for TranscriptSegmentModel
+func (TranscriptSegmentModel) TableName() string {
+	return "transcript_segments"
+}
+
 // PerspectiveModel is the GORM persistence model for perspectives table
 type PerspectiveModel struct {
 	ID                 int         `gorm:"primaryKey;autoIncrement"`
@@ -64,11 +158,65 @@ type PerspectiveModel struct {
 	Description        *string     `gorm:""`
 	ReviewStatus       *string     `gorm:""`
 	CategorizedRatings JSONBArray  `gorm:"type:jsonb[];column:categorized_ratings"`
-	CreatedAt          time.Time   `gorm:"autoCreateTime"`
-	UpdatedAt          time.Time   `gorm:"autoUpdateTime"`
+
+	// Search rank and sort keys, selected only when listing with a search or
+	// by a computed sort; never written
+	SearchRank            float64 `gorm:"->;column:search_rank"`
+	SortCategorizedRating *int    `gorm:"->;column:sort_categorized_rating"`
+	SortContentMissing    bool    `gorm:"->;column:sort_content_missing"`
+	SortContentName       *string `gorm:"->;column:sort_content_name"`
+
+	CreatedAt time.Time `gorm:"autoCreateTime"`
+	UpdatedAt time.Time `gorm:"autoUpdateTime"`
 }
 
 // TableName returns the table name for PerspectiveModel
 func (PerspectiveModel) TableName() string {
 	return "perspectives"
 }
+
+// PerspectiveSegmentModel is the GORM persistence model for perspective_segments table
+type PerspectiveSegmentModel struct {
+	PerspectiveID int     `gorm:"primaryKey"`
+	Position      int     `gorm:"primaryKey"`
+	StartMs       int     `gorm:"not null"`
+	EndMs         int     `gorm:"not null"`
+	Note          *string `gorm:""`
+	Quality       *int    `gorm:""`
+	Agreement     *int    `gorm:""`
+	Importance    *int    `gorm:""`
+	Confidence    *int    `gorm:""`
+}
+
+// TableName returns the table name for PerspectiveSegmentModel
+func (PerspectiveSegmentModel) TableName() string {
+	return "perspective_segments"
+}
+
+// QuotaUsageModel is the GORM persistence model for youtube_quota_usage table
+type QuotaUsageModel struct {
+	Day       string    `gorm:"primaryKey;type:date"`
+	Method    string    `gorm:"primaryKey"`
+	Units     int       `gorm:"not null"`
+	UpdatedAt time.Time `gorm:"autoUpdateTime"`
+}
+
+// TableName returns the table name for QuotaUsageModel
+func (QuotaUsageModel) TableName() string {
+	return "youtube_quota_usage"
+}
+
+// YouTubeVideoCacheModel is the GORM persistence model for youtube_video_cache table
+type YouTubeVideoCacheModel struct {
+	VideoID   string          `gorm:"primaryKey"`
+	Item      json.RawMessage `gorm:"type:jsonb;not null"`
+	FetchedAt json.RawMessage `gorm:"type:jsonb;not null"`
+	ETag      *string         `gorm:"column:etag"`
+	ETagParts *string         `gorm:"column:etag_parts"`
+	UpdatedAt time.Time       `gorm:"autoUpdateTime"`
+}
+
+// TableName returns the table name for YouTubeVideoCacheModel
+func (YouTubeVideoCacheModel) TableName() string {
+	return "youtube_video_cache"
+}
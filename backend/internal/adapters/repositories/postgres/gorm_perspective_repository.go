@@ -2,6 +2,7 @@ package postgres
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"strings"
@@ -14,23 +15,30 @@ import (
 
 // GormPerspectiveRepository implements the PerspectiveRepository interface using GORM
 type GormPerspectiveRepository struct {
-	db *gorm.DB
+	db   *gorm.DB
+	opts repositoryOptions
 }
 
 // Compile-time interface check
 var _ repositories.PerspectiveRepository = (*GormPerspectiveRepository)(nil)
 
 // NewGormPerspectiveRepository creates a new GORM perspective repository
-func NewGormPerspectiveRepository(db *gorm.DB) *GormPerspectiveRepository {
-	return &GormPerspectiveRepository{db: db}
+func NewGormPerspectiveRepository(db *gorm.DB, opts ...RepositoryOption) *GormPerspectiveRepository {
+	return &GormPerspectiveRepository{db: db, opts: newRepositoryOptions(opts)}
 }
 
-// Create inserts a new perspective record into the database
+// Create inserts a new perspective record and its segments into the database
 func (r *GormPerspectiveRepository) Create(ctx context.Context, p *domain.Perspective) (*domain.Perspective, error) {
 	model := perspectiveDomainToModel(p)
 
-	if err := r.db.WithContext(ctx).Create(model).Error; err != nil {
-		return nil, fmt.Errorf("failed to insert perspective: %w", err)
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(model).Error; err != nil {
+			return fmt.Errorf("failed to insert perspective: %w", err)
+		}
+		return replaceSegments(tx, model.ID, p.Segments)
+	})
+	if err != nil {
+		return nil, err
 	}
 
 	// Fetch fresh record with DB-generated timestamps
@@ -48,15 +56,25 @@ func (r *GormPerspectiveRepository) GetByID(ctx context.Context, id int) (*domai
 		return nil, fmt.Errorf("failed to get perspective by id: %w", err)
 	}
 
-	return perspectiveModelToDomain(&model), nil
+	perspective := perspectiveModelToDomain(&model)
+	if err := r.loadSegments(ctx, []*domain.Perspective{perspective}); err != nil {
+		return nil, err
+	}
+	return perspective, nil
 }
 
-// Update updates an existing perspective
+// Update updates an existing perspective and replaces its segments
 func (r *GormPerspectiveRepository) Update(ctx context.Context, p *domain.Perspective) (*domain.Perspective, error) {
 	model := perspectiveDomainToModel(p)
 
-	if err := r.db.WithContext(ctx).Save(model).Error; err != nil {
-		return nil, fmt.Errorf("failed to update perspective: %w", err)
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Save(model).Error; err != nil {
+			return fmt.Errorf("failed to update perspective: %w", err)
+		}
+		return replaceSegments(tx, model.ID, p.Segments)
+	})
+	if err != nil {
+		return nil, err
 	}
 
 	// Fetch fresh record with updated timestamps
@@ -93,8 +111,20 @@ func (r *GormPerspectiveRepository) List(ctx context.Context, params domain.Pers
 		paginator.WithLimit(limit),
 		paginator.WithAllowTupleCmp(paginator.TRUE),
 	}
+	sortKey := string(params.SortBy)
+	if params.SortBy == domain.PerspectiveSortByCategorizedRating {
+		sortKey += ":" + params.SortCategory
+	}
+	scope, err := newCursorScope(sortKey, params.SortOrder, params.Filter)
+	if err != nil {
+		return nil, err
+	}
 	if params.After != nil {
-		opts = append(opts, paginator.WithAfter(*params.After))
+		after, err := r.opts.verifyCursor(scope, *params.After)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, paginator.WithAfter(after))
 	}
 	p := paginator.New(opts...)
 
@@ -103,28 +133,56 @@ func (r *GormPerspectiveRepository) List(ctx context.Context, params domain.Pers
 
 	// Apply filters via GORM chaining
 	if params.Filter != nil {
-		if params.Filter.UserID != nil {
-			query = query.Where("user_id = ?", *params.Filter.UserID)
-		}
-		if params.Filter.ContentID != nil {
-			query = query.Where("content_id = ?", *params.Filter.ContentID)
-		}
-		if params.Filter.Privacy != nil {
-			query = query.Where("privacy = ?", strings.ToLower(string(*params.Filter.Privacy)))
+		query = applyPerspectiveFilter(query, params.Filter)
+	}
+
+	// Full-text search over description, like, category and labels
+	var tsQuery string
+	if params.Filter != nil && hasSearch(params.Filter.Search) {
+		search, err := domain.ParseSearchQuery(*params.Filter.Search)
+		if err != nil {
+			return nil, err
 		}
+		tsQuery = toTSQuery(search)
+		query = query.Where("perspectives.search_vector @@ to_tsquery('english', ?)", tsQuery)
 	}
 
 	// Total count (before cursor/limit — respects filters only)
 	var totalCountInt *int
+	var totalCountExact bool
 	if params.IncludeTotalCount {
-		// Clone query to avoid Paginate() modifying count query
-		countQuery := query.Session(&gorm.Session{})
-		var count int64
-		if err := countQuery.Count(&count).Error; err != nil {
+		count, exact, err := r.opts.countRows(query, params.CountStrategy, func() (string, error) {
+			return countKey("perspectives", params.Filter)
+		})
+		if err != nil {
 			return nil, fmt.Errorf("failed to count perspectives: %w", err)
 		}
-		countInt := int(count)
-		totalCountInt = &countInt
+		totalCountInt, totalCountExact = &count, exact
+	}
+
+	// The search rank and computed sort keys must be columns the paginator
+	// can address: they are selected in a subquery aliased as the
+	// perspectives table
+	columns := []string{"perspectives.*"}
+	var columnArgs []interface{}
+	if tsQuery != "" {
+		columns = append(columns, "ts_rank(perspectives.search_vector, to_tsquery('english', ?))::float8 AS search_rank")
+		columnArgs = append(columnArgs, tsQuery)
+	}
+	switch params.SortBy {
+	case domain.PerspectiveSortByCategorizedRating:
+		columns = append(columns, `(
+			SELECT max((cr->>'rating')::int) FROM unnest(perspectives.categorized_ratings) AS cr
+			WHERE cr->>'category' = ?
+		) AS sort_categorized_rating`)
+		columnArgs = append(columnArgs, params.SortCategory)
+	case domain.PerspectiveSortByContentName:
+		query = query.Joins("LEFT JOIN content ON content.id = perspectives.content_id AND content.deleted_at IS NULL")
+		columns = append(columns, "content.name IS NULL AS sort_content_missing", "content.name AS sort_content_name")
+	}
+	if len(columns) > 1 {
+		sorted := query.Select(strings.Join(columns, ", "), columnArgs...)
+		query = r.db.WithContext(ctx).Table("(?) AS perspectives", sorted)
 	}
 
 	// Execute pagination
@@ -138,18 +196,34 @@ func (r *GormPerspectiveRepository) List(ctx context.Context, params domain.Pers
 	items := make([]*domain.Perspective, len(models))
 	for i := range models {
 		items[i] = perspectiveModelToDomain(&models[i])
+		if tsQuery != "" {
+			items[i].SearchMatch = &domain.PerspectiveSearchMatch{Rank: models[i].SearchRank}
+		}
+	}
+	if err := r.loadSegments(ctx, items); err != nil {
+		return nil, err
+	}
+	if tsQuery != "" {
+		if err := r.highlightSearchMatches(ctx, tsQuery, items); err != nil {
+			return nil, err
+		}
 	}
 
 	result := &domain.PaginatedPerspectives{
-		Items:      items,
-		HasNext:    cursor.After != nil,
-		HasPrev:    cursor.Before != nil,
-		TotalCount: totalCountInt,
+		Items:           items,
+		HasNext:         cursor.After != nil,
+		HasPrev:         cursor.Before != nil,
+		TotalCount:      totalCountInt,
+		TotalCountExact: totalCountExact,
 	}
 
 	// StartCursor = cursor.Before, EndCursor = cursor.After
-	result.StartCursor = cursor.Before
-	result.EndCursor = cursor.After
+	if result.StartCursor, err = r.opts.signCursor(scope, cursor.Before); err != nil {
+		return nil, err
+	}
+	if result.EndCursor, err = r.opts.signCursor(scope, cursor.After); err != nil {
+		return nil, err
+	}
 
 	return result, nil
 }
@@ -161,3 +235,145 @@ func (r *GormPerspectiveRepository) ReassignByUser(ctx context.Context, fromUser
 		Where("user_id = ?", fromUserID).
 		Update("user_id", toUserID).Error
 }
+
+// applyPerspectiveFilter adds f's conditions, other than search, to query
+func applyPerspectiveFilter(query *gorm.DB, f *domain.PerspectiveFilter) *gorm.DB {
+	if f.UserID != nil {
+		query = query.Where("perspectives.user_id = ?", *f.UserID)
+	}
+	if f.ContentID != nil {
+		query = query.Where("perspectives.content_id = ?", *f.ContentID)
+	}
+	if f.Privacy != nil {
+		// Rows without a privacy value read as public
+		query = query.Where("COALESCE(perspectives.privacy, 'public') = ?", privacyToDBValue(*f.Privacy))
+	}
+
+	query = whereIntRange(query, "perspectives.quality", f.Quality)
+	query = whereIntRange(query, "perspectives.agreement", f.Agreement)
+	query = whereIntRange(query, "perspectives.importance", f.Importance)
+	query = whereIntRange(query, "perspectives.confidence", f.Confidence)
+
+	// Array operators, so the GIN index on labels applies
+	if len(f.LabelsAny) > 0 {
+		query = query.Where("perspectives.labels && ?::text[]", StringArray(f.LabelsAny))
+	}
+	if len(f.LabelsAll) > 0 {
+		query = query.Where("perspectives.labels @> ?::text[]", StringArray(f.LabelsAll))
+	}
+
+	if f.Category != nil {
+		query = query.Where("lower(perspectives.category) = lower(?)", *f.Category)
+	}
+	if f.ReviewStatus != nil {
+		query = query.Where("perspectives.review_status = ?", strings.ToLower(string(*f.ReviewStatus)))
+	}
+	if cr := f.CategorizedRating; cr != nil {
+		// Containment narrows rows through the GIN index; the rating
+		// comparison then runs only on those
+		category, _ := json.Marshal([]map[string]string{{"category": cr.Category}})
+		query = query.
+			Where("perspective_ratings_jsonb(perspectives.categorized_ratings) @> ?::jsonb", string(category)).
+			Where(`EXISTS (
+				SELECT 1 FROM unnest(perspectives.categorized_ratings) AS cr
+				WHERE cr->>'category' = ? AND (cr->>'rating')::int >= ?
+			)`, cr.Category, cr.MinRating)
+	}
+
+	query = whereTimeRange(query, "perspectives.created_at", f.CreatedAt)
+	query = whereTimeRange(query, "perspectives.updated_at", f.UpdatedAt)
+	return query
+}
+
+// perspectiveHighlightRow is search headlines scanned from raw SQL
+type perspectiveHighlightRow struct {
+	ID                   int
+	DescriptionHighlight *string
+	LikeHighlight        *string
+}
+
+// highlightSearchMatches fills in headlines for one page of search results.
+// ts_headline re-parses the text, so it runs only for the rows returned.
+func (r *GormPerspectiveRepository) highlightSearchMatches(ctx context.Context, tsQuery string, items []*domain.Perspective) error {
+	if len(items) == 0 {
+		return nil
+	}
+	byID := make(map[int]*domain.Perspective, len(items))
+	ids := make([]int, len(items))
+	for i, p := range items {
+		byID[p.ID] = p
+		ids[i] = p.ID
+	}
+
+	var rows []perspectiveHighlightRow
+	err := r.db.WithContext(ctx).
+		Table("perspectives").
+		Select(`perspectives.id,
+			CASE WHEN COALESCE(perspectives.description, '') <> '' THEN
+				ts_headline('english', perspectives.description, q,
+					'StartSel=<b>, StopSel=</b>, MaxFragments=2, MinWords=15, MaxWords=35, FragmentDelimiter=" … "')
+			END AS description_highlight,
+			CASE WHEN COALESCE(perspectives."like", '') <> '' THEN
+				ts_headline('english', perspectives."like", q, 'StartSel=<b>, StopSel=</b>, HighlightAll=true')
+			END AS like_highlight`).
+		Joins("CROSS JOIN to_tsquery('english', ?) AS q", tsQuery).
+		Where("perspectives.id IN ?", ids).
+		Scan(&rows).Error
+	if err != nil {
+		return fmt.Errorf("failed to highlight perspective search matches: %w", err)
+	}
+
+	for _, row := range rows {
+		match := byID[row.ID].SearchMatch
+		match.DescriptionHighlight = row.DescriptionHighlight
+		match.LikeHighlight = row.LikeHighlight
+	}
+	return nil
+}
+
+// loadSegments attaches stored segments to perspectives with one query
+func (r *GormPerspectiveRepository) loadSegments(ctx context.Context, perspectives []*domain.Perspective) error {
+	if len(perspectives) == 0 {
+		return nil
+	}
+	byID := make(map[int]*domain.Perspective, len(perspectives))
+	ids := make([]int, len(perspectives))
+	for i, p := range perspectives {
+		byID[p.ID] = p
+		ids[i] = p.ID
+	}
+
+	var models []PerspectiveSegmentModel
+	err := r.db.WithContext(ctx).
+		Where("perspective_id IN ?", ids).
+		Order("perspective_id, position").
+		Find(&models).Error
+	if err != nil {
+		return fmt.Errorf("failed to load perspective segments: %w", err)
+	}
+
+	for i := range models {
+		p := byID[models[i].PerspectiveID]
+		p.Segments = append(p.Segments, perspectiveSegmentModelToDomain(&models[i]))
+	}
+	return nil
+}
+
+// replaceSegments swaps a perspective's stored segments for segments
+func replaceSegments(tx *gorm.DB, perspectiveID int, segments []domain.PerspectiveSegment) error {
+	if err := tx.Where("perspective_id = ?", perspectiveID).Delete(&PerspectiveSegmentModel{}).Error; err != nil {
+		return fmt.Errorf("failed to clear perspective segments: %w", err)
+	}
+	if len(segments) == 0 {
+		return nil
+	}
+
+	models := make([]PerspectiveSegmentModel, len(segments))
+	for i, s := range segments {
+		models[i] = perspectiveSegmentDomainToModel(perspectiveID, s)
+	}
+	if err := tx.Create(&models).Error; err != nil {
+		return fmt.Errorf("failed to save perspective segments: %w", err)
+	}
+	return nil
+}
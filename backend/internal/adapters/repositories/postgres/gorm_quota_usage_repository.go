@@ -0,0 +1,54 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/CodeWarrior-debug/perspectize/backend/internal/core/ports/repositories"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// GormQuotaUsageRepository implements the QuotaUsageRepository interface using GORM
+type GormQuotaUsageRepository struct {
+	db *gorm.DB
+}
+
+// Compile-time interface check
+var _ repositories.QuotaUsageRepository = (*GormQuotaUsageRepository)(nil)
+
+// NewGormQuotaUsageRepository creates a new GORM quota usage repository
+func NewGormQuotaUsageRepository(db *gorm.DB) *GormQuotaUsageRepository {
+	return &GormQuotaUsageRepository{db: db}
+}
+
+// AddUsage increments the units spent on method for day
+func (r *GormQuotaUsageRepository) AddUsage(ctx context.Context, day time.Time, method string, units int) error {
+	model := &QuotaUsageModel{Day: day.Format(time.DateOnly), Method: method, Units: units}
+	err := r.db.WithContext(ctx).Clauses(clause.OnConflict{
+		Columns: []clause.Column{{Name: "day"}, {Name: "method"}},
+		DoUpdates: clause.Assignments(map[string]interface{}{
+			"units":      gorm.Expr("youtube_quota_usage.units + EXCLUDED.units"),
+			"updated_at": gorm.Expr("NOW()"),
+		}),
+	}).Create(model).Error
+	if err != nil {
+		return fmt.Errorf("failed to record quota usage: %w", err)
+	}
+	return nil
+}
+
+// GetUsage returns the units spent per method on day
+func (r *GormQuotaUsageRepository) GetUsage(ctx context.Context, day time.Time) (map[string]int, error) {
+	var models []QuotaUsageModel
+	if err := r.db.WithContext(ctx).Where("day = ?", day.Format(time.DateOnly)).Find(&models).Error; err != nil {
+		return nil, fmt.Errorf("failed to get quota usage: %w", err)
+	}
+
+	usage := make(map[string]int, len(models))
+	for _, m := range models {
+		usage[m.Method] = m.Units
+	}
+	return usage, nil
+}
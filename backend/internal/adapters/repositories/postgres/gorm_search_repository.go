@@ -0,0 +1,88 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/CodeWarrior-debug/perspectize/backend/internal/core/domain"
+	"github.com/CodeWarrior-debug/perspectize/backend/internal/core/ports/repositories"
+	"gorm.io/gorm"
+)
+
+// WithSimilarityThreshold sets the minimum pg_trgm similarity, from 0 to 1,
+// for a fuzzy match; values outside (0, 1] keep domain.DefaultSimilarityThreshold
+func WithSimilarityThreshold(threshold float64) RepositoryOption {
+	return func(o *repositoryOptions) {
+		if threshold > 0 && threshold <= 1 {
+			o.similarityThreshold = threshold
+		}
+	}
+}
+
+// withSimilarityThreshold runs fn in a transaction whose pg_trgm % operator
+// uses threshold. % is what the trigram GIN indexes accelerate, and it reads
+// the cutoff from a setting, so the setting is scoped to the transaction
+// rather than leaking to other users of the pooled connection.
+func withSimilarityThreshold(ctx context.Context, db *gorm.DB, threshold float64, fn func(tx *gorm.DB) error) error {
+	return db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Exec("SELECT set_config('pg_trgm.similarity_threshold', ?, true)", fmt.Sprint(threshold)).Error; err != nil {
+			return fmt.Errorf("failed to set similarity threshold: %w", err)
+		}
+		return fn(tx)
+	})
+}
+
+// GormSearchRepository implements the SearchRepository interface using GORM
+type GormSearchRepository struct {
+	db   *gorm.DB
+	opts repositoryOptions
+}
+
+// Compile-time interface check
+var _ repositories.SearchRepository = (*GormSearchRepository)(nil)
+
+// NewGormSearchRepository creates a new GORM search repository
+func NewGormSearchRepository(db *gorm.DB, opts ...RepositoryOption) *GormSearchRepository {
+	return &GormSearchRepository{db: db, opts: newRepositoryOptions(opts)}
+}
+
+// Suggest returns content names, channel titles and usernames similar to
+// query, most similar first. Exact matches are left out: they are not a
+// correction.
+func (r *GormSearchRepository) Suggest(ctx context.Context, query string, limit int) ([]*domain.SearchSuggestion, error) {
+	var suggestions []*domain.SearchSuggestion
+	err := withSimilarityThreshold(ctx, r.db, r.opts.similarityThreshold, func(tx *gorm.DB) error {
+		return tx.Raw(`
+			SELECT text, kind, similarity FROM (
+				SELECT name AS text, CAST(@content AS text) AS kind, similarity(name, @q)::float8 AS similarity
+				FROM content
+				WHERE deleted_at IS NULL AND name % @q
+				GROUP BY name
+				UNION ALL
+				SELECT channel_title, CAST(@channel AS text), similarity(channel_title, @q)::float8
+				FROM content
+				WHERE deleted_at IS NULL AND channel_title % @q
+				GROUP BY channel_title
+				UNION ALL
+				SELECT username, CAST(@user AS text), similarity(username, @q)::float8
+				FROM users
+				WHERE active AND username NOT IN (@sentinels) AND username % @q
+			) s
+			WHERE lower(text) <> lower(@q)
+			ORDER BY similarity DESC, text ASC
+			LIMIT @limit`,
+			map[string]interface{}{
+				"q":         query,
+				"content":   domain.SearchSuggestionContent,
+				"channel":   domain.SearchSuggestionChannel,
+				"user":      domain.SearchSuggestionUser,
+				"sentinels": []string{domain.DeletedUserUsername, domain.SystemUserUsername},
+				"limit":     limit,
+			},
+		).Scan(&suggestions).Error
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to suggest search terms: %w", err)
+	}
+	return suggestions, nil
+}
@@ -0,0 +1,146 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/CodeWarrior-debug/perspectize/backend/internal/core/domain"
+	"github.com/CodeWarrior-debug/perspectize/backend/internal/core/ports/repositories"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// transcriptInsertBatchSize keeps each segment INSERT well under Postgres'
+// 65535 bind parameter limit
+const transcriptInsertBatchSize = 1000
+
+// GormTranscriptRepository implements the TranscriptRepository interface using GORM
+type GormTranscriptRepository struct {
+	db *gorm.DB
+}
+
+// Compile-time interface check
+var _ repositories.TranscriptRepository = (*GormTranscriptRepository)(nil)
+
+// NewGormTranscriptRepository creates a new GORM transcript repository
+func NewGormTranscriptRepository(db *gorm.DB) *GormTranscriptRepository {
+	return &GormTranscriptRepository{db: db}
+}
+
+// Replace stores a transcript and its segments in one transaction, replacing
+// any transcript the content already has
+func (r *GormTranscriptRepository) Replace(ctx context.Context, transcript *domain.Transcript) (*domain.Transcript, error) {
+	model := &TranscriptModel{
+		ContentID:    transcript.ContentID,
+		Language:     transcript.Language,
+		Source:       string(transcript.Source),
+		SegmentCount: len(transcript.Segments),
+	}
+
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		err := tx.Clauses(
+			clause.OnConflict{
+				Columns:   []clause.Column{{Name: "content_id"}},
+				DoUpdates: clause.AssignmentColumns([]string{"language", "source", "segment_count", "updated_at"}),
+			},
+			clause.Returning{},
+		).Create(model).Error
+		if err != nil {
+			return fmt.Errorf("failed to save transcript: %w", err)
+		}
+
+		if err := tx.Where("content_id = ?", transcript.ContentID).Delete(&TranscriptSegmentModel{}).Error; err != nil {
+			return fmt.Errorf("failed to clear transcript segments: %w", err)
+		}
+
+		segments := make([]TranscriptSegmentModel, len(transcript.Segments))
+		for i, s := range transcript.Segments {
+			segments[i] = TranscriptSegmentModel{
+				ContentID: transcript.ContentID,
+				Position:  s.Position,
+				StartMs:   s.StartMs,
+				EndMs:     s.EndMs,
+				Text:      s.Text,
+			}
+		}
+		if err := tx.CreateInBatches(segments, transcriptInsertBatchSize).Error; err != nil {
+			return fmt.Errorf("failed to save transcript segments: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &domain.Transcript{
+		ContentID:    model.ContentID,
+		Language:     model.Language,
+		Source:       domain.TranscriptSource(model.Source),
+		Segments:     transcript.Segments,
+		SegmentCount: model.SegmentCount,
+		CreatedAt:    model.CreatedAt,
+		UpdatedAt:    model.UpdatedAt,
+	}, nil
+}
+
+// ListSegments returns segments overlapping [FromMs, ToMs) in transcript order
+func (r *GormTranscriptRepository) ListSegments(ctx context.Context, params domain.TranscriptRangeParams) ([]*domain.TranscriptSegment, error) {
+	query := r.db.WithContext(ctx).Where("content_id = ?", params.ContentID)
+	if params.FromMs != nil {
+		// Zero-length cues at the window start still count as inside it
+		query = query.Where("(end_ms > ? OR start_ms >= ?)", *params.FromMs, *params.FromMs)
+	}
+	if params.ToMs != nil {
+		query = query.Where("start_ms < ?", *params.ToMs)
+	}
+
+	var models []TranscriptSegmentModel
+	if err := query.Order("position ASC").Find(&models).Error; err != nil {
+		return nil, fmt.Errorf("failed to list transcript segments: %w", err)
+	}
+
+	segments := make([]*domain.TranscriptSegment, len(models))
+	for i := range models {
+		segments[i] = transcriptSegmentModelToDomain(&models[i])
+	}
+	return segments, nil
+}
+
+// transcriptMatchRow is a search hit scanned from raw SQL
+type transcriptMatchRow struct {
+	TranscriptSegmentModel
+	Headline string
+	Rank     float64
+}
+
+// Search returns segments of content that is not soft-deleted matching a
+// web-search-syntax query, ranked by ts_rank
+func (r *GormTranscriptRepository) Search(ctx context.Context, params domain.TranscriptSearchParams) ([]*domain.TranscriptMatch, error) {
+	query := r.db.WithContext(ctx).
+		Table("transcript_segments AS s").
+		Select(`s.content_id, s.position, s.start_ms, s.end_ms, s.text,
+			ts_headline('simple', s.text, q, 'StartSel=<b>, StopSel=</b>, HighlightAll=true') AS headline,
+			ts_rank(s.text_search, q) AS rank`).
+		Joins("CROSS JOIN websearch_to_tsquery('simple', ?) AS q", params.Query).
+		Joins("JOIN content c ON c.id = s.content_id AND c.deleted_at IS NULL").
+		Where("s.text_search @@ q")
+	if params.ContentID != nil {
+		query = query.Where("s.content_id = ?", *params.ContentID)
+	}
+
+	var rows []transcriptMatchRow
+	err := query.Order("rank DESC, s.content_id ASC, s.position ASC").Limit(params.Limit).Scan(&rows).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to search transcripts: %w", err)
+	}
+
+	matches := make([]*domain.TranscriptMatch, len(rows))
+	for i := range rows {
+		matches[i] = &domain.TranscriptMatch{
+			Segment:  transcriptSegmentModelToDomain(&rows[i].TranscriptSegmentModel),
+			Headline: rows[i].Headline,
+			Rank:     rows[i].Rank,
+		}
+	}
+	return matches, nil
+}
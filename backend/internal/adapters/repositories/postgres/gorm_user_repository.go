@@ -3,23 +3,26 @@ package postgres
 import (
 	"context"
 	"errors"
+	"fmt"
 
 	"github.com/CodeWarrior-debug/perspectize/backend/internal/core/domain"
 	repositories "github.com/CodeWarrior-debug/perspectize/backend/internal/core/ports/repositories"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 // GormUserRepository implements the UserRepository interface using GORM
 type GormUserRepository struct {
-	db *gorm.DB
+	db   *gorm.DB
+	opts repositoryOptions
 }
 
 // Compile-time interface check
 var _ repositories.UserRepository = (*GormUserRepository)(nil)
 
 // NewGormUserRepository creates a new GORM-based user repository
-func NewGormUserRepository(db *gorm.DB) *GormUserRepository {
-	return &GormUserRepository{db: db}
+func NewGormUserRepository(db *gorm.DB, opts ...RepositoryOption) *GormUserRepository {
+	return &GormUserRepository{db: db, opts: newRepositoryOptions(opts)}
 }
 
 // Create inserts a new user record into the database
@@ -96,6 +99,29 @@ func (r *GormUserRepository) ListAll(ctx context.Context) ([]*domain.User, error
 	return users, nil
 }
 
+// Search returns active, non-sentinel users whose username is similar to
+// query or contains it, most similar first
+func (r *GormUserRepository) Search(ctx context.Context, query string, limit int) ([]*domain.User, error) {
+	var models []UserModel
+	err := withSimilarityThreshold(ctx, r.db, r.opts.similarityThreshold, func(tx *gorm.DB) error {
+		return tx.
+			Where("active AND username NOT IN ?", []string{domain.DeletedUserUsername, domain.SystemUserUsername}).
+			Where("(username % ? OR username ILIKE ?)", query, "%"+escapeLike(query)+"%").
+			Order(clause.Expr{SQL: "similarity(username, ?) DESC, username ASC", Vars: []interface{}{query}}).
+			Limit(limit).
+			Find(&models).Error
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to search users: %w", err)
+	}
+
+	users := make([]*domain.User, len(models))
+	for i := range models {
+		users[i] = userModelToDomain(&models[i])
+	}
+	return users, nil
+}
+
 // Update saves changes to an existing user record
 func (r *GormUserRepository) Update(ctx context.Context, user *domain.User) (*domain.User, error) {
 	model := userDomainToModel(user)
@@ -0,0 +1,69 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/CodeWarrior-debug/perspectize/backend/internal/core/domain"
+	"github.com/CodeWarrior-debug/perspectize/backend/internal/core/ports/repositories"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// GormYouTubeCacheRepository implements the YouTubeCacheRepository interface using GORM
+type GormYouTubeCacheRepository struct {
+	db *gorm.DB
+}
+
+// Compile-time interface check
+var _ repositories.YouTubeCacheRepository = (*GormYouTubeCacheRepository)(nil)
+
+// NewGormYouTubeCacheRepository creates a new GORM YouTube cache repository
+func NewGormYouTubeCacheRepository(db *gorm.DB) *GormYouTubeCacheRepository {
+	return &GormYouTubeCacheRepository{db: db}
+}
+
+// GetVideos returns cached entries keyed by video ID
+func (r *GormYouTubeCacheRepository) GetVideos(ctx context.Context, videoIDs []string) (map[string]*domain.YouTubeVideoCacheEntry, error) {
+	entries := make(map[string]*domain.YouTubeVideoCacheEntry, len(videoIDs))
+	if len(videoIDs) == 0 {
+		return entries, nil
+	}
+
+	var models []YouTubeVideoCacheModel
+	if err := r.db.WithContext(ctx).Where("video_id IN ?", videoIDs).Find(&models).Error; err != nil {
+		return nil, fmt.Errorf("failed to get cached videos: %w", err)
+	}
+	for i := range models {
+		entry, err := youtubeCacheModelToDomain(&models[i])
+		if err != nil {
+			return nil, err
+		}
+		entries[entry.VideoID] = entry
+	}
+	return entries, nil
+}
+
+// PutVideo inserts or replaces the cached entry for a video
+func (r *GormYouTubeCacheRepository) PutVideo(ctx context.Context, entry *domain.YouTubeVideoCacheEntry) error {
+	model, err := youtubeCacheDomainToModel(entry)
+	if err != nil {
+		return err
+	}
+	err = r.db.WithContext(ctx).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "video_id"}},
+		DoUpdates: clause.AssignmentColumns([]string{"item", "fetched_at", "etag", "etag_parts", "updated_at"}),
+	}).Create(model).Error
+	if err != nil {
+		return fmt.Errorf("failed to cache video %s: %w", entry.VideoID, err)
+	}
+	return nil
+}
+
+// DeleteVideo removes the cached entry for a video
+func (r *GormYouTubeCacheRepository) DeleteVideo(ctx context.Context, videoID string) error {
+	if err := r.db.WithContext(ctx).Delete(&YouTubeVideoCacheModel{}, "video_id = ?", videoID).Error; err != nil {
+		return fmt.Errorf("failed to delete cached video %s: %w", videoID, err)
+	}
+	return nil
+}
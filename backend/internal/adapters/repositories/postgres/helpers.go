@@ -3,10 +3,16 @@ package postgres
 import (
 	"database/sql"
 	"database/sql/driver"
+	"errors"
+	"fmt"
+	"math"
 	"strings"
 
 	"github.com/CodeWarrior-debug/perspectize/backend/internal/core/domain"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/pilagod/gorm-cursor-paginator/v2/cursor"
 	paginator "github.com/pilagod/gorm-cursor-paginator/v2/paginator"
+	"gorm.io/gorm"
 )
 
 // JSONBArray is a custom type for PostgreSQL jsonb[] columns.
@@ -38,6 +44,13 @@ func (a JSONBArray) Value() (driver.Value, error) {
 	return StringArray(a).Value()
 }
 
+// isUniqueViolation reports whether err is a unique violation (SQLSTATE
+// 23505) of the named constraint or unique index
+func isUniqueViolation(err error, constraint string) bool {
+	var pgErr *pgconn.PgError
+	return errors.As(err, &pgErr) && pgErr.Code == "23505" && pgErr.ConstraintName == constraint
+}
+
 // contentTypeToDBValue converts domain ContentType to lowercase for database storage
 func contentTypeToDBValue(ct domain.ContentType) string {
 	return strings.ToLower(string(ct))
@@ -87,8 +100,25 @@ func intSliceToInt64Array(ints []int) Int64Array {
 	return result
 }
 
+// encodeCursor encodes model's sort keys as the paginator does, so the
+// result works as an after cursor under the same rules
+func encodeCursor(rules []paginator.Rule, model interface{}) (*string, error) {
+	fields := make([]cursor.EncoderField, len(rules))
+	for i, rule := range rules {
+		fields[i].Key = rule.Key
+	}
+	c, err := (&paginator.JSONCursorCodec{}).Encode(fields, model)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode cursor: %w", err)
+	}
+	return &c, nil
+}
+
 // buildContentSortRules builds paginator rules for content sorting
 // Returns slice with primary sort rule + ID tie-breaker rule
+//
+// Content without statistics or a publish date sorts last in both
+// directions, as missing ratings do in buildPerspectiveSortRules.
 func buildContentSortRules(sortBy domain.ContentSortBy, order domain.SortOrder) []paginator.Rule {
 	// Map domain.SortOrder to paginator.Order
 	var paginatorOrder paginator.Order
@@ -102,25 +132,14 @@ func buildContentSortRules(sortBy domain.ContentSortBy, order domain.SortOrder)
 
 	switch sortBy {
 	case domain.ContentSortByViewCount:
-		primaryRule = paginator.Rule{
-			Key:             "ViewCount",
-			Order:           paginatorOrder,
-			SQLRepr:         "(response->'items'->0->'statistics'->>'viewCount')::BIGINT",
-			NULLReplacement: int64(0),
-		}
+		primaryRule = countSortRule("ViewCount", paginatorOrder)
 	case domain.ContentSortByLikeCount:
-		primaryRule = paginator.Rule{
-			Key:             "LikeCount",
-			Order:           paginatorOrder,
-			SQLRepr:         "(response->'items'->0->'statistics'->>'likeCount')::BIGINT",
-			NULLReplacement: int64(0),
-		}
+		primaryRule = countSortRule("LikeCount", paginatorOrder)
 	case domain.ContentSortByPublishedAt:
 		primaryRule = paginator.Rule{
 			Key:             "PublishedAt",
 			Order:           paginatorOrder,
-			SQLRepr:         "response->'items'->0->'snippet'->>'publishedAt'",
-			NULLReplacement: "",
+			NULLReplacement: publishedAtNullReplacement(paginatorOrder),
 		}
 	case domain.ContentSortByUpdatedAt:
 		primaryRule = paginator.Rule{
@@ -137,6 +156,12 @@ func buildContentSortRules(sortBy domain.ContentSortBy, order domain.SortOrder)
 			Key:   "CreatedAt",
 			Order: paginatorOrder,
 		}
+	case domain.ContentSortByRelevance:
+		// search_rank is selected by List's relevance subquery
+		primaryRule = paginator.Rule{
+			Key:   "SearchRank",
+			Order: paginatorOrder,
+		}
 	default:
 		// Default to CreatedAt DESC
 		primaryRule = paginator.Rule{
@@ -155,7 +180,11 @@ func buildContentSortRules(sortBy domain.ContentSortBy, order domain.SortOrder)
 }
 
 // buildPerspectiveSortRules builds paginator rules for perspective sorting
-// Returns slice with primary sort rule + ID tie-breaker rule
+// Returns slice with primary sort rule(s) + ID tie-breaker rule
+//
+// Missing ratings and content names sort last in both directions. The
+// paginator applies NULLReplacement to the ORDER BY, the cursor comparison
+// and decoded cursor values alike, so pages stay consistent across NULLs.
 func buildPerspectiveSortRules(sortBy domain.PerspectiveSortBy, order domain.SortOrder) []paginator.Rule {
 	// Map domain.SortOrder to paginator.Order
 	var paginatorOrder paginator.Order
@@ -165,25 +194,56 @@ func buildPerspectiveSortRules(sortBy domain.PerspectiveSortBy, order domain.Sor
 		paginatorOrder = paginator.DESC
 	}
 
-	var primaryRule paginator.Rule
+	var primaryRules []paginator.Rule
 
 	switch sortBy {
 	case domain.PerspectiveSortByUpdatedAt:
-		primaryRule = paginator.Rule{
+		primaryRules = []paginator.Rule{{
 			Key:   "UpdatedAt",
 			Order: paginatorOrder,
-		}
+		}}
 	case domain.PerspectiveSortByCreatedAt:
-		primaryRule = paginator.Rule{
+		primaryRules = []paginator.Rule{{
 			Key:   "CreatedAt",
 			Order: paginatorOrder,
+		}}
+	case domain.PerspectiveSortByRelevance:
+		// search_rank is selected by List's search subquery
+		primaryRules = []paginator.Rule{{
+			Key:   "SearchRank",
+			Order: paginatorOrder,
+		}}
+	case domain.PerspectiveSortByQuality:
+		primaryRules = []paginator.Rule{ratingSortRule("Quality", paginatorOrder)}
+	case domain.PerspectiveSortByAgreement:
+		primaryRules = []paginator.Rule{ratingSortRule("Agreement", paginatorOrder)}
+	case domain.PerspectiveSortByImportance:
+		primaryRules = []paginator.Rule{ratingSortRule("Importance", paginatorOrder)}
+	case domain.PerspectiveSortByConfidence:
+		primaryRules = []paginator.Rule{ratingSortRule("Confidence", paginatorOrder)}
+	case domain.PerspectiveSortByCategorizedRating:
+		// sort_categorized_rating is selected by List's sort subquery
+		primaryRules = []paginator.Rule{ratingSortRule("SortCategorizedRating", paginatorOrder)}
+	case domain.PerspectiveSortByContentName:
+		// No string sorts after every name, so a missing-content flag
+		// (always ascending) puts perspectives without content last
+		primaryRules = []paginator.Rule{
+			{
+				Key:   "SortContentMissing",
+				Order: paginator.ASC,
+			},
+			{
+				Key:             "SortContentName",
+				Order:           paginatorOrder,
+				NULLReplacement: "",
+			},
 		}
 	default:
 		// Default to CreatedAt DESC
-		primaryRule = paginator.Rule{
+		primaryRules = []paginator.Rule{{
 			Key:   "CreatedAt",
 			Order: paginator.DESC,
-		}
+		}}
 	}
 
 	// Tie-breaker: ID with same sort direction as primary
@@ -192,5 +252,112 @@ func buildPerspectiveSortRules(sortBy domain.PerspectiveSortBy, order domain.Sor
 		Order: paginatorOrder,
 	}
 
-	return []paginator.Rule{primaryRule, tieBreaker}
+	return append(primaryRules, tieBreaker)
+}
+
+// ratingSortRule sorts by a rating with NULLs last: they are replaced by a
+// value just outside the valid range on the side that sorts last. Ratings
+// use a DB domain type, so values are cast to plain integers to compare.
+func ratingSortRule(key string, order paginator.Order) paginator.Rule {
+	replacement := domain.RatingMin - 1
+	if order == paginator.ASC {
+		replacement = domain.RatingMax + 1
+	}
+	sqlType := "INTEGER"
+	return paginator.Rule{
+		Key:             key,
+		Order:           order,
+		SQLType:         &sqlType,
+		NULLReplacement: replacement,
+	}
+}
+
+// countSortRule sorts by a statistics count with NULLs last: they are
+// replaced by a value no count reaches on the side that sorts last
+func countSortRule(key string, order paginator.Order) paginator.Rule {
+	replacement := int64(-1)
+	if order == paginator.ASC {
+		replacement = math.MaxInt64
+	}
+	return paginator.Rule{
+		Key:             key,
+		Order:           order,
+		NULLReplacement: replacement,
+	}
+}
+
+// publishedAtNullReplacement stands in for a missing publish date, outside
+// any real date on the side that sorts last
+func publishedAtNullReplacement(order paginator.Order) string {
+	if order == paginator.ASC {
+		return "9999-12-31T23:59:59Z"
+	}
+	return "0001-01-01T00:00:00Z"
+}
+
+// toTSQuery renders a parsed search as to_tsquery input. Words hold only
+// letters and digits, so they need no quoting.
+func toTSQuery(q domain.SearchQuery) string {
+	terms := make([]string, len(q.Terms))
+	for i, t := range q.Terms {
+		term := strings.Join(t.Words, " <-> ")
+		if t.Prefix {
+			term += ":*"
+		}
+		if len(t.Words) > 1 {
+			term = "(" + term + ")"
+		}
+		if t.Exclude {
+			term = "!" + term
+		}
+		terms[i] = term
+	}
+	return strings.Join(terms, " & ")
+}
+
+// whereIntRange restricts column to r's inclusive bounds; column must be a
+// trusted identifier
+func whereIntRange(query *gorm.DB, column string, r *domain.IntRange) *gorm.DB {
+	if r == nil {
+		return query
+	}
+	if r.Min != nil {
+		query = query.Where(column+" >= ?", *r.Min)
+	}
+	if r.Max != nil {
+		query = query.Where(column+" <= ?", *r.Max)
+	}
+	return query
+}
+
+// whereTimeRange restricts column to [r.From, r.To); column must be a
+// trusted identifier
+func whereTimeRange(query *gorm.DB, column string, r *domain.TimeRange) *gorm.DB {
+	if r == nil {
+		return query
+	}
+	if r.From != nil {
+		query = query.Where(column+" >= ?", *r.From)
+	}
+	if r.To != nil {
+		query = query.Where(column+" < ?", *r.To)
+	}
+	return query
+}
+
+// likeEscaper escapes LIKE wildcards so user text matches literally
+var likeEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
+// escapeLike escapes s for use inside a LIKE or ILIKE pattern
+func escapeLike(s string) string {
+	return likeEscaper.Replace(s)
+}
+
+// statsGranularityToTruncUnit maps a StatsGranularity to a PostgreSQL
+// date_trunc unit. Unknown values fall back to daily buckets.
+func statsGranularityToTruncUnit(g domain.StatsGranularity) string {
+	if g == domain.StatsGranularityWeek {
+		return "week"
+	}
+	return "day"
 }
@@ -0,0 +1,32 @@
+package postgres
+
+import (
+	"time"
+
+	"github.com/CodeWarrior-debug/perspectize/backend/internal/core/domain"
+)
+
+// RepositoryOption configures optional behavior of the GORM repositories
+type RepositoryOption func(*repositoryOptions)
+
+type repositoryOptions struct {
+	similarityThreshold float64
+	countCacheTTL       time.Duration
+	countCache          *countCache
+	cursorKeys          [][]byte // The first signs; all verify
+}
+
+func newRepositoryOptions(opts []RepositoryOption) repositoryOptions {
+	o := repositoryOptions{
+		similarityThreshold: domain.DefaultSimilarityThreshold,
+		countCacheTTL:       DefaultCountCacheTTL,
+	}
+	for _, opt := range opts {
+		opt(&o)
+	}
+	o.countCache = newCountCache(o.countCacheTTL)
+	if len(o.cursorKeys) == 0 {
+		o.cursorKeys = [][]byte{processCursorKey()}
+	}
+	return o
+}
@@ -0,0 +1,388 @@
+package youtube
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"maps"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/CodeWarrior-debug/perspectize/backend/internal/core/domain"
+	"github.com/CodeWarrior-debug/perspectize/backend/internal/core/ports/repositories"
+	"github.com/CodeWarrior-debug/perspectize/backend/internal/core/ports/services"
+)
+
+// Video resource parts the client requests; each is cached with its own TTL
+const (
+	PartSnippet        = "snippet"
+	PartStatistics     = "statistics"
+	PartContentDetails = "contentDetails"
+)
+
+// videoParts is every part the client needs, in request order
+var videoParts = []string{PartSnippet, PartStatistics, PartContentDetails}
+
+// DefaultCacheCapacity is how many videos the in-memory cache holds by default
+const DefaultCacheCapacity = 10000
+
+// DefaultPartTTLs is how long each cached part stays fresh. Statistics move
+// constantly, titles and descriptions rarely, durations never.
+var DefaultPartTTLs = map[string]time.Duration{
+	PartSnippet:        24 * time.Hour,
+	PartStatistics:     time.Hour,
+	PartContentDetails: 7 * 24 * time.Hour,
+}
+
+// CacheConfig controls the response cache
+type CacheConfig struct {
+	// Capacity is how many videos the in-memory LRU holds
+	Capacity int
+	// TTLs overrides DefaultPartTTLs for the parts it lists
+	TTLs map[string]time.Duration
+}
+
+// CachingClient decorates a Client with a per-video response cache: an
+// in-memory LRU, optionally backed by a persistent store. Each part of a
+// video expires on its own TTL and only stale parts are requested again. A
+// single stale video is revalidated with If-None-Match; on a 304 the cached
+// copy is returned with VideoMetadata.Unchanged set. Stale videos fetched in
+// a batch cost one unit however many there are, so they are not revalidated
+// one by one; instead each item's own etag is compared with the cached one
+// and a match sets Unchanged.
+type CachingClient struct {
+	client *Client
+	ttls   map[string]time.Duration
+	lru    *lru
+	store  repositories.YouTubeCacheRepository // nil keeps the cache in memory only
+	now    func() time.Time
+}
+
+// Compile-time interface check
+var _ services.YouTubeClient = (*CachingClient)(nil)
+
+// CacheOption configures a CachingClient
+type CacheOption func(*CachingClient)
+
+// WithCacheStore backs the in-memory cache with a persistent store, so cached
+// responses survive restarts and are shared between instances
+func WithCacheStore(store repositories.YouTubeCacheRepository) CacheOption {
+	return func(c *CachingClient) {
+		c.store = store
+	}
+}
+
+// WithCacheClock overrides the time source (used by tests)
+func WithCacheClock(now func() time.Time) CacheOption {
+	return func(c *CachingClient) {
+		c.now = now
+	}
+}
+
+// NewCachingClient wraps client with a response cache
+func NewCachingClient(client *Client, cfg CacheConfig, opts ...CacheOption) *CachingClient {
+	capacity := cfg.Capacity
+	if capacity <= 0 {
+		capacity = DefaultCacheCapacity
+	}
+	ttls := maps.Clone(DefaultPartTTLs)
+	for part, ttl := range cfg.TTLs {
+		if ttl > 0 {
+			ttls[part] = ttl
+		}
+	}
+
+	c := &CachingClient{
+		client: client,
+		ttls:   ttls,
+		lru:    newLRU(capacity),
+		now:    time.Now,
+	}
+	for _, opt := range opts {
+		opt(c)
+	}
+	return c
+}
+
+// GetVideoMetadata returns video metadata, from the cache while it is fresh
+func (c *CachingClient) GetVideoMetadata(ctx context.Context, videoID string) (*services.VideoMetadata, error) {
+	videos, err := c.GetVideosMetadata(ctx, []string{videoID})
+	if err != nil {
+		return nil, err
+	}
+
+	metadata, ok := videos[videoID]
+	if !ok {
+		return nil, fmt.Errorf("%w: video not found: %s", domain.ErrNotFound, videoID)
+	}
+	return metadata, nil
+}
+
+// GetVideosMetadata returns metadata for many videos. Fresh videos come from
+// the cache; the rest are grouped by which parts are stale and fetched up to
+// 50 IDs per request. Videos YouTube no longer returns are evicted. A context
+// from services.BypassCache treats every part as stale.
+func (c *CachingClient) GetVideosMetadata(ctx context.Context, videoIDs []string) (map[string]*services.VideoMetadata, error) {
+	ids := uniqueIDs(videoIDs)
+	entries := c.lookup(ctx, ids)
+	result := make(map[string]*services.VideoMetadata, len(ids))
+
+	var errs []error
+	groups := make(map[string][]string) // Comma-joined stale parts -> video IDs
+	var keys []string
+	now := c.now()
+	bypass := services.CacheBypassed(ctx)
+	for _, id := range ids {
+		stale := videoParts
+		if !bypass {
+			stale = c.staleParts(entries[id], now)
+		}
+		if len(stale) == 0 {
+			if err := c.addMetadata(result, entries[id], false); err != nil {
+				errs = append(errs, err)
+			}
+			continue
+		}
+		key := strings.Join(stale, ",")
+		if _, ok := groups[key]; !ok {
+			keys = append(keys, key)
+		}
+		groups[key] = append(groups[key], id)
+	}
+
+	for _, key := range keys {
+		parts := strings.Split(key, ",")
+		group := groups[key]
+		for start := 0; start < len(group); start += maxIDsPerRequest {
+			if err := ctx.Err(); err != nil {
+				return result, errors.Join(append(errs, err)...)
+			}
+			end := min(start+maxIDsPerRequest, len(group))
+			if err := c.fetch(ctx, group[start:end], parts, entries, result); err != nil {
+				errs = append(errs, err)
+			}
+		}
+	}
+
+	return result, errors.Join(errs...)
+}
+
+// ListCollectionVideoIDs passes through to the wrapped client uncached
+func (c *CachingClient) ListCollectionVideoIDs(ctx context.Context, collectionURL string, limit int) ([]string, error) {
+	return c.client.ListCollectionVideoIDs(ctx, collectionURL, limit)
+}
+
+// ExtractVideoID extracts the video ID from a YouTube URL
+func (c *CachingClient) ExtractVideoID(url string) (string, error) {
+	return c.client.ExtractVideoID(url)
+}
+
+// fetch requests parts for at most 50 videos, merges them into the cached
+// entries and adds the results. A single video with an ETag for the same
+// parts is requested conditionally; any other video whose item etag matches
+// the cached one for the same parts is reported unchanged.
+func (c *CachingClient) fetch(ctx context.Context, ids, parts []string, entries map[string]*domain.YouTubeVideoCacheEntry, result map[string]*services.VideoMetadata) error {
+	key := strings.Join(parts, ",")
+	var etag string
+	if len(ids) == 1 {
+		if entry := entries[ids[0]]; entry != nil && entry.ETagParts == key {
+			etag = entry.ETag
+		}
+	}
+
+	items, respETag, notModified, err := c.client.fetchVideoItems(ctx, ids, parts, etag)
+	if err != nil {
+		return err
+	}
+	now := c.now()
+
+	if notModified {
+		entry := revalidated(entries[ids[0]], parts, now)
+		c.save(ctx, entry)
+		return c.addMetadata(result, entry, true)
+	}
+
+	var errs []error
+	for _, id := range ids {
+		raw, ok := items[id]
+		if !ok {
+			// Deleted, made private, or never existed
+			c.remove(ctx, id)
+			continue
+		}
+		entry, err := merged(entries[id], id, raw, parts, now)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		unchanged := sameItemETag(entries[id], entry, key)
+		// A batch response's ETag covers every video in it, so only a
+		// single-video ETag can revalidate this video later
+		if len(ids) == 1 {
+			entry.ETag = respETag
+		}
+		entry.ETagParts = key
+		c.save(ctx, entry)
+		if err := c.addMetadata(result, entry, unchanged); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// sameItemETag reports whether fetched, just requested for parts, carries
+// the same item etag as the cached copy fetched for the same parts. YouTube
+// derives an item's etag from the parts requested, so etags for different
+// parts never match.
+func sameItemETag(cached, fetched *domain.YouTubeVideoCacheEntry, parts string) bool {
+	if cached == nil || cached.ETagParts != parts {
+		return false
+	}
+	etag := itemETag(cached.Item)
+	return etag != "" && etag == itemETag(fetched.Item)
+}
+
+// itemETag returns the etag field of a raw videos.list item
+func itemETag(raw json.RawMessage) string {
+	var item struct {
+		ETag string `json:"etag"`
+	}
+	if err := json.Unmarshal(raw, &item); err != nil {
+		return ""
+	}
+	return item.ETag
+}
+
+// staleParts returns the parts of entry that have expired, in request order;
+// every part is stale for an uncached video
+func (c *CachingClient) staleParts(entry *domain.YouTubeVideoCacheEntry, now time.Time) []string {
+	if entry == nil {
+		return videoParts
+	}
+	var stale []string
+	for _, part := range videoParts {
+		fetchedAt, ok := entry.FetchedAt[part]
+		if !ok || now.Sub(fetchedAt) >= c.ttls[part] {
+			stale = append(stale, part)
+		}
+	}
+	return stale
+}
+
+// addMetadata converts a cached entry to VideoMetadata and adds it to result
+func (c *CachingClient) addMetadata(result map[string]*services.VideoMetadata, entry *domain.YouTubeVideoCacheEntry, unchanged bool) error {
+	metadata, err := c.client.metadataFromItem(entry.Item)
+	if err != nil {
+		return fmt.Errorf("cached video %s: %w", entry.VideoID, err)
+	}
+	metadata.Unchanged = unchanged
+	result[entry.VideoID] = metadata
+	return nil
+}
+
+// lookup returns cached entries for ids from the LRU, falling back to the
+// store. Store errors are logged and treated as misses.
+func (c *CachingClient) lookup(ctx context.Context, ids []string) map[string]*domain.YouTubeVideoCacheEntry {
+	entries := make(map[string]*domain.YouTubeVideoCacheEntry, len(ids))
+	var missing []string
+	for _, id := range ids {
+		if entry, ok := c.lru.get(id); ok {
+			entries[id] = entry
+		} else {
+			missing = append(missing, id)
+		}
+	}
+	if c.store == nil || len(missing) == 0 {
+		return entries
+	}
+
+	stored, err := c.store.GetVideos(ctx, missing)
+	if err != nil {
+		slog.Warn("failed to read YouTube cache", "error", err)
+		return entries
+	}
+	for id, entry := range stored {
+		entries[id] = entry
+		c.lru.put(entry)
+	}
+	return entries
+}
+
+// save caches entry in memory and, best-effort, in the store
+func (c *CachingClient) save(ctx context.Context, entry *domain.YouTubeVideoCacheEntry) {
+	c.lru.put(entry)
+	if c.store == nil {
+		return
+	}
+	if err := c.store.PutVideo(context.WithoutCancel(ctx), entry); err != nil {
+		slog.Warn("failed to write YouTube cache", "videoID", entry.VideoID, "error", err)
+	}
+}
+
+// remove evicts a video from memory and, best-effort, from the store
+func (c *CachingClient) remove(ctx context.Context, videoID string) {
+	c.lru.remove(videoID)
+	if c.store == nil {
+		return
+	}
+	if err := c.store.DeleteVideo(context.WithoutCancel(ctx), videoID); err != nil {
+		slog.Warn("failed to evict from YouTube cache", "videoID", videoID, "error", err)
+	}
+}
+
+// merged returns a new entry with the given parts of raw replacing those in
+// base. Parts YouTube left out of raw are dropped. base is never modified, as
+// other requests may be reading it.
+func merged(base *domain.YouTubeVideoCacheEntry, videoID string, raw json.RawMessage, parts []string, now time.Time) (*domain.YouTubeVideoCacheEntry, error) {
+	var update map[string]json.RawMessage
+	if err := json.Unmarshal(raw, &update); err != nil {
+		return nil, fmt.Errorf("failed to parse YouTube API response: %w", err)
+	}
+
+	item := make(map[string]json.RawMessage, len(update))
+	if base != nil {
+		// An unreadable cached item is replaced by whatever parts were fetched
+		_ = json.Unmarshal(base.Item, &item)
+	}
+	for key, value := range update {
+		if !slices.Contains(videoParts, key) {
+			item[key] = value // id, kind, etag
+		}
+	}
+	for _, part := range parts {
+		if value, ok := update[part]; ok {
+			item[part] = value
+		} else {
+			delete(item, part)
+		}
+	}
+
+	encoded, err := json.Marshal(item)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode cached video %s: %w", videoID, err)
+	}
+	entry := revalidated(base, parts, now)
+	entry.VideoID = videoID
+	entry.Item = encoded
+	entry.ETag, entry.ETagParts = "", ""
+	return entry, nil
+}
+
+// revalidated returns a copy of base with parts marked fetched at now
+func revalidated(base *domain.YouTubeVideoCacheEntry, parts []string, now time.Time) *domain.YouTubeVideoCacheEntry {
+	entry := &domain.YouTubeVideoCacheEntry{FetchedAt: make(map[string]time.Time, len(videoParts))}
+	if base != nil {
+		*entry = *base
+		entry.FetchedAt = maps.Clone(base.FetchedAt)
+		if entry.FetchedAt == nil {
+			entry.FetchedAt = make(map[string]time.Time, len(videoParts))
+		}
+	}
+	for _, part := range parts {
+		entry.FetchedAt[part] = now
+	}
+	return entry
+}
@@ -0,0 +1,77 @@
+package youtube
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/CodeWarrior-debug/perspectize/backend/internal/core/domain"
+	"github.com/CodeWarrior-debug/perspectize/backend/internal/core/ports/services"
+)
+
+// defaultTimedTextURL serves a video's public caption tracks. The Data API's
+// captions.download needs OAuth consent from the video's owner, so public
+// tracks are read from the endpoint the web player uses; it costs no quota.
+const defaultTimedTextURL = "https://www.youtube.com/api/timedtext"
+
+// Compile-time interface check
+var _ services.CaptionProvider = (*Client)(nil)
+
+// WithTimedTextURL overrides the caption track endpoint (used by tests)
+func WithTimedTextURL(timedTextURL string) Option {
+	return func(c *Client) {
+		c.timedTextURL = strings.TrimRight(timedTextURL, "/")
+	}
+}
+
+// FetchCaptions downloads the public caption track for videoID in language
+// as WebVTT. Videos without a track in that language return domain.ErrNotFound.
+func (c *Client) FetchCaptions(ctx context.Context, videoID, language string) (*services.Captions, error) {
+	params := url.Values{
+		"v":    {videoID},
+		"lang": {language},
+		"fmt":  {"vtt"},
+	}
+
+	if c.timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, c.timeout)
+		defer cancel()
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.timedTextURL+"?"+params.Encode(), nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch captions: %w", stripURL(err))
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, c.maxResponseBytes+1))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response body: %w", stripURL(err))
+	}
+	if int64(len(body)) > c.maxResponseBytes {
+		return nil, fmt.Errorf("%w: captions response larger than %d bytes", domain.ErrYouTubeAPI, c.maxResponseBytes)
+	}
+
+	switch {
+	case resp.StatusCode == http.StatusNotFound,
+		resp.StatusCode == http.StatusOK && len(strings.TrimSpace(string(body))) == 0:
+		// The endpoint answers an empty 200 when no track matches
+		return nil, fmt.Errorf("%w: no %s captions for video %s", domain.ErrNotFound, language, videoID)
+	case resp.StatusCode != http.StatusOK:
+		return nil, parseAPIError(resp.StatusCode, body, c.apiKey)
+	}
+
+	return &services.Captions{
+		Language: language,
+		Format:   domain.CaptionFormatWebVTT,
+		Data:     string(body),
+	}, nil
+}
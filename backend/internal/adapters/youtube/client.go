@@ -3,106 +3,406 @@ package youtube
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"log/slog"
 	"net/http"
 	"net/url"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/CodeWarrior-debug/perspectize/backend/internal/core/domain"
 	"github.com/CodeWarrior-debug/perspectize/backend/internal/core/ports/services"
 )
 
+// maxIDsPerRequest is the most video IDs the videos endpoint accepts per call
+const maxIDsPerRequest = 50
+
 // Client implements the YouTubeClient interface for YouTube Data API v3
 type Client struct {
-	apiKey     string
-	httpClient *http.Client
-	baseURL    string
+	apiKey       string
+	httpClient   *http.Client
+	baseURL      string
+	timedTextURL string
+	responseMode ResponseMode
+	quota        *Quota // nil disables quota accounting
+
+	retry            RetryPolicy
+	timeout          time.Duration // Per attempt; zero means none
+	maxResponseBytes int64
+}
+
+// Option configures a Client
+type Option func(*Client)
+
+// WithBaseURL overrides the YouTube Data API base URL (used by tests)
+func WithBaseURL(baseURL string) Option {
+	return func(c *Client) {
+		c.baseURL = strings.TrimRight(baseURL, "/")
+	}
+}
+
+// WithHTTPClient overrides the HTTP client used for API requests
+func WithHTTPClient(httpClient *http.Client) Option {
+	return func(c *Client) {
+		c.httpClient = httpClient
+	}
 }
 
 // NewClient creates a new YouTube API client
-func NewClient(apiKey string) *Client {
-	return &Client{
-		apiKey:     apiKey,
-		httpClient: &http.Client{},
-		baseURL:    "https://www.googleapis.com/youtube/v3",
+func NewClient(apiKey string, opts ...Option) *Client {
+	c := &Client{
+		apiKey:       apiKey,
+		httpClient:   &http.Client{},
+		baseURL:      "https://www.googleapis.com/youtube/v3",
+		timedTextURL: defaultTimedTextURL,
+		responseMode: ResponseModeTrimmed,
+		retry: RetryPolicy{
+			MaxAttempts: DefaultMaxAttempts,
+			BaseDelay:   DefaultRetryBaseDelay,
+			MaxDelay:    DefaultRetryMaxDelay,
+		},
+		timeout:          DefaultRequestTimeout,
+		maxResponseBytes: DefaultMaxResponseBytes,
 	}
+	for _, opt := range opts {
+		opt(c)
+	}
+	return c
+}
+
+// videoItem is a single entry in a videos endpoint response
+type videoItem struct {
+	ID      string `json:"id"`
+	Snippet struct {
+		Title        string   `json:"title"`
+		Description  string   `json:"description"`
+		ChannelTitle string   `json:"channelTitle"`
+		PublishedAt  string   `json:"publishedAt"`
+		Tags         []string `json:"tags"`
+	} `json:"snippet"`
+	ContentDetails struct {
+		Duration string `json:"duration"`
+	} `json:"contentDetails"`
+	Statistics struct {
+		ViewCount    string `json:"viewCount"`
+		LikeCount    string `json:"likeCount"`
+		CommentCount string `json:"commentCount"`
+	} `json:"statistics"`
 }
 
 // YouTubeAPIResponse represents the response from YouTube Data API
 type YouTubeAPIResponse struct {
-	Items []struct {
-		ID      string `json:"id"`
-		Snippet struct {
-			Title        string   `json:"title"`
-			Description  string   `json:"description"`
-			ChannelTitle string   `json:"channelTitle"`
-			PublishedAt  string   `json:"publishedAt"`
-			Tags         []string `json:"tags"`
-		} `json:"snippet"`
-		ContentDetails struct {
-			Duration string `json:"duration"`
-		} `json:"contentDetails"`
-		Statistics struct {
-			ViewCount    string `json:"viewCount"`
-			LikeCount    string `json:"likeCount"`
-			CommentCount string `json:"commentCount"`
-		} `json:"statistics"`
-	} `json:"items"`
+	Items []videoItem `json:"items"`
 }
 
 // GetVideoMetadata fetches video metadata from YouTube Data API
 func (c *Client) GetVideoMetadata(ctx context.Context, videoID string) (*services.VideoMetadata, error) {
-	endpoint := fmt.Sprintf("%s/videos?part=snippet,statistics,contentDetails&id=%s&key=%s",
-		c.baseURL,
-		url.QueryEscape(videoID),
-		url.QueryEscape(c.apiKey),
-	)
+	videos, err := c.fetchVideos(ctx, []string{videoID})
+	if err != nil {
+		return nil, err
+	}
+
+	metadata, ok := videos[videoID]
+	if !ok {
+		return nil, fmt.Errorf("%w: video not found: %s", domain.ErrNotFound, videoID)
+	}
+	return metadata, nil
+}
+
+// GetVideosMetadata fetches metadata for many videos, up to 50 IDs per API
+// request. IDs YouTube does not return (deleted or private videos) are absent
+// from the result. If some requests fail, metadata from the successful ones is
+// still returned alongside the joined error.
+func (c *Client) GetVideosMetadata(ctx context.Context, videoIDs []string) (map[string]*services.VideoMetadata, error) {
+	ids := uniqueIDs(videoIDs)
+	result := make(map[string]*services.VideoMetadata, len(ids))
 
-	req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint, nil)
+	var errs []error
+	for start := 0; start < len(ids); start += maxIDsPerRequest {
+		if err := ctx.Err(); err != nil {
+			errs = append(errs, err)
+			break
+		}
+
+		end := min(start+maxIDsPerRequest, len(ids))
+		videos, err := c.fetchVideos(ctx, ids[start:end])
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		for id, metadata := range videos {
+			result[id] = metadata
+		}
+	}
+
+	return result, errors.Join(errs...)
+}
+
+// fetchVideos performs a single videos endpoint request for at most 50 IDs
+func (c *Client) fetchVideos(ctx context.Context, videoIDs []string) (map[string]*services.VideoMetadata, error) {
+	items, _, _, err := c.fetchVideoItems(ctx, videoIDs, videoParts, "")
+	if err != nil {
+		return nil, err
+	}
+
+	videos := make(map[string]*services.VideoMetadata, len(items))
+	for id, raw := range items {
+		metadata, err := c.metadataFromItem(raw)
+		if err != nil {
+			return nil, err
+		}
+		videos[id] = metadata
+	}
+	return videos, nil
+}
+
+// fetchVideoItems requests the given parts for at most 50 IDs and returns
+// each item's raw JSON keyed by video ID, plus the response ETag. A non-empty
+// etag is sent as If-None-Match; notModified reports a 304, with no items.
+func (c *Client) fetchVideoItems(ctx context.Context, videoIDs, parts []string, etag string) (items map[string]json.RawMessage, respETag string, notModified bool, err error) {
+	resp, err := c.do(ctx, "videos", url.Values{
+		"part": {strings.Join(parts, ",")},
+		"id":   {strings.Join(videoIDs, ",")},
+	}, "video metadata", etag)
 	if err != nil {
-		return nil, fmt.Errorf("failed to create request: %w", err)
+		return nil, "", false, err
+	}
+	if resp.notModified {
+		return nil, resp.etag, true, nil
+	}
+
+	// Keep each item's raw JSON so it can be stored per video
+	var rawResponse struct {
+		ETag  string            `json:"etag"`
+		Items []json.RawMessage `json:"items"`
+	}
+	if err := json.Unmarshal(resp.body, &rawResponse); err != nil {
+		return nil, "", false, fmt.Errorf("failed to parse YouTube API response: %w", err)
+	}
+	// The ETag header and the body's etag field carry the same value
+	respETag = resp.etag
+	if respETag == "" {
+		respETag = rawResponse.ETag
+	}
+
+	items = make(map[string]json.RawMessage, len(rawResponse.Items))
+	for _, raw := range rawResponse.Items {
+		var item struct {
+			ID string `json:"id"`
+		}
+		if err := json.Unmarshal(raw, &item); err != nil {
+			return nil, "", false, fmt.Errorf("failed to parse YouTube API response: %w", err)
+		}
+		items[item.ID] = raw
+	}
+	return items, respETag, false, nil
+}
+
+// metadataFromItem parses a raw videos endpoint item into VideoMetadata
+func (c *Client) metadataFromItem(raw json.RawMessage) (*services.VideoMetadata, error) {
+	var item videoItem
+	if err := json.Unmarshal(raw, &item); err != nil {
+		return nil, fmt.Errorf("failed to parse YouTube API response: %w", err)
+	}
+	return c.itemToMetadata(item, raw), nil
+}
+
+// apiKeyHeader carries the API key so it never appears in request URLs,
+// which transport errors and proxies tend to log
+const apiKeyHeader = "X-Goog-Api-Key"
+
+// apiResponse is a successful reply: a 200 with its body, or a 304 to a
+// conditional request
+type apiResponse struct {
+	body        []byte
+	etag        string
+	notModified bool
+}
+
+// get performs a GET against a Data API resource and returns the body of a
+// 200 response. what describes the request in transport error messages.
+func (c *Client) get(ctx context.Context, resource string, params url.Values, what string) ([]byte, error) {
+	resp, err := c.do(ctx, resource, params, what, "")
+	if err != nil {
+		return nil, err
+	}
+	return resp.body, nil
+}
+
+// do performs a GET, retrying temporary failures per the client's
+// RetryPolicy. A non-empty etag is sent as If-None-Match.
+func (c *Client) do(ctx context.Context, resource string, params url.Values, what, etag string) (*apiResponse, error) {
+	endpoint := fmt.Sprintf("%s/%s?%s", c.baseURL, resource, params.Encode())
+
+	var err error
+	for attempt := 1; ; attempt++ {
+		var resp *apiResponse
+		var retry bool
+		var retryAfter time.Duration
+		resp, retryAfter, retry, err = c.attempt(ctx, resource, endpoint, what, etag)
+		if err == nil {
+			return resp, nil
+		}
+		if !retry || attempt >= c.retry.MaxAttempts || ctx.Err() != nil {
+			return nil, err
+		}
+
+		delay := c.retry.delay(attempt, retryAfter)
+		slog.Debug("retrying YouTube API request", "resource", resource, "attempt", attempt, "delay", delay, "error", err)
+		if sleepErr := sleep(ctx, delay); sleepErr != nil {
+			return nil, err
+		}
+	}
+}
+
+// attempt sends one request. retry reports whether the failure is temporary;
+// retryAfter is the server's requested delay, if any.
+func (c *Client) attempt(ctx context.Context, resource, endpoint, what, etag string) (result *apiResponse, retryAfter time.Duration, retry bool, err error) {
+	if c.quota != nil {
+		if err := c.quota.acquire(ctx, resource+".list"); err != nil {
+			return nil, 0, false, err
+		}
+	}
+
+	attemptCtx := ctx
+	if c.timeout > 0 {
+		var cancel context.CancelFunc
+		attemptCtx, cancel = context.WithTimeout(ctx, c.timeout)
+		defer cancel()
+	}
+
+	req, err := http.NewRequestWithContext(attemptCtx, http.MethodGet, endpoint, nil)
+	if err != nil {
+		c.report(ctx, 0, false, nil)
+		return nil, 0, false, fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set(apiKeyHeader, c.apiKey)
+	if etag != "" {
+		req.Header.Set("If-None-Match", etag)
 	}
 
 	resp, err := c.httpClient.Do(req)
 	if err != nil {
-		return nil, fmt.Errorf("failed to fetch video metadata: %w", err)
+		c.report(ctx, 0, false, err)
+		return nil, 0, true, fmt.Errorf("failed to fetch %s: %w", what, stripURL(err))
 	}
 	defer resp.Body.Close()
 
-	body, err := io.ReadAll(resp.Body)
+	// Read one byte past the cap to tell a full-size body from an oversized one
+	body, err := io.ReadAll(io.LimitReader(resp.Body, c.maxResponseBytes+1))
 	if err != nil {
-		return nil, fmt.Errorf("failed to read response body: %w", err)
+		c.report(ctx, 0, false, err)
+		return nil, 0, true, fmt.Errorf("failed to read response body: %w", stripURL(err))
 	}
-
-	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("%w: status %d: %s", domain.ErrYouTubeAPI, resp.StatusCode, string(body))
+	if int64(len(body)) > c.maxResponseBytes {
+		c.report(ctx, resp.StatusCode, false, nil)
+		return nil, 0, false, fmt.Errorf("%w: %s response larger than %d bytes", domain.ErrYouTubeAPI, what, c.maxResponseBytes)
 	}
 
-	var apiResponse YouTubeAPIResponse
-	if err := json.Unmarshal(body, &apiResponse); err != nil {
-		return nil, fmt.Errorf("failed to parse YouTube API response: %w", err)
+	switch {
+	case resp.StatusCode == http.StatusNotModified && etag != "":
+		c.report(ctx, resp.StatusCode, false, nil)
+		return &apiResponse{etag: etag, notModified: true}, 0, false, nil
+	case resp.StatusCode != http.StatusOK:
+		apiErr := parseAPIError(resp.StatusCode, body, c.apiKey)
+		c.report(ctx, resp.StatusCode, apiErr.quotaExhausted(), nil)
+		return nil, parseRetryAfter(resp.Header, time.Now()), apiErr.temporary(), apiErr
 	}
+	c.report(ctx, resp.StatusCode, false, nil)
+	return &apiResponse{body: body, etag: resp.Header.Get("ETag")}, 0, false, nil
+}
 
-	if len(apiResponse.Items) == 0 {
-		return nil, fmt.Errorf("%w: video not found: %s", domain.ErrNotFound, videoID)
+// stripURL drops the request URL that net/http adds to transport errors,
+// keeping the underlying cause for errors.Is
+func stripURL(err error) error {
+	var urlErr *url.Error
+	if errors.As(err, &urlErr) {
+		return urlErr.Err
 	}
+	return err
+}
 
-	item := apiResponse.Items[0]
+// report feeds the outcome of a request to the circuit breaker. A zero status
+// with a nil error means the request was never sent; exhausted means YouTube
+// reported the daily quota spent.
+func (c *Client) report(ctx context.Context, status int, exhausted bool, transportErr error) {
+	if c.quota == nil {
+		return
+	}
+	switch {
+	case ctx.Err() != nil || (status == 0 && transportErr == nil):
+		c.quota.abandoned()
+	case transportErr != nil,
+		status == http.StatusForbidden,
+		status == http.StatusTooManyRequests,
+		status >= http.StatusInternalServerError:
+		c.quota.failed(exhausted)
+	default:
+		c.quota.succeeded()
+	}
+}
 
+// itemToMetadata converts a videos endpoint item to VideoMetadata, keeping
+// the raw item as the stored response according to the client's ResponseMode
+func (c *Client) itemToMetadata(item videoItem, raw json.RawMessage) *services.VideoMetadata {
 	duration, err := ParseISO8601Duration(item.ContentDetails.Duration)
 	if err != nil {
-		slog.Warn("failed to parse duration", "duration", item.ContentDetails.Duration, "videoID", videoID, "error", err)
+		slog.Warn("failed to parse duration", "duration", item.ContentDetails.Duration, "videoID", item.ID, "error", err)
 		duration = 0
 	}
 
+	var publishedAt *time.Time
+	if item.Snippet.PublishedAt != "" {
+		if t, err := time.Parse(time.RFC3339, item.Snippet.PublishedAt); err == nil {
+			publishedAt = &t
+		} else {
+			slog.Warn("failed to parse publishedAt", "publishedAt", item.Snippet.PublishedAt, "videoID", item.ID, "error", err)
+		}
+	}
+
 	return &services.VideoMetadata{
-		Title:       item.Snippet.Title,
-		Description: item.Snippet.Description,
-		Duration:    duration,
-		ChannelName: item.Snippet.ChannelTitle,
-		Response:    body,
-	}, nil
+		VideoID:      item.ID,
+		Title:        item.Snippet.Title,
+		Description:  item.Snippet.Description,
+		Duration:     duration,
+		ChannelName:  item.Snippet.ChannelTitle,
+		PublishedAt:  publishedAt,
+		Tags:         item.Snippet.Tags,
+		ViewCount:    parseCount(item.Statistics.ViewCount),
+		LikeCount:    parseCount(item.Statistics.LikeCount),
+		CommentCount: parseCount(item.Statistics.CommentCount),
+		Response:     c.storedResponse(raw),
+	}
+}
+
+// uniqueIDs drops empty and duplicate IDs, preserving order
+func uniqueIDs(ids []string) []string {
+	seen := make(map[string]bool, len(ids))
+	out := make([]string, 0, len(ids))
+	for _, id := range ids {
+		if id == "" || seen[id] {
+			continue
+		}
+		seen[id] = true
+		out = append(out, id)
+	}
+	return out
+}
+
+// parseCount converts a YouTube statistics string to an int64. YouTube omits
+// counts the channel has hidden, so empty or malformed values return nil.
+func parseCount(s string) *int64 {
+	if s == "" {
+		return nil
+	}
+	n, err := strconv.ParseInt(s, 10, 64)
+	if err != nil {
+		return nil
+	}
+	return &n
 }
 
 // ExtractVideoID extracts the video ID from a YouTube URL
@@ -0,0 +1,115 @@
+package youtube
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strconv"
+
+	"github.com/CodeWarrior-debug/perspectize/backend/internal/core/domain"
+)
+
+// channelsResponse is the subset of a channels endpoint response we read
+type channelsResponse struct {
+	Items []struct {
+		ID             string `json:"id"`
+		ContentDetails struct {
+			RelatedPlaylists struct {
+				Uploads string `json:"uploads"`
+			} `json:"relatedPlaylists"`
+		} `json:"contentDetails"`
+	} `json:"items"`
+}
+
+// playlistItemsResponse is the subset of a playlistItems endpoint response we read
+type playlistItemsResponse struct {
+	NextPageToken string `json:"nextPageToken"`
+	Items         []struct {
+		ContentDetails struct {
+			VideoID string `json:"videoId"`
+		} `json:"contentDetails"`
+	} `json:"items"`
+}
+
+// ListCollectionVideoIDs returns up to limit video IDs from a playlist or
+// channel URL, in playlist order. Channel URLs (/channel/UC... or /@handle)
+// resolve to the channel's uploads playlist.
+func (c *Client) ListCollectionVideoIDs(ctx context.Context, collectionURL string, limit int) ([]string, error) {
+	collection, err := ParseCollectionURL(collectionURL)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", domain.ErrInvalidURL, err)
+	}
+
+	playlistID := collection.ID
+	if collection.Kind != CollectionPlaylist {
+		playlistID, err = c.uploadsPlaylistID(ctx, collection)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return c.playlistVideoIDs(ctx, playlistID, limit)
+}
+
+// uploadsPlaylistID resolves a channel to the playlist holding its uploads
+func (c *Client) uploadsPlaylistID(ctx context.Context, channel Collection) (string, error) {
+	params := url.Values{"part": {"contentDetails"}}
+	if channel.Kind == CollectionChannelHandle {
+		params.Set("forHandle", channel.ID)
+	} else {
+		params.Set("id", channel.ID)
+	}
+
+	body, err := c.get(ctx, "channels", params, "channel")
+	if err != nil {
+		return "", err
+	}
+
+	var resp channelsResponse
+	if err := json.Unmarshal(body, &resp); err != nil {
+		return "", fmt.Errorf("failed to parse YouTube API response: %w", err)
+	}
+	if len(resp.Items) == 0 || resp.Items[0].ContentDetails.RelatedPlaylists.Uploads == "" {
+		return "", fmt.Errorf("%w: channel not found: %s", domain.ErrNotFound, channel.ID)
+	}
+	return resp.Items[0].ContentDetails.RelatedPlaylists.Uploads, nil
+}
+
+// playlistVideoIDs pages through playlistItems until limit IDs are collected
+// or the playlist ends
+func (c *Client) playlistVideoIDs(ctx context.Context, playlistID string, limit int) ([]string, error) {
+	var ids []string
+	pageToken := ""
+	for len(ids) < limit {
+		params := url.Values{
+			"part":       {"contentDetails"},
+			"playlistId": {playlistID},
+			"maxResults": {strconv.Itoa(min(maxIDsPerRequest, limit-len(ids)))},
+		}
+		if pageToken != "" {
+			params.Set("pageToken", pageToken)
+		}
+
+		body, err := c.get(ctx, "playlistItems", params, "playlist items")
+		if err != nil {
+			return nil, err
+		}
+
+		var page playlistItemsResponse
+		if err := json.Unmarshal(body, &page); err != nil {
+			return nil, fmt.Errorf("failed to parse YouTube API response: %w", err)
+		}
+		for _, item := range page.Items {
+			if item.ContentDetails.VideoID != "" && len(ids) < limit {
+				ids = append(ids, item.ContentDetails.VideoID)
+			}
+		}
+
+		if page.NextPageToken == "" {
+			break
+		}
+		pageToken = page.NextPageToken
+	}
+	return ids, nil
+}
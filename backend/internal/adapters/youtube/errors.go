@@ -0,0 +1,123 @@
+package youtube
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/CodeWarrior-debug/perspectize/backend/internal/core/domain"
+)
+
+// ErrKeyInvalid is matched by errors.Is when YouTube rejects the API key
+var ErrKeyInvalid = errors.New("youtube API key invalid")
+
+// Error reasons from Google's error envelope that the client acts on
+const (
+	ReasonQuotaExceeded         = "quotaExceeded"
+	ReasonDailyLimitExceeded    = "dailyLimitExceeded"
+	ReasonRateLimitExceeded     = "rateLimitExceeded"
+	ReasonUserRateLimitExceeded = "userRateLimitExceeded"
+	ReasonKeyInvalid            = "keyInvalid"
+	ReasonVideoNotFound         = "videoNotFound"
+	ReasonPlaylistNotFound      = "playlistNotFound"
+	ReasonChannelNotFound       = "channelNotFound"
+)
+
+// maxErrorMessageLen bounds how much of an unparseable error body is kept
+const maxErrorMessageLen = 200
+
+// APIError is a non-200 response from the YouTube Data API. It matches
+// domain.ErrYouTubeAPI with errors.Is, plus domain.ErrQuotaExceeded,
+// ErrKeyInvalid or domain.ErrNotFound depending on Reason.
+type APIError struct {
+	StatusCode int
+	Reason     string // First reason in the error envelope, e.g. "quotaExceeded"; empty if absent
+	Message    string
+}
+
+func (e *APIError) Error() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "%s: status %d", domain.ErrYouTubeAPI, e.StatusCode)
+	if e.Reason != "" {
+		fmt.Fprintf(&b, " (%s)", e.Reason)
+	}
+	if e.Message != "" {
+		b.WriteString(": ")
+		b.WriteString(e.Message)
+	}
+	return b.String()
+}
+
+func (e *APIError) Unwrap() []error {
+	errs := []error{domain.ErrYouTubeAPI}
+	switch e.Reason {
+	case ReasonQuotaExceeded, ReasonDailyLimitExceeded:
+		errs = append(errs, domain.ErrQuotaExceeded)
+	case ReasonKeyInvalid:
+		errs = append(errs, ErrKeyInvalid)
+	case ReasonVideoNotFound, ReasonPlaylistNotFound, ReasonChannelNotFound:
+		errs = append(errs, domain.ErrNotFound)
+	}
+	return errs
+}
+
+// quotaExhausted reports whether YouTube said the daily quota is spent
+func (e *APIError) quotaExhausted() bool {
+	return e.Reason == ReasonQuotaExceeded || e.Reason == ReasonDailyLimitExceeded
+}
+
+// temporary reports whether the request may succeed if retried
+func (e *APIError) temporary() bool {
+	switch e.StatusCode {
+	case http.StatusTooManyRequests,
+		http.StatusInternalServerError,
+		http.StatusBadGateway,
+		http.StatusServiceUnavailable,
+		http.StatusGatewayTimeout:
+		return true
+	case http.StatusForbidden:
+		return e.Reason == ReasonRateLimitExceeded || e.Reason == ReasonUserRateLimitExceeded
+	}
+	return false
+}
+
+// parseAPIError builds an APIError from a non-200 response, reading Google's
+// {"error": {"message": ..., "errors": [{"reason": ...}]}} envelope when
+// present. apiKey is scrubbed from the message.
+func parseAPIError(statusCode int, body []byte, apiKey string) *APIError {
+	apiErr := &APIError{StatusCode: statusCode}
+
+	var envelope struct {
+		Error struct {
+			Message string `json:"message"`
+			Errors  []struct {
+				Reason string `json:"reason"`
+			} `json:"errors"`
+		} `json:"error"`
+	}
+	if err := json.Unmarshal(body, &envelope); err == nil {
+		apiErr.Message = envelope.Error.Message
+		if len(envelope.Error.Errors) > 0 {
+			apiErr.Reason = envelope.Error.Errors[0].Reason
+		}
+	} else {
+		apiErr.Message = strings.TrimSpace(string(body))
+	}
+
+	// Redact before truncating: a cut through the key would leave a prefix
+	// that no longer matches
+	if apiKey != "" {
+		apiErr.Message = strings.ReplaceAll(apiErr.Message, apiKey, "[REDACTED]")
+	}
+	if len(apiErr.Message) > maxErrorMessageLen {
+		cut := maxErrorMessageLen
+		for cut > 0 && !utf8.RuneStart(apiErr.Message[cut]) {
+			cut--
+		}
+		apiErr.Message = apiErr.Message[:cut] + "..."
+	}
+	return apiErr
+}
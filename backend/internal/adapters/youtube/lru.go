@@ -0,0 +1,61 @@
+package youtube
+
+import (
+	"container/list"
+	"sync"
+
+	"github.com/CodeWarrior-debug/perspectize/backend/internal/core/domain"
+)
+
+// lru is a fixed-capacity, concurrency-safe least-recently-used cache of
+// video entries. Entries are treated as immutable once stored.
+type lru struct {
+	mu       sync.Mutex
+	capacity int
+	order    *list.List // Front is most recently used
+	items    map[string]*list.Element
+}
+
+func newLRU(capacity int) *lru {
+	return &lru{
+		capacity: capacity,
+		order:    list.New(),
+		items:    make(map[string]*list.Element, capacity),
+	}
+}
+
+func (l *lru) get(videoID string) (*domain.YouTubeVideoCacheEntry, bool) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	elem, ok := l.items[videoID]
+	if !ok {
+		return nil, false
+	}
+	l.order.MoveToFront(elem)
+	return elem.Value.(*domain.YouTubeVideoCacheEntry), true
+}
+
+func (l *lru) put(entry *domain.YouTubeVideoCacheEntry) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if elem, ok := l.items[entry.VideoID]; ok {
+		elem.Value = entry
+		l.order.MoveToFront(elem)
+		return
+	}
+	l.items[entry.VideoID] = l.order.PushFront(entry)
+	if l.order.Len() > l.capacity {
+		oldest := l.order.Back()
+		l.order.Remove(oldest)
+		delete(l.items, oldest.Value.(*domain.YouTubeVideoCacheEntry).VideoID)
+	}
+}
+
+func (l *lru) remove(videoID string) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if elem, ok := l.items[videoID]; ok {
+		l.order.Remove(elem)
+		delete(l.items, videoID)
+	}
+}
@@ -2,6 +2,7 @@ package youtube
 
 import (
 	"fmt"
+	"net/url"
 	"regexp"
 	"strconv"
 	"strings"
@@ -71,3 +72,51 @@ func ParseISO8601Duration(duration string) (int, error) {
 
 	return hours*3600 + minutes*60 + seconds, nil
 }
+
+// CollectionKind identifies what a YouTube collection URL points at
+type CollectionKind string
+
+const (
+	CollectionPlaylist      CollectionKind = "playlist"
+	CollectionChannelID     CollectionKind = "channel_id"
+	CollectionChannelHandle CollectionKind = "channel_handle"
+)
+
+// Collection is a playlist or channel parsed from a URL
+type Collection struct {
+	Kind CollectionKind
+	ID   string // Playlist ID, channel ID (UC...), or handle including the leading @
+}
+
+var (
+	collectionHostPattern = regexp.MustCompile(`^(?:https?://)?(?:www\.|m\.|music\.)?youtube\.com(/[^?#]*)?(?:\?([^#]*))?`)
+	playlistIDPattern     = regexp.MustCompile(`^[a-zA-Z0-9_-]+$`)
+	channelPathPattern    = regexp.MustCompile(`^/channel/(UC[a-zA-Z0-9_-]{22})(?:/|$)`)
+	handlePathPattern     = regexp.MustCompile(`^/(@[a-zA-Z0-9._-]{3,30})(?:/|$)`)
+)
+
+// ParseCollectionURL recognizes playlist and channel URLs.
+// Supported: any youtube.com URL with a list= parameter (including
+// /playlist and /watch?v=...&list=), /channel/UC..., and /@handle.
+// The list= parameter takes precedence over the path.
+func ParseCollectionURL(rawURL string) (Collection, error) {
+	matches := collectionHostPattern.FindStringSubmatch(strings.TrimSpace(rawURL))
+	if matches == nil {
+		return Collection{}, fmt.Errorf("not a YouTube playlist or channel URL: %s", rawURL)
+	}
+	path, query := matches[1], matches[2]
+
+	if values, err := url.ParseQuery(query); err == nil {
+		if list := values.Get("list"); list != "" && playlistIDPattern.MatchString(list) {
+			return Collection{Kind: CollectionPlaylist, ID: list}, nil
+		}
+	}
+	if m := channelPathPattern.FindStringSubmatch(path); m != nil {
+		return Collection{Kind: CollectionChannelID, ID: m[1]}, nil
+	}
+	if m := handlePathPattern.FindStringSubmatch(path); m != nil {
+		return Collection{Kind: CollectionChannelHandle, ID: m[1]}, nil
+	}
+
+	return Collection{}, fmt.Errorf("not a YouTube playlist or channel URL: %s", rawURL)
+}
@@ -0,0 +1,272 @@
+package youtube
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"sort"
+	"sync"
+	"time"
+	_ "time/tzdata" // Quota days follow America/Los_Angeles on hosts without zoneinfo
+
+	"github.com/CodeWarrior-debug/perspectize/backend/internal/core/domain"
+	"github.com/CodeWarrior-debug/perspectize/backend/internal/core/ports/repositories"
+	"github.com/CodeWarrior-debug/perspectize/backend/internal/core/ports/services"
+)
+
+// methodCosts is the quota cost of each Data API method the client calls.
+// Every list call costs one unit regardless of parts or page size.
+var methodCosts = map[string]int{
+	"videos.list":        1,
+	"channels.list":      1,
+	"playlistItems.list": 1,
+}
+
+// quotaLocation is where YouTube's daily quota resets at midnight
+var quotaLocation = mustLoadLocation("America/Los_Angeles")
+
+func mustLoadLocation(name string) *time.Location {
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		panic(err)
+	}
+	return loc
+}
+
+// Defaults for QuotaConfig fields left at zero
+const (
+	DefaultFailureThreshold = 5
+	DefaultBreakerCooldown  = time.Minute
+)
+
+// QuotaConfig controls quota accounting and the circuit breaker
+type QuotaConfig struct {
+	// DailyBudget is the most units to spend per quota day; zero disables the check
+	DailyBudget int
+	// FailureThreshold is how many consecutive 403, 429 or 5xx responses or
+	// network errors open the breaker
+	FailureThreshold int
+	// Cooldown is how long the breaker stays open before letting a probe through
+	Cooldown time.Duration
+}
+
+// Quota tracks quota units spent by a Client and trips a circuit breaker when
+// the budget runs out or the API keeps failing. It implements QuotaService.
+type Quota struct {
+	store repositories.QuotaUsageRepository
+	cfg   QuotaConfig
+	now   func() time.Time
+
+	mu        sync.Mutex
+	day       time.Time // Start of the quota day used holds spend for
+	used      map[string]int
+	failures  int
+	openUntil time.Time // Zero while closed
+	probing   bool      // A half-open probe request is in flight
+}
+
+// Compile-time interface check
+var _ services.QuotaService = (*Quota)(nil)
+
+// QuotaOption configures a Quota
+type QuotaOption func(*Quota)
+
+// WithQuotaClock overrides the time source (used by tests)
+func WithQuotaClock(now func() time.Time) QuotaOption {
+	return func(q *Quota) {
+		q.now = now
+	}
+}
+
+// NewQuota creates quota accounting persisted to store
+func NewQuota(store repositories.QuotaUsageRepository, cfg QuotaConfig, opts ...QuotaOption) *Quota {
+	if cfg.FailureThreshold <= 0 {
+		cfg.FailureThreshold = DefaultFailureThreshold
+	}
+	if cfg.Cooldown <= 0 {
+		cfg.Cooldown = DefaultBreakerCooldown
+	}
+	q := &Quota{store: store, cfg: cfg, now: time.Now}
+	for _, opt := range opts {
+		opt(q)
+	}
+	return q
+}
+
+// WithQuota makes the client account for quota and fail fast with
+// domain.ErrQuotaExceeded when q's budget or circuit breaker says so
+func WithQuota(q *Quota) Option {
+	return func(c *Client) {
+		c.quota = q
+	}
+}
+
+// Usage returns the units spent so far in the current quota day
+func (q *Quota) Usage(ctx context.Context) (*domain.YouTubeQuotaUsage, error) {
+	now := q.now()
+	if err := q.rollDay(ctx, now); err != nil {
+		return nil, err
+	}
+
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	usage := &domain.YouTubeQuotaUsage{
+		Day:      q.day,
+		ResetsAt: q.day.AddDate(0, 0, 1),
+		Budget:   q.cfg.DailyBudget,
+		Breaker:  q.state(now),
+	}
+	for method, units := range q.used {
+		usage.Used += units
+		usage.ByMethod = append(usage.ByMethod, domain.QuotaMethodUsage{Method: method, Units: units})
+	}
+	sort.Slice(usage.ByMethod, func(i, j int) bool {
+		return usage.ByMethod[i].Method < usage.ByMethod[j].Method
+	})
+	if !q.openUntil.IsZero() {
+		openUntil := q.openUntil
+		usage.BreakerOpenUntil = &openUntil
+	}
+	return usage, nil
+}
+
+// acquire reserves the cost of one call to method, failing fast while the
+// breaker is open or the budget is spent. Units are charged up front because
+// YouTube bills every request it receives, including failed ones. While the
+// day's persisted spend cannot be loaded the budget is unknown, so calls fail
+// the same way.
+func (q *Quota) acquire(ctx context.Context, method string) error {
+	cost := methodCosts[method]
+
+	now := q.now()
+	if err := q.rollDay(ctx, now); err != nil {
+		slog.Warn("failed to load YouTube quota usage", "error", err)
+		return fmt.Errorf("%w: quota usage unavailable", domain.ErrQuotaExceeded)
+	}
+
+	q.mu.Lock()
+	switch q.state(now) {
+	case domain.CircuitOpen:
+		openUntil := q.openUntil
+		q.mu.Unlock()
+		return fmt.Errorf("%w: circuit breaker open until %s", domain.ErrQuotaExceeded, openUntil.Format(time.RFC3339))
+	case domain.CircuitHalfOpen:
+		if q.probing {
+			q.mu.Unlock()
+			return fmt.Errorf("%w: circuit breaker probing", domain.ErrQuotaExceeded)
+		}
+		q.probing = true
+	}
+
+	if q.cfg.DailyBudget > 0 && q.total()+cost > q.cfg.DailyBudget {
+		q.probing = false
+		q.mu.Unlock()
+		return fmt.Errorf("%w: daily budget of %d units spent", domain.ErrQuotaExceeded, q.cfg.DailyBudget)
+	}
+	q.used[method] += cost
+	day := q.day
+	q.mu.Unlock()
+
+	// Persisting is best-effort; the in-memory total still enforces the budget
+	if err := q.store.AddUsage(context.WithoutCancel(ctx), day, method, cost); err != nil {
+		slog.Warn("failed to persist YouTube quota usage", "method", method, "error", err)
+	}
+	return nil
+}
+
+// succeeded closes the breaker after a response that shows the API is healthy
+func (q *Quota) succeeded() {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	q.failures = 0
+	q.openUntil = time.Time{}
+	q.probing = false
+}
+
+// failed counts a 403, 429, 5xx or network failure, opening the breaker once
+// the threshold is reached or immediately if a half-open probe failed.
+// exhausted means YouTube reported the daily quota spent, which keeps the
+// breaker open until the quota resets.
+func (q *Quota) failed(exhausted bool) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	now := q.now()
+	q.failures++
+	switch {
+	case exhausted:
+		q.openUntil = quotaDayStart(now).AddDate(0, 0, 1)
+	case q.probing || q.failures >= q.cfg.FailureThreshold:
+		q.openUntil = now.Add(q.cfg.Cooldown)
+	}
+	q.probing = false
+}
+
+// abandoned releases a half-open probe whose outcome is unknown, e.g. because
+// the caller's context was canceled
+func (q *Quota) abandoned() {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	q.probing = false
+}
+
+// state returns the breaker state at now. Callers must hold q.mu.
+func (q *Quota) state(now time.Time) domain.CircuitState {
+	switch {
+	case q.openUntil.IsZero():
+		return domain.CircuitClosed
+	case now.Before(q.openUntil):
+		return domain.CircuitOpen
+	default:
+		return domain.CircuitHalfOpen
+	}
+}
+
+// rollDay starts a new quota day when now has passed the current one, loading
+// any spend already persisted for it. On a load error nothing changes, so the
+// next call tries again rather than starting the day from zero. Callers must
+// not hold q.mu: the store is read without it, so a slow database does not
+// hold up calls that only need the breaker.
+func (q *Quota) rollDay(ctx context.Context, now time.Time) error {
+	day := quotaDayStart(now)
+	q.mu.Lock()
+	current := q.used != nil && !day.After(q.day)
+	q.mu.Unlock()
+	if current {
+		return nil
+	}
+
+	used, err := q.store.GetUsage(ctx, day)
+	if err != nil {
+		return fmt.Errorf("failed to load quota usage for %s: %w", day.Format(time.DateOnly), err)
+	}
+	if used == nil {
+		used = make(map[string]int)
+	}
+
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	// Another call may have loaded the day, and charged it, meanwhile
+	if q.used != nil && !day.After(q.day) {
+		return nil
+	}
+	q.day = day
+	q.used = used
+	return nil
+}
+
+// total returns the units spent in the current quota day. Callers must hold q.mu.
+func (q *Quota) total() int {
+	total := 0
+	for _, units := range q.used {
+		total += units
+	}
+	return total
+}
+
+// quotaDayStart returns midnight Pacific time at the start of t's quota day
+func quotaDayStart(t time.Time) time.Time {
+	y, m, d := t.In(quotaLocation).Date()
+	return time.Date(y, m, d, 0, 0, 0, 0, quotaLocation)
+}
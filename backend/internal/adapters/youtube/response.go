@@ -0,0 +1,85 @@
+package youtube
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// ResponseMode controls how much of each video's API response is kept in
+// VideoMetadata.Response for storage
+type ResponseMode string
+
+const (
+	// ResponseModeFull keeps the item exactly as YouTube returned it
+	ResponseModeFull ResponseMode = "full"
+	// ResponseModeTrimmed drops bulky snippet fields that are either stored in
+	// typed columns (description, tags) or never read (thumbnails, localized)
+	ResponseModeTrimmed ResponseMode = "trimmed"
+	// ResponseModeNone stores no response at all
+	ResponseModeNone ResponseMode = "none"
+)
+
+// trimmedSnippetFields are removed from snippet in ResponseModeTrimmed
+var trimmedSnippetFields = []string{"thumbnails", "localized", "description", "tags"}
+
+// ParseResponseMode parses a config value; empty selects ResponseModeTrimmed
+func ParseResponseMode(s string) (ResponseMode, error) {
+	switch mode := ResponseMode(strings.ToLower(strings.TrimSpace(s))); mode {
+	case "":
+		return ResponseModeTrimmed, nil
+	case ResponseModeFull, ResponseModeTrimmed, ResponseModeNone:
+		return mode, nil
+	default:
+		return "", fmt.Errorf("invalid YouTube response storage mode %q (want full, trimmed or none)", s)
+	}
+}
+
+// WithResponseMode sets how much of the API response is kept for storage
+func WithResponseMode(mode ResponseMode) Option {
+	return func(c *Client) {
+		c.responseMode = mode
+	}
+}
+
+// storedResponse builds the response to store for one videos endpoint item, in
+// the same {"items": [...]} shape as a single-video API response
+func (c *Client) storedResponse(raw json.RawMessage) json.RawMessage {
+	switch c.responseMode {
+	case ResponseModeNone:
+		return nil
+	case ResponseModeFull:
+	default:
+		raw = trimItem(raw)
+	}
+	response, _ := json.Marshal(map[string][]json.RawMessage{"items": {raw}})
+	return response
+}
+
+// trimItem removes trimmedSnippetFields from a raw item, returning it
+// unchanged if it cannot be parsed
+func trimItem(raw json.RawMessage) json.RawMessage {
+	var item map[string]json.RawMessage
+	if err := json.Unmarshal(raw, &item); err != nil {
+		return raw
+	}
+	var snippet map[string]json.RawMessage
+	if err := json.Unmarshal(item["snippet"], &snippet); err != nil {
+		return raw
+	}
+	for _, field := range trimmedSnippetFields {
+		delete(snippet, field)
+	}
+
+	trimmedSnippet, err := json.Marshal(snippet)
+	if err != nil {
+		return raw
+	}
+	item["snippet"] = trimmedSnippet
+
+	trimmed, err := json.Marshal(item)
+	if err != nil {
+		return raw
+	}
+	return trimmed
+}
@@ -0,0 +1,100 @@
+package youtube
+
+import (
+	"context"
+	"math/rand/v2"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// Defaults for the client's transport settings
+const (
+	DefaultRequestTimeout   = 10 * time.Second
+	DefaultMaxAttempts      = 3
+	DefaultRetryBaseDelay   = 500 * time.Millisecond
+	DefaultRetryMaxDelay    = 5 * time.Second
+	DefaultMaxResponseBytes = 10 << 20
+)
+
+// RetryPolicy controls retries of 429, 5xx and rate-limit 403 responses and
+// network errors. Delays grow exponentially from BaseDelay up to MaxDelay,
+// with jitter; a Retry-After header is honored up to MaxDelay.
+type RetryPolicy struct {
+	MaxAttempts int // Total attempts including the first; 1 disables retries
+	BaseDelay   time.Duration
+	MaxDelay    time.Duration
+}
+
+// WithRetry sets the retry policy; zero fields keep their defaults
+func WithRetry(policy RetryPolicy) Option {
+	return func(c *Client) {
+		if policy.MaxAttempts > 0 {
+			c.retry.MaxAttempts = policy.MaxAttempts
+		}
+		if policy.BaseDelay > 0 {
+			c.retry.BaseDelay = policy.BaseDelay
+		}
+		if policy.MaxDelay > 0 {
+			c.retry.MaxDelay = policy.MaxDelay
+		}
+	}
+}
+
+// WithRequestTimeout bounds each attempt, including reading the body; zero
+// disables the per-attempt timeout
+func WithRequestTimeout(timeout time.Duration) Option {
+	return func(c *Client) {
+		c.timeout = timeout
+	}
+}
+
+// WithMaxResponseBytes caps the size of a response body the client will read
+func WithMaxResponseBytes(n int64) Option {
+	return func(c *Client) {
+		if n > 0 {
+			c.maxResponseBytes = n
+		}
+	}
+}
+
+// delay returns how long to wait before retry number attempt (1-based)
+func (p RetryPolicy) delay(attempt int, retryAfter time.Duration) time.Duration {
+	backoff := p.MaxDelay
+	if shift := attempt - 1; shift < 30 {
+		backoff = min(p.BaseDelay<<shift, p.MaxDelay)
+	}
+	// Equal jitter: at least half the backoff, so retries never bunch at zero
+	d := backoff/2 + rand.N(backoff/2+1)
+	if retryAfter > d {
+		d = min(retryAfter, p.MaxDelay)
+	}
+	return d
+}
+
+// parseRetryAfter reads a Retry-After header given in seconds or as an HTTP date
+func parseRetryAfter(h http.Header, now time.Time) time.Duration {
+	v := h.Get("Retry-After")
+	if v == "" {
+		return 0
+	}
+	if secs, err := strconv.Atoi(v); err == nil && secs > 0 {
+		return time.Duration(secs) * time.Second
+	}
+	if t, err := http.ParseTime(v); err == nil && t.After(now) {
+		return t.Sub(now)
+	}
+	return 0
+}
+
+// sleep waits for d or until ctx is done
+func sleep(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
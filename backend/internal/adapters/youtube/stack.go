@@ -0,0 +1,72 @@
+package youtube
+
+import (
+	"errors"
+	"time"
+
+	"github.com/CodeWarrior-debug/perspectize/backend/internal/config"
+	"github.com/CodeWarrior-debug/perspectize/backend/internal/core/ports/repositories"
+	"github.com/CodeWarrior-debug/perspectize/backend/internal/core/ports/services"
+)
+
+// Stack is the YouTube client as the application runs it: quota accounting
+// and circuit breaker, retries and timeouts, response storage and, when
+// enabled, the metadata cache
+type Stack struct {
+	// API is the uncached client, for calls the cache does not cover
+	API *Client
+	// Videos serves video metadata, through the cache when it is enabled
+	Videos services.YouTubeClient
+	Quota  *Quota
+}
+
+// NewStack builds the YouTube client from cfg. Quota usage is persisted to
+// quotaStore; cacheStore backs the cache when cfg.Cache.Persistent is set.
+// extra options are applied to the client last (used by tests).
+func NewStack(cfg config.YouTubeConfig, quotaStore repositories.QuotaUsageRepository, cacheStore repositories.YouTubeCacheRepository, extra ...Option) (*Stack, error) {
+	responseMode, err := ParseResponseMode(cfg.ResponseStorage)
+	if err != nil {
+		return nil, err
+	}
+
+	quota := NewQuota(quotaStore, QuotaConfig{
+		DailyBudget:      cfg.Quota.DailyBudget,
+		FailureThreshold: cfg.Quota.FailureThreshold,
+		Cooldown:         time.Duration(cfg.Quota.CooldownSeconds) * time.Second,
+	})
+	opts := []Option{
+		WithResponseMode(responseMode),
+		WithQuota(quota),
+		WithRetry(RetryPolicy{
+			MaxAttempts: cfg.HTTP.MaxAttempts,
+			BaseDelay:   time.Duration(cfg.HTTP.RetryBaseDelayMs) * time.Millisecond,
+			MaxDelay:    time.Duration(cfg.HTTP.RetryMaxDelayMs) * time.Millisecond,
+		}),
+		WithMaxResponseBytes(cfg.HTTP.MaxResponseBytes),
+	}
+	if cfg.HTTP.TimeoutSeconds > 0 {
+		opts = append(opts, WithRequestTimeout(time.Duration(cfg.HTTP.TimeoutSeconds)*time.Second))
+	}
+	api := NewClient(cfg.APIKey, append(opts, extra...)...)
+
+	stack := &Stack{API: api, Videos: api, Quota: quota}
+	if !cfg.Cache.Enabled {
+		return stack, nil
+	}
+	var cacheOpts []CacheOption
+	if cfg.Cache.Persistent {
+		if cacheStore == nil {
+			return nil, errors.New("persistent YouTube cache needs a cache store")
+		}
+		cacheOpts = append(cacheOpts, WithCacheStore(cacheStore))
+	}
+	stack.Videos = NewCachingClient(api, CacheConfig{
+		Capacity: cfg.Cache.Capacity,
+		TTLs: map[string]time.Duration{
+			PartSnippet:        time.Duration(cfg.Cache.SnippetTTLMinutes) * time.Minute,
+			PartStatistics:     time.Duration(cfg.Cache.StatisticsTTLMinutes) * time.Minute,
+			PartContentDetails: time.Duration(cfg.Cache.ContentDetailsTTLMinutes) * time.Minute,
+		},
+	}, cacheOpts...)
+	return stack, nil
+}
@@ -4,14 +4,17 @@ import (
 	"encoding/json"
 	"fmt"
 	"os"
+	"strings"
 )
 
 // Config represents the application configuration
 type Config struct {
-	Server   ServerConfig   `json:"server"`
-	Database DatabaseConfig `json:"database"`
-	YouTube  YouTubeConfig  `json:"youtube"`
-	Logging  LoggingConfig  `json:"logging"`
+	Server     ServerConfig     `json:"server"`
+	Database   DatabaseConfig   `json:"database"`
+	YouTube    YouTubeConfig    `json:"youtube"`
+	Search     SearchConfig     `json:"search"`
+	Pagination PaginationConfig `json:"pagination"`
+	Logging    LoggingConfig    `json:"logging"`
 }
 
 // ServerConfig holds HTTP server configuration
@@ -32,7 +35,69 @@ type DatabaseConfig struct {
 
 // YouTubeConfig holds YouTube API configuration
 type YouTubeConfig struct {
-	APIKey string `json:"api_key"` // Will be overridden by env var
+	APIKey  string               `json:"api_key"` // Will be overridden by env var
+	Refresh YouTubeRefreshConfig `json:"refresh"`
+	Quota   YouTubeQuotaConfig   `json:"quota"`
+	HTTP    YouTubeHTTPConfig    `json:"http"`
+	Cache   YouTubeCacheConfig   `json:"cache"`
+	// ResponseStorage is how much of each API response is stored with content:
+	// "full", "trimmed" (default) or "none"
+	ResponseStorage string `json:"response_storage"`
+}
+
+// SearchConfig holds settings for content and user search
+type SearchConfig struct {
+	// SimilarityThreshold is the minimum trigram similarity, from 0 to 1, for
+	// a fuzzy match; 0 keeps the default of 0.3
+	SimilarityThreshold float64 `json:"similarity_threshold"`
+}
+
+// PaginationConfig holds settings for list queries
+type PaginationConfig struct {
+	// CountCacheTTLSeconds is how long a CACHED total count is reused; 0
+	// keeps the default of 30
+	CountCacheTTLSeconds int `json:"count_cache_ttl_seconds"`
+	// CursorSecrets are the keys that sign pagination cursors. The first
+	// signs; the rest still verify, so a rotated-out secret can stay listed
+	// until its cursors are no longer in use.
+	CursorSecrets []string `json:"cursor_secrets,omitempty"` // Will be overridden by env var
+}
+
+// YouTubeRefreshConfig holds settings for the background metadata refresher
+type YouTubeRefreshConfig struct {
+	Enabled           bool `json:"enabled"`
+	IntervalMinutes   int  `json:"interval_minutes"`
+	MaxAgeHours       int  `json:"max_age_hours"`
+	BatchSize         int  `json:"batch_size"`
+	RequestsPerSecond int  `json:"requests_per_second"`
+}
+
+// YouTubeQuotaConfig holds settings for quota accounting and the API circuit breaker
+type YouTubeQuotaConfig struct {
+	DailyBudget      int `json:"daily_budget"` // 0 disables the budget check
+	FailureThreshold int `json:"failure_threshold"`
+	CooldownSeconds  int `json:"cooldown_seconds"`
+}
+
+// YouTubeHTTPConfig holds timeout, retry and size limits for YouTube API
+// requests; zero values keep the client defaults
+type YouTubeHTTPConfig struct {
+	TimeoutSeconds   int   `json:"timeout_seconds"`
+	MaxAttempts      int   `json:"max_attempts"`
+	RetryBaseDelayMs int   `json:"retry_base_delay_ms"`
+	RetryMaxDelayMs  int   `json:"retry_max_delay_ms"`
+	MaxResponseBytes int64 `json:"max_response_bytes"`
+}
+
+// YouTubeCacheConfig holds settings for the video metadata response cache;
+// zero TTLs keep the client defaults
+type YouTubeCacheConfig struct {
+	Enabled                  bool `json:"enabled"`
+	Capacity                 int  `json:"capacity"`   // Videos held in memory
+	Persistent               bool `json:"persistent"` // Back the in-memory cache with Postgres
+	SnippetTTLMinutes        int  `json:"snippet_ttl_minutes"`
+	StatisticsTTLMinutes     int  `json:"statistics_ttl_minutes"`
+	ContentDetailsTTLMinutes int  `json:"content_details_ttl_minutes"`
 }
 
 // LoggingConfig holds logging configuration
@@ -66,6 +131,16 @@ func Load(configPath string) (*Config, error) {
 		cfg.YouTube.APIKey = ytAPIKey
 	}
 
+	// Comma-separated, newest first
+	if cursorSecrets := os.Getenv("CURSOR_SECRETS"); cursorSecrets != "" {
+		cfg.Pagination.CursorSecrets = nil
+		for _, secret := range strings.Split(cursorSecrets, ",") {
+			if secret = strings.TrimSpace(secret); secret != "" {
+				cfg.Pagination.CursorSecrets = append(cfg.Pagination.CursorSecrets, secret)
+			}
+		}
+	}
+
 	return &cfg, nil
 }
 
@@ -0,0 +1,31 @@
+package domain
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// AuditAction names an administrative change recorded in the audit log
+type AuditAction string
+
+const (
+	// AuditActionContentMerge records content merged into another item
+	AuditActionContentMerge AuditAction = "content.merge"
+)
+
+// AuditEntry is one row of the audit log. EntityID is the row that was
+// changed; Details holds action-specific JSON.
+type AuditEntry struct {
+	ID         int64
+	Action     AuditAction
+	EntityType string
+	EntityID   int
+	Details    json.RawMessage
+	CreatedAt  time.Time
+}
+
+// ContentMergeResult reports the outcome of merging one content item into another
+type ContentMergeResult struct {
+	Target            *Content
+	PerspectivesMoved int
+}
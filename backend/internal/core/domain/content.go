@@ -2,6 +2,7 @@ package domain
 
 import (
 	"encoding/json"
+	"strings"
 	"time"
 )
 
@@ -12,16 +13,78 @@ const (
 	ContentTypeYouTube ContentType = "YOUTUBE"
 )
 
+// ContentDeletePolicy controls what happens to perspectives that reference
+// content being deleted
+type ContentDeletePolicy string
+
+const (
+	// ContentDeletePolicyRestrict blocks deletion while perspectives reference the content
+	ContentDeletePolicyRestrict ContentDeletePolicy = "RESTRICT"
+	// ContentDeletePolicyDetach nulls content_id on referencing perspectives, then deletes
+	ContentDeletePolicyDetach ContentDeletePolicy = "DETACH"
+)
+
+// IsValid returns true if the policy is a known ContentDeletePolicy value
+func (p ContentDeletePolicy) IsValid() bool {
+	return p == ContentDeletePolicyRestrict || p == ContentDeletePolicyDetach
+}
+
+// IfExistsPolicy controls what creating content does when it is already stored
+type IfExistsPolicy string
+
+const (
+	// IfExistsError fails with ErrAlreadyExists
+	IfExistsError IfExistsPolicy = "ERROR"
+	// IfExistsReturnExisting returns the stored content unchanged
+	IfExistsReturnExisting IfExistsPolicy = "RETURN_EXISTING"
+	// IfExistsRefresh re-fetches provider metadata for the stored content and returns it
+	IfExistsRefresh IfExistsPolicy = "REFRESH"
+)
+
+// IsValid returns true if the policy is a known IfExistsPolicy value
+func (p IfExistsPolicy) IsValid() bool {
+	return p == IfExistsError || p == IfExistsReturnExisting || p == IfExistsRefresh
+}
+
+// CanonicalKey returns the provider-level identity for an item, e.g.
+// "youtube:dQw4w9WgXcQ". Every URL form of the same video shares one key.
+func CanonicalKey(contentType ContentType, providerID string) string {
+	return strings.ToLower(string(contentType)) + ":" + providerID
+}
+
 // Content represents a media item that users create perspectives on
 type Content struct {
 	ID            int
 	Name          string
 	URL           *string
+	CanonicalKey  *string // Provider identity shared by every URL form of the same item
 	ContentType   ContentType
 	AddedByUserID int
 	Length        *int
 	LengthUnits   *string
-	Response      json.RawMessage
+	// Provider metadata copied out of Response so it can be filtered, sorted
+	// and indexed; nil when the provider omits or hides it
+	ChannelTitle *string
+	Description  *string
+	PublishedAt  *time.Time
+	Tags         []string
+	ViewCount    *int64
+	LikeCount    *int64
+	CommentCount *int64
+	Response     json.RawMessage // Stored provider response; may be trimmed or absent
+	// UnavailableAt is when the provider stopped returning the item (deleted,
+	// made private, or never existed); nil while it is available
+	UnavailableAt *time.Time
 	CreatedAt     time.Time
 	UpdatedAt     time.Time
+
+	SearchMatch *ContentSearchMatch // Set only on results of a search
+}
+
+// ContentSearchMatch describes how content matched a full-text search.
+// Highlights wrap matched words in <b></b>.
+type ContentSearchMatch struct {
+	Rank                 float64
+	NameHighlight        string
+	DescriptionHighlight *string // Best fragments of the description; nil when it has none
 }
@@ -0,0 +1,56 @@
+package domain
+
+// ContentFacet names a content attribute that listings can be counted by
+type ContentFacet string
+
+const (
+	ContentFacetContentType  ContentFacet = "CONTENT_TYPE"
+	ContentFacetChannel      ContentFacet = "CHANNEL"
+	ContentFacetTag          ContentFacet = "TAG"
+	ContentFacetLengthBucket ContentFacet = "LENGTH_BUCKET" // See LengthBucket
+	ContentFacetPublishYear  ContentFacet = "PUBLISH_YEAR"
+)
+
+// IsValid returns true if the facet is a known ContentFacet value
+func (f ContentFacet) IsValid() bool {
+	switch f {
+	case ContentFacetContentType, ContentFacetChannel, ContentFacetTag,
+		ContentFacetLengthBucket, ContentFacetPublishYear:
+		return true
+	}
+	return false
+}
+
+// MaxFacetValues bounds how many values are returned per facet; the most
+// common are kept
+const MaxFacetValues = 50
+
+// Length buckets, by length in seconds: short is under 4 minutes, medium
+// under 20, long anything longer
+const (
+	LengthBucketShort  = "SHORT"
+	LengthBucketMedium = "MEDIUM"
+	LengthBucketLong   = "LONG"
+
+	LengthBucketShortMaxSeconds  = 4 * 60
+	LengthBucketMediumMaxSeconds = 20 * 60
+)
+
+// FacetValue is one value of a facet and how many content items have it
+type FacetValue struct {
+	Value string
+	Count int
+}
+
+// ContentFacetCounts holds a facet's values, most common first and then by
+// value. Content without a value for the facet is not counted.
+type ContentFacetCounts struct {
+	Facet  ContentFacet
+	Values []FacetValue
+}
+
+// ContentFacetParams selects the facets to count over content matching Filter
+type ContentFacetParams struct {
+	Filter *ContentFilter
+	Facets []ContentFacet
+}
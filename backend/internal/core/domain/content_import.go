@@ -0,0 +1,35 @@
+package domain
+
+// ContentImportStatus is the outcome of importing a single URL
+type ContentImportStatus string
+
+const (
+	// ContentImportStatusCreated means new content was created
+	ContentImportStatusCreated ContentImportStatus = "CREATED"
+	// ContentImportStatusExists means content for the URL already existed
+	ContentImportStatusExists ContentImportStatus = "EXISTS"
+	// ContentImportStatusInvalidURL means no video ID could be extracted
+	ContentImportStatusInvalidURL ContentImportStatus = "INVALID_URL"
+	// ContentImportStatusProviderError means the provider lookup failed or found no video
+	ContentImportStatusProviderError ContentImportStatus = "PROVIDER_ERROR"
+	// ContentImportStatusFailed means the content could not be saved
+	ContentImportStatusFailed ContentImportStatus = "FAILED"
+)
+
+// ContentImportResult reports what happened to one URL in a bulk import.
+// Content is set for CREATED and EXISTS; Error is set for all other statuses.
+type ContentImportResult struct {
+	URL     string
+	Status  ContentImportStatus
+	Content *Content
+	Error   string
+}
+
+// CollectionImportSummary counts the outcome of importing a playlist or channel.
+// Skipped videos already existed; failed covers every other non-created status.
+type CollectionImportSummary struct {
+	Total   int
+	Created int
+	Skipped int
+	Failed  int
+}
@@ -0,0 +1,44 @@
+package domain
+
+import "time"
+
+// StatsGranularity controls how stats snapshots are bucketed over time
+type StatsGranularity string
+
+const (
+	StatsGranularityDay  StatsGranularity = "DAY"
+	StatsGranularityWeek StatsGranularity = "WEEK"
+)
+
+// IsValid returns true if the granularity is a known StatsGranularity value
+func (g StatsGranularity) IsValid() bool {
+	return g == StatsGranularityDay || g == StatsGranularityWeek
+}
+
+// ContentStatsSnapshot records provider statistics for content at a point in time.
+// Counts are nil when the provider hides them (e.g. likes disabled).
+type ContentStatsSnapshot struct {
+	ContentID    int
+	CapturedAt   time.Time
+	ViewCount    *int64
+	LikeCount    *int64
+	CommentCount *int64
+}
+
+// ContentStatsBucket aggregates snapshots within one day or week. Counts are
+// the highest value observed in the bucket.
+type ContentStatsBucket struct {
+	BucketStart  time.Time
+	ViewCount    *int64
+	LikeCount    *int64
+	CommentCount *int64
+	Samples      int
+}
+
+// ContentStatsHistoryParams selects a range of stats history for one content item
+type ContentStatsHistoryParams struct {
+	ContentID   int
+	From        *time.Time // Inclusive; nil means unbounded
+	To          *time.Time // Exclusive; nil means unbounded
+	Granularity StatsGranularity
+}
@@ -8,7 +8,9 @@ var (
 	ErrInvalidInput   = errors.New("invalid input")
 	ErrInvalidURL     = errors.New("invalid URL")
 	ErrYouTubeAPI     = errors.New("youtube API error")
+	ErrQuotaExceeded  = errors.New("youtube API quota exceeded")
 	ErrInvalidRating  = errors.New("rating must be between 0 and 10000")
 	ErrSentinelUser   = errors.New("cannot modify the system sentinel user")
 	ErrDeleteSentinel = errors.New("cannot delete the system sentinel user")
+	ErrContentInUse   = errors.New("content is referenced by perspectives")
 )
@@ -0,0 +1,66 @@
+package domain
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// MaxFilterValues bounds how many values a list filter (such as labels) may hold
+const MaxFilterValues = 20
+
+// IntRange bounds an integer field; both ends are inclusive and optional
+type IntRange struct {
+	Min *int
+	Max *int
+}
+
+// Validate checks that the range is not inverted; name labels errors
+func (r *IntRange) Validate(name string) error {
+	if r != nil && r.Min != nil && r.Max != nil && *r.Min > *r.Max {
+		return fmt.Errorf("%w: %s min must not exceed max", ErrInvalidInput, name)
+	}
+	return nil
+}
+
+// ValidateRating checks the range is valid and within RatingMin to RatingMax
+func (r *IntRange) ValidateRating(name string) error {
+	if r == nil {
+		return nil
+	}
+	if !ValidateRating(r.Min) || !ValidateRating(r.Max) {
+		return fmt.Errorf("%w: %s range must be between %d and %d", ErrInvalidInput, name, RatingMin, RatingMax)
+	}
+	return r.Validate(name)
+}
+
+// TimeRange bounds a timestamp field; From is inclusive, To exclusive, and
+// either may be nil for an open end
+type TimeRange struct {
+	From *time.Time
+	To   *time.Time
+}
+
+// Validate checks that the range is not empty or inverted; name labels errors
+func (r *TimeRange) Validate(name string) error {
+	if r != nil && r.From != nil && r.To != nil && !r.From.Before(*r.To) {
+		return fmt.Errorf("%w: %s from must be before to", ErrInvalidInput, name)
+	}
+	return nil
+}
+
+// ValidateFilterValues trims a list filter's values and checks there are
+// at most MaxFilterValues of them, none blank
+func ValidateFilterValues(name string, values []string) ([]string, error) {
+	if len(values) > MaxFilterValues {
+		return nil, fmt.Errorf("%w: %s may contain at most %d values", ErrInvalidInput, name, MaxFilterValues)
+	}
+	trimmed := make([]string, len(values))
+	for i, v := range values {
+		trimmed[i] = strings.TrimSpace(v)
+		if trimmed[i] == "" {
+			return nil, fmt.Errorf("%w: %s values must not be blank", ErrInvalidInput, name)
+		}
+	}
+	return trimmed, nil
+}
@@ -0,0 +1,16 @@
+package domain
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// IdempotencyRecord remembers the response to a mutation sent with an
+// Idempotency-Key so a retry gets the same answer instead of running twice.
+// Response is nil while the first request is still in flight.
+type IdempotencyRecord struct {
+	Key         string
+	RequestHash string
+	Response    json.RawMessage
+	CreatedAt   time.Time
+}
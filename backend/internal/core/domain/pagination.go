@@ -10,6 +10,7 @@ const (
 	ContentSortByViewCount   ContentSortBy = "VIEW_COUNT"
 	ContentSortByLikeCount   ContentSortBy = "LIKE_COUNT"
 	ContentSortByPublishedAt ContentSortBy = "PUBLISHED_AT"
+	ContentSortByRelevance   ContentSortBy = "RELEVANCE" // Search rank; requires Filter.Search
 )
 
 // SortOrder represents ascending or descending sort direction
@@ -20,26 +21,92 @@ const (
 	SortOrderDesc SortOrder = "DESC"
 )
 
+// CountStrategy selects how a listing's total count is computed
+type CountStrategy string
+
+const (
+	CountStrategyExact     CountStrategy = "EXACT"     // COUNT(*) on every request
+	CountStrategyEstimated CountStrategy = "ESTIMATED" // Query planner row estimate; see EstimatedCountExactBelow
+	CountStrategyCached    CountStrategy = "CACHED"    // Exact count reused for the same filter for a short TTL
+)
+
+// IsValid returns true if the strategy is a known CountStrategy value
+func (s CountStrategy) IsValid() bool {
+	return s == CountStrategyExact || s == CountStrategyEstimated || s == CountStrategyCached
+}
+
+// EstimatedCountExactBelow is the planner estimate under which an estimated
+// count is replaced by an exact one: counting is cheap at that size, and it
+// is where selective filters make estimates least reliable
+const EstimatedCountExactBelow = 1000
+
 // ContentFilter contains filter criteria for content queries
 type ContentFilter struct {
 	ContentType      *ContentType
 	MinLengthSeconds *int
 	MaxLengthSeconds *int
-	Search           *string
+	Search           *string    // Full-text search syntax (see ParseSearchQuery), or free text when fuzzy
+	SearchMode       SearchMode // Empty means SearchModeFullText
+
+	PublishedAt   *TimeRange
+	CreatedAt     *TimeRange
+	ChannelTitles []string // Exact match on any of these
+	TagsAny       []string // Has at least one of these tags
+	TagsAll       []string // Has every one of these tags
+	AddedByUserID *int
+	MinViewCount  *int64
+
+	MinPerspectives               *int // Has at least this many perspectives, of any privacy
+	WithoutPerspectivesFromUserID *int // The user has no perspective on the content
 }
 
 // ContentListParams contains parameters for paginated content queries
 type ContentListParams struct {
 	First             *int
-	After             *string // Opaque cursor (base64-encoded id)
+	After             *string // Opaque cursor, signed by the repository
 	Last              *int
 	Before            *string
 	SortBy            ContentSortBy
 	SortOrder         SortOrder
 	IncludeTotalCount bool
+	CountStrategy     CountStrategy // Empty means CountStrategyExact
 	Filter            *ContentFilter
 }
 
+// Page-number pagination bounds. Deep pages are capped because OFFSET still
+// reads every skipped row; clients going further should page by cursor.
+const (
+	DefaultPageSize = 25
+	MaxPageSize     = 100
+	MaxPageOffset   = 10000
+)
+
+// ContentPageParams selects one page of content by page number
+type ContentPageParams struct {
+	Page          int // 1-based
+	PageSize      int // 0 means DefaultPageSize
+	SortBy        ContentSortBy
+	SortOrder     SortOrder
+	CountStrategy CountStrategy // Empty means CountStrategyExact
+	Filter        *ContentFilter
+}
+
+// ContentPage is one numbered page of content. StartCursor is the cursor of
+// the row before the page (nil on the first page) and EndCursor that of its
+// last row, so listing after StartCursor returns this page and after
+// EndCursor the ones that follow.
+type ContentPage struct {
+	Items       []*Content
+	Page        int
+	PageSize    int
+	TotalCount  int
+	TotalPages  int
+	StartCursor *string
+	EndCursor   *string
+	// TotalCountExact is false when TotalCount is an estimate or cached
+	TotalCountExact bool
+}
+
 // PaginatedContent represents a paginated list of content
 type PaginatedContent struct {
 	Items       []*Content
@@ -48,4 +115,6 @@ type PaginatedContent struct {
 	StartCursor *string
 	EndCursor   *string
 	TotalCount  *int
+	// TotalCountExact is false when TotalCount is an estimate or cached
+	TotalCountExact bool
 }
@@ -22,6 +22,11 @@ const (
 	ReviewStatusRejected ReviewStatus = "REJECTED"
 )
 
+// IsValid returns true if the status is a known ReviewStatus value
+func (s ReviewStatus) IsValid() bool {
+	return s == ReviewStatusPending || s == ReviewStatusApproved || s == ReviewStatusRejected
+}
+
 // CategorizedRating represents a rating with a category label
 type CategorizedRating struct {
 	Category string `json:"category"`
@@ -48,17 +53,50 @@ type Perspective struct {
 	ReviewStatus *ReviewStatus
 
 	// Array fields
-	Parts  []int    // Array of part identifiers
+	Parts  []int    // Deprecated: superseded by Segments; still stored and returned
 	Labels []string // Array of label strings
 
+	// Time ranges within the content, ordered by start
+	Segments []PerspectiveSegment
+
 	// JSONB field
 	CategorizedRatings []CategorizedRating
 
 	// Timestamps
 	CreatedAt time.Time
 	UpdatedAt time.Time
+
+	SearchMatch *PerspectiveSearchMatch // Set only on results of a search
+}
+
+// PerspectiveSearchMatch describes how a perspective matched a full-text
+// search. Highlights wrap matched words in <b></b>.
+type PerspectiveSearchMatch struct {
+	Rank                 float64
+	DescriptionHighlight *string // Best fragments of the description; nil when it has none
+	LikeHighlight        *string // nil when the perspective has no like text
+}
+
+// PerspectiveSegment is a time range within a perspective's content with an
+// optional note and its own optional ratings
+type PerspectiveSegment struct {
+	Position int // 0-based order within the perspective
+	StartMs  int
+	EndMs    int
+	Note     *string
+
+	Quality    *int
+	Agreement  *int
+	Importance *int
+	Confidence *int
 }
 
+// Limits on perspective segments
+const (
+	MaxPerspectiveSegments = 100
+	MaxSegmentNoteLength   = 2000
+)
+
 // RatingMin is the minimum valid rating value
 const RatingMin = 0
 
@@ -77,8 +115,16 @@ func ValidateRating(rating *int) bool {
 type PerspectiveSortBy string
 
 const (
-	PerspectiveSortByCreatedAt PerspectiveSortBy = "CREATED_AT"
-	PerspectiveSortByUpdatedAt PerspectiveSortBy = "UPDATED_AT"
+	PerspectiveSortByCreatedAt  PerspectiveSortBy = "CREATED_AT"
+	PerspectiveSortByUpdatedAt  PerspectiveSortBy = "UPDATED_AT"
+	PerspectiveSortByRelevance  PerspectiveSortBy = "RELEVANCE" // Search rank; requires Filter.Search
+	PerspectiveSortByQuality    PerspectiveSortBy = "QUALITY"
+	PerspectiveSortByAgreement  PerspectiveSortBy = "AGREEMENT"
+	PerspectiveSortByImportance PerspectiveSortBy = "IMPORTANCE"
+	PerspectiveSortByConfidence PerspectiveSortBy = "CONFIDENCE"
+	// Rating for PerspectiveListParams.SortCategory
+	PerspectiveSortByCategorizedRating PerspectiveSortBy = "CATEGORIZED_RATING"
+	PerspectiveSortByContentName       PerspectiveSortBy = "CONTENT_NAME"
 )
 
 // PerspectiveFilter contains filter criteria for perspective queries
@@ -86,6 +132,29 @@ type PerspectiveFilter struct {
 	UserID    *int
 	ContentID *int
 	Privacy   *Privacy
+	Search    *string // Full-text search over description, like, category and labels; see ParseSearchQuery
+
+	Quality    *IntRange
+	Agreement  *IntRange
+	Importance *IntRange
+	Confidence *IntRange
+
+	LabelsAny []string // Has at least one of these labels
+	LabelsAll []string // Has every one of these labels
+
+	Category          *string // Case-insensitive exact match
+	ReviewStatus      *ReviewStatus
+	CategorizedRating *CategorizedRatingFilter
+
+	CreatedAt *TimeRange
+	UpdatedAt *TimeRange
+}
+
+// CategorizedRatingFilter matches perspectives holding a categorized rating
+// for Category (exact match) of at least MinRating
+type CategorizedRatingFilter struct {
+	Category  string
+	MinRating int
 }
 
 // PerspectiveListParams contains parameters for paginated perspective queries
@@ -96,7 +165,9 @@ type PerspectiveListParams struct {
 	Before            *string
 	SortBy            PerspectiveSortBy
 	SortOrder         SortOrder
+	SortCategory      string // Categorized rating category; only for PerspectiveSortByCategorizedRating
 	IncludeTotalCount bool
+	CountStrategy     CountStrategy // Empty means CountStrategyExact
 	Filter            *PerspectiveFilter
 }
 
@@ -108,6 +179,8 @@ type PaginatedPerspectives struct {
 	StartCursor *string
 	EndCursor   *string
 	TotalCount  *int
+	// TotalCountExact is false when TotalCount is an estimate or cached
+	TotalCountExact bool
 }
 
 // MarshalCategorizedRatings converts CategorizedRatings to JSON for storage
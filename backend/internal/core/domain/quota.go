@@ -0,0 +1,41 @@
+package domain
+
+import "time"
+
+// CircuitState is the state of the YouTube API circuit breaker
+type CircuitState string
+
+const (
+	// CircuitClosed lets requests through
+	CircuitClosed CircuitState = "CLOSED"
+	// CircuitOpen fails requests fast with ErrQuotaExceeded
+	CircuitOpen CircuitState = "OPEN"
+	// CircuitHalfOpen lets a single probe request through after the cooldown
+	CircuitHalfOpen CircuitState = "HALF_OPEN"
+)
+
+// QuotaMethodUsage is the quota spent on one API method, e.g. "videos.list"
+type QuotaMethodUsage struct {
+	Method string
+	Units  int
+}
+
+// YouTubeQuotaUsage reports quota spent in the current quota day, which
+// YouTube resets at midnight Pacific time
+type YouTubeQuotaUsage struct {
+	Day              time.Time // Start of the quota day
+	ResetsAt         time.Time
+	Budget           int // Zero means no budget is enforced
+	Used             int
+	ByMethod         []QuotaMethodUsage
+	Breaker          CircuitState
+	BreakerOpenUntil *time.Time
+}
+
+// Remaining returns the units left in the budget, or -1 when no budget is enforced
+func (u *YouTubeQuotaUsage) Remaining() int {
+	if u.Budget <= 0 {
+		return -1
+	}
+	return max(u.Budget-u.Used, 0)
+}
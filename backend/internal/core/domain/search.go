@@ -0,0 +1,134 @@
+package domain
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+)
+
+// MaxSearchTerms bounds how many terms a search query may contain
+const MaxSearchTerms = 32
+
+// SearchTerm is one part of a search query: a single word, or a phrase whose
+// words must appear in order
+type SearchTerm struct {
+	Words   []string // Lowercased letters and digits only
+	Prefix  bool     // The last word matches any word it begins
+	Exclude bool     // Matching documents must not contain the term
+}
+
+// SearchQuery is a parsed full-text search. Every term must match.
+type SearchQuery struct {
+	Terms []SearchTerm
+}
+
+// ParseSearchQuery parses user search syntax:
+//
+//	climate tax        both words
+//	"carbon tax"       the phrase
+//	clim*              words starting with clim
+//	-hoax              without hoax
+//
+// Punctuation inside a term splits it into words, so "state-of-the-art"
+// searches for the phrase "state of the art". A query with nothing to
+// search for is ErrInvalidInput.
+func ParseSearchQuery(s string) (SearchQuery, error) {
+	var q SearchQuery
+	rest := strings.TrimSpace(s)
+	for rest != "" {
+		var term SearchTerm
+		if rest[0] == '-' {
+			term.Exclude = true
+			rest = rest[1:]
+		}
+
+		var raw string
+		if strings.HasPrefix(rest, `"`) {
+			end := strings.Index(rest[1:], `"`)
+			if end < 0 {
+				raw, rest = rest[1:], "" // An unclosed quote runs to the end
+			} else {
+				raw, rest = rest[1:end+1], rest[end+2:]
+			}
+		} else {
+			end := strings.IndexFunc(rest, unicode.IsSpace)
+			if end < 0 {
+				end = len(rest)
+			}
+			raw, rest = rest[:end], rest[end:]
+		}
+		rest = strings.TrimSpace(rest)
+
+		term.Prefix = strings.HasSuffix(raw, "*")
+		term.Words = searchWords(raw)
+		if len(term.Words) == 0 {
+			continue
+		}
+		q.Terms = append(q.Terms, term)
+	}
+
+	if len(q.Terms) == 0 {
+		return SearchQuery{}, fmt.Errorf("%w: search must contain at least one word", ErrInvalidInput)
+	}
+	if len(q.Terms) > MaxSearchTerms {
+		return SearchQuery{}, fmt.Errorf("%w: search may contain at most %d terms", ErrInvalidInput, MaxSearchTerms)
+	}
+	return q, nil
+}
+
+// searchWords splits s into lowercased runs of letters and digits
+func searchWords(s string) []string {
+	return strings.FieldsFunc(strings.ToLower(s), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+}
+
+// SearchMode selects how a search string is matched
+type SearchMode string
+
+const (
+	// SearchModeFullText matches words and phrases, with stemming
+	SearchModeFullText SearchMode = "FULL_TEXT"
+	// SearchModeFuzzy matches by trigram similarity, tolerating typos
+	SearchModeFuzzy SearchMode = "FUZZY"
+)
+
+// IsValid returns true if the mode is a known SearchMode value
+func (m SearchMode) IsValid() bool {
+	return m == SearchModeFullText || m == SearchModeFuzzy
+}
+
+// DefaultSimilarityThreshold is the minimum trigram similarity, from 0 to 1,
+// for a fuzzy match; it matches pg_trgm's own default
+const DefaultSimilarityThreshold = 0.3
+
+// MaxFuzzyQueryLength bounds fuzzy search strings, in characters
+const MaxFuzzyQueryLength = 200
+
+// ValidateFuzzyQuery trims a fuzzy search string and checks it is usable
+func ValidateFuzzyQuery(s string) (string, error) {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return "", fmt.Errorf("%w: search is required", ErrInvalidInput)
+	}
+	if len([]rune(s)) > MaxFuzzyQueryLength {
+		return "", fmt.Errorf("%w: search must be at most %d characters", ErrInvalidInput, MaxFuzzyQueryLength)
+	}
+	return s, nil
+}
+
+// SearchSuggestionKind says what a "did you mean" suggestion names
+type SearchSuggestionKind string
+
+const (
+	SearchSuggestionContent SearchSuggestionKind = "CONTENT"
+	SearchSuggestionChannel SearchSuggestionKind = "CHANNEL"
+	SearchSuggestionUser    SearchSuggestionKind = "USER"
+)
+
+// SearchSuggestion is a stored name similar to what the user typed
+type SearchSuggestion struct {
+	Text       string
+	Kind       SearchSuggestionKind
+	Similarity float64
+}
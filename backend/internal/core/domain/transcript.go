@@ -0,0 +1,70 @@
+package domain
+
+import "time"
+
+// TranscriptSource records where a transcript came from
+type TranscriptSource string
+
+const (
+	// TranscriptSourceYouTube is a caption track fetched from YouTube
+	TranscriptSourceYouTube TranscriptSource = "YOUTUBE"
+	// TranscriptSourceUpload is a caption file uploaded by a user
+	TranscriptSourceUpload TranscriptSource = "UPLOAD"
+)
+
+// CaptionFormat is a timed caption file format
+type CaptionFormat string
+
+const (
+	CaptionFormatWebVTT CaptionFormat = "WEBVTT"
+	CaptionFormatSRT    CaptionFormat = "SRT"
+)
+
+// IsValid returns true if the format is a known CaptionFormat value
+func (f CaptionFormat) IsValid() bool {
+	return f == CaptionFormatWebVTT || f == CaptionFormatSRT
+}
+
+// Transcript is the timed text of a content item. Each content item has at
+// most one transcript; storing a new one replaces it.
+type Transcript struct {
+	ContentID    int
+	Language     string // BCP 47 tag, e.g. "en" or "pt-BR"
+	Source       TranscriptSource
+	Segments     []*TranscriptSegment
+	SegmentCount int
+	CreatedAt    time.Time
+	UpdatedAt    time.Time
+}
+
+// TranscriptSegment is one caption cue. Times are milliseconds from the start
+// of the video.
+type TranscriptSegment struct {
+	ContentID int
+	Position  int // Zero-based order within the transcript
+	StartMs   int
+	EndMs     int
+	Text      string
+}
+
+// TranscriptRangeParams selects the segments of one transcript that overlap
+// a time window
+type TranscriptRangeParams struct {
+	ContentID int
+	FromMs    *int // Inclusive; nil means from the start
+	ToMs      *int // Exclusive; nil means to the end
+}
+
+// TranscriptSearchParams is a full-text search over transcript text
+type TranscriptSearchParams struct {
+	Query     string // Web search syntax: words, "quoted phrases", -excluded, OR
+	ContentID *int   // Limits the search to one content item
+	Limit     int
+}
+
+// TranscriptMatch is a transcript segment matching a search
+type TranscriptMatch struct {
+	Segment  *TranscriptSegment
+	Headline string // Segment text with matches wrapped in <b></b>
+	Rank     float64
+}
@@ -0,0 +1,17 @@
+package domain
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// YouTubeVideoCacheEntry is a cached YouTube videos.list item. Parts are
+// fetched and expire independently, so Item holds the latest copy of each
+// part and FetchedAt records when each was last fetched or revalidated.
+type YouTubeVideoCacheEntry struct {
+	VideoID   string
+	Item      json.RawMessage      // Untrimmed API item merged across part fetches
+	FetchedAt map[string]time.Time // Part name (e.g. "statistics") -> last fetch
+	ETag      string               // ETag of the last response if it was for this video alone, sent as If-None-Match
+	ETagParts string               // Comma-separated parts of the last fetch, which ETag and Item's etag cover
+}
@@ -2,15 +2,33 @@ package repositories
 
 import (
 	"context"
+	"time"
 
 	"github.com/CodeWarrior-debug/perspectize/backend/internal/core/domain"
 )
 
 // ContentRepository defines the contract for content persistence
 type ContentRepository interface {
+	// Create returns domain.ErrAlreadyExists when the URL, canonical key or
+	// name is taken
 	Create(ctx context.Context, content *domain.Content) (*domain.Content, error)
 	GetByID(ctx context.Context, id int) (*domain.Content, error)
 	GetByURL(ctx context.Context, url string) (*domain.Content, error)
+	GetByCanonicalKey(ctx context.Context, key string) (*domain.Content, error)
+	AddAlias(ctx context.Context, contentID int, url string) error
 	List(ctx context.Context, params domain.ContentListParams) (*domain.PaginatedContent, error)
+	ListPage(ctx context.Context, params domain.ContentPageParams) (*domain.ContentPage, error)
+	Facets(ctx context.Context, params domain.ContentFacetParams) ([]*domain.ContentFacetCounts, error)
+	// Update returns domain.ErrAlreadyExists when the new name is taken
+	Update(ctx context.Context, content *domain.Content) (*domain.Content, error)
+	// ListRefreshDue returns available YouTube content neither updated nor
+	// unsuccessfully refreshed since before, least recently tried first
+	ListRefreshDue(ctx context.Context, before time.Time, limit int) ([]*domain.Content, error)
+	MarkRefreshFailed(ctx context.Context, ids []int, at time.Time) error
+	MarkUnavailable(ctx context.Context, ids []int, at time.Time) error
+	Delete(ctx context.Context, id int, policy domain.ContentDeletePolicy) error
+	Merge(ctx context.Context, sourceID, targetID int) (*domain.ContentMergeResult, error)
 	ReassignByUser(ctx context.Context, fromUserID, toUserID int) error
+	RecordStatsSnapshot(ctx context.Context, snapshot *domain.ContentStatsSnapshot) error
+	ListStatsHistory(ctx context.Context, params domain.ContentStatsHistoryParams) ([]*domain.ContentStatsBucket, error)
 }
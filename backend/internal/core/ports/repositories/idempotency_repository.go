@@ -0,0 +1,22 @@
+package repositories
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/CodeWarrior-debug/perspectize/backend/internal/core/domain"
+)
+
+// IdempotencyRepository defines the contract for idempotency key persistence
+type IdempotencyRepository interface {
+	// Reserve claims key for a new request. Records created before
+	// expiresBefore, and pending records created before pendingBefore, are
+	// discarded first. If the key is already held, the existing record is
+	// returned and reserved is false.
+	Reserve(ctx context.Context, key, requestHash string, expiresBefore, pendingBefore time.Time) (existing *domain.IdempotencyRecord, reserved bool, err error)
+	// Complete stores the response for a reserved key
+	Complete(ctx context.Context, key string, response json.RawMessage) error
+	// Release frees a reserved key so the request can be retried
+	Release(ctx context.Context, key string) error
+}
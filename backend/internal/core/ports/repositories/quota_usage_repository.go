@@ -0,0 +1,15 @@
+package repositories
+
+import (
+	"context"
+	"time"
+)
+
+// QuotaUsageRepository defines the contract for persisting YouTube API quota
+// spend. day is a calendar date; only its year, month and day are used.
+type QuotaUsageRepository interface {
+	// AddUsage adds units to the running total for method on day
+	AddUsage(ctx context.Context, day time.Time, method string, units int) error
+	// GetUsage returns the units spent per method on day
+	GetUsage(ctx context.Context, day time.Time) (map[string]int, error)
+}
@@ -0,0 +1,13 @@
+package repositories
+
+import (
+	"context"
+
+	"github.com/CodeWarrior-debug/perspectize/backend/internal/core/domain"
+)
+
+// SearchRepository defines the contract for cross-entity search lookups
+type SearchRepository interface {
+	// Suggest returns stored names similar to query, most similar first
+	Suggest(ctx context.Context, query string, limit int) ([]*domain.SearchSuggestion, error)
+}
@@ -0,0 +1,21 @@
+package repositories
+
+import (
+	"context"
+
+	"github.com/CodeWarrior-debug/perspectize/backend/internal/core/domain"
+)
+
+// TranscriptRepository defines the contract for transcript persistence
+type TranscriptRepository interface {
+	// Replace stores transcript and its segments, replacing any transcript
+	// the content already has
+	Replace(ctx context.Context, transcript *domain.Transcript) (*domain.Transcript, error)
+
+	// ListSegments returns segments overlapping the requested window in order
+	ListSegments(ctx context.Context, params domain.TranscriptRangeParams) ([]*domain.TranscriptSegment, error)
+
+	// Search returns segments of live content matching a full-text query,
+	// best matches first
+	Search(ctx context.Context, params domain.TranscriptSearchParams) ([]*domain.TranscriptMatch, error)
+}
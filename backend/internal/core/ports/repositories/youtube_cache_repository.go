@@ -0,0 +1,18 @@
+package repositories
+
+import (
+	"context"
+
+	"github.com/CodeWarrior-debug/perspectize/backend/internal/core/domain"
+)
+
+// YouTubeCacheRepository defines the contract for a persistent cache of
+// YouTube API responses shared across restarts and instances
+type YouTubeCacheRepository interface {
+	// GetVideos returns cached entries keyed by video ID; uncached IDs are absent
+	GetVideos(ctx context.Context, videoIDs []string) (map[string]*domain.YouTubeVideoCacheEntry, error)
+	// PutVideo inserts or replaces the entry for entry.VideoID
+	PutVideo(ctx context.Context, entry *domain.YouTubeVideoCacheEntry) error
+	// DeleteVideo removes the entry for videoID, if any
+	DeleteVideo(ctx context.Context, videoID string) error
+}
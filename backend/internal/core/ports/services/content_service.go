@@ -6,14 +6,57 @@ import (
 	"github.com/CodeWarrior-debug/perspectize/backend/internal/core/domain"
 )
 
+// UpdateContentInput contains the data needed to update content
+type UpdateContentInput struct {
+	ID   int
+	Name *string
+	URL  *string
+}
+
 // ContentService defines the contract for content business logic
 type ContentService interface {
 	// CreateFromYouTube creates content from a YouTube URL
 	CreateFromYouTube(ctx context.Context, url string) (*domain.Content, error)
 
+	// CreateOrGetFromYouTube creates content from a YouTube URL, applying
+	// ifExists when it is already stored; created reports whether a row was inserted
+	CreateOrGetFromYouTube(ctx context.Context, url string, ifExists domain.IfExistsPolicy) (content *domain.Content, created bool, err error)
+
+	// ImportFromURLs creates content for each YouTube URL, returning one
+	// result per input URL in input order
+	ImportFromURLs(ctx context.Context, urls []string) ([]*domain.ContentImportResult, error)
+
+	// ImportYouTubeCollection imports up to limit videos from a YouTube
+	// playlist or channel URL
+	ImportYouTubeCollection(ctx context.Context, url string, limit int) (*domain.CollectionImportSummary, error)
+
 	// GetByID retrieves content by ID
 	GetByID(ctx context.Context, id int) (*domain.Content, error)
 
 	// ListContent retrieves a paginated list of content
 	ListContent(ctx context.Context, params domain.ContentListParams) (*domain.PaginatedContent, error)
+
+	// ContentPage retrieves one page of content by page number
+	ContentPage(ctx context.Context, params domain.ContentPageParams) (*domain.ContentPage, error)
+
+	// ContentFacets counts content matching a filter by each requested facet
+	ContentFacets(ctx context.Context, params domain.ContentFacetParams) ([]*domain.ContentFacetCounts, error)
+
+	// Update updates an existing content item's name and/or URL
+	Update(ctx context.Context, input UpdateContentInput) (*domain.Content, error)
+
+	// RefreshContent re-fetches provider metadata (title, length, statistics)
+	// for existing content
+	RefreshContent(ctx context.Context, id int) (*domain.Content, error)
+
+	// StatsHistory returns bucketed view/like/comment counts for content
+	StatsHistory(ctx context.Context, params domain.ContentStatsHistoryParams) ([]*domain.ContentStatsBucket, error)
+
+	// Delete soft-deletes content, applying the given policy to any
+	// perspectives that reference it
+	Delete(ctx context.Context, id int, policy domain.ContentDeletePolicy) error
+
+	// MergeContent moves everything referencing source onto target and
+	// soft-deletes source, returning target
+	MergeContent(ctx context.Context, sourceID, targetID int) (*domain.ContentMergeResult, error)
 }
@@ -19,6 +19,7 @@ type CreatePerspectiveInput struct {
 	Description        *string
 	Category           *string
 	Parts              []int
+	Segments           []domain.PerspectiveSegment
 	Labels             []string
 	CategorizedRatings []domain.CategorizedRating
 }
@@ -37,6 +38,7 @@ type UpdatePerspectiveInput struct {
 	Category           *string
 	ReviewStatus       *domain.ReviewStatus
 	Parts              []int
+	Segments           []domain.PerspectiveSegment
 	Labels             []string
 	CategorizedRatings []domain.CategorizedRating
 }
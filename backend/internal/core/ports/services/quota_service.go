@@ -0,0 +1,14 @@
+package services
+
+import (
+	"context"
+
+	"github.com/CodeWarrior-debug/perspectize/backend/internal/core/domain"
+)
+
+// QuotaService defines the contract for reporting YouTube API quota usage
+type QuotaService interface {
+	// Usage returns the quota spent so far in the current quota day and the
+	// circuit breaker state
+	Usage(ctx context.Context) (*domain.YouTubeQuotaUsage, error)
+}
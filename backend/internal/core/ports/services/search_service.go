@@ -0,0 +1,13 @@
+package services
+
+import (
+	"context"
+
+	"github.com/CodeWarrior-debug/perspectize/backend/internal/core/domain"
+)
+
+// SearchService defines the contract for search helpers that span entities
+type SearchService interface {
+	// Suggest returns "did you mean" corrections for a search string
+	Suggest(ctx context.Context, query string, limit int) ([]*domain.SearchSuggestion, error)
+}
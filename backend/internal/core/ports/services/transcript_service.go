@@ -0,0 +1,45 @@
+package services
+
+import (
+	"context"
+
+	"github.com/CodeWarrior-debug/perspectize/backend/internal/core/domain"
+)
+
+// UploadTranscriptInput contains a caption file to store as a transcript
+type UploadTranscriptInput struct {
+	ContentID int
+	Language  string
+	Format    domain.CaptionFormat
+	Data      string
+}
+
+// Captions is a caption track downloaded from a provider
+type Captions struct {
+	Language string
+	Format   domain.CaptionFormat
+	Data     string
+}
+
+// CaptionProvider defines the contract for downloading caption tracks
+type CaptionProvider interface {
+	// FetchCaptions downloads the caption track for videoID in language,
+	// returning domain.ErrNotFound when the video has none
+	FetchCaptions(ctx context.Context, videoID, language string) (*Captions, error)
+}
+
+// TranscriptService defines the contract for transcript business logic
+type TranscriptService interface {
+	// FetchFromYouTube downloads the video's captions and stores them as the
+	// content's transcript
+	FetchFromYouTube(ctx context.Context, contentID int, language string) (*domain.Transcript, error)
+
+	// Upload parses a WebVTT or SRT file and stores it as the content's transcript
+	Upload(ctx context.Context, input UploadTranscriptInput) (*domain.Transcript, error)
+
+	// Segments returns the transcript segments overlapping a time window
+	Segments(ctx context.Context, params domain.TranscriptRangeParams) ([]*domain.TranscriptSegment, error)
+
+	// Search finds transcript segments matching a full-text query
+	Search(ctx context.Context, params domain.TranscriptSearchParams) ([]*domain.TranscriptMatch, error)
+}
@@ -27,6 +27,9 @@ type UserService interface {
 	// ListAll retrieves all users
 	ListAll(ctx context.Context) ([]*domain.User, error)
 
+	// Search finds active users whose username is similar to query
+	Search(ctx context.Context, query string, limit int) ([]*domain.User, error)
+
 	// Update updates an existing user's username and/or email
 	Update(ctx context.Context, input UpdateUserInput) (*domain.User, error)
 
@@ -3,15 +3,27 @@ package services
 import (
 	"context"
 	"encoding/json"
+	"time"
 )
 
 // VideoMetadata contains extracted information from YouTube API response
 type VideoMetadata struct {
+	VideoID     string
 	Title       string
 	Description string
 	Duration    int // Duration in seconds
 	ChannelName string
-	Response    json.RawMessage // Raw API response for storage
+	PublishedAt *time.Time
+	Tags        []string
+	// Statistics are nil when YouTube hides them (e.g. likes or comments disabled)
+	ViewCount    *int64
+	LikeCount    *int64
+	CommentCount *int64
+	Response     json.RawMessage // API response for storage; trimmed or nil depending on client configuration
+	// Unchanged is set when YouTube confirmed, with an HTTP 304 or a matching
+	// item etag, that the video has not changed since it was cached, so a
+	// stored Response need not be replaced
+	Unchanged bool
 }
 
 // YouTubeClient defines the contract for YouTube API interactions
@@ -19,6 +31,30 @@ type YouTubeClient interface {
 	// GetVideoMetadata fetches video metadata from YouTube API
 	GetVideoMetadata(ctx context.Context, videoID string) (*VideoMetadata, error)
 
+	// GetVideosMetadata fetches metadata for many videos in as few API
+	// requests as possible, keyed by video ID. Missing videos are absent from
+	// the map; a non-nil error may accompany partial results.
+	GetVideosMetadata(ctx context.Context, videoIDs []string) (map[string]*VideoMetadata, error)
+
+	// ListCollectionVideoIDs returns up to limit video IDs from a playlist or
+	// channel URL in playlist order; channels resolve to their uploads
+	ListCollectionVideoIDs(ctx context.Context, collectionURL string, limit int) ([]string, error)
+
 	// ExtractVideoID extracts the video ID from a YouTube URL
 	ExtractVideoID(url string) (string, error)
 }
+
+// bypassCacheKey marks a context made by BypassCache
+type bypassCacheKey struct{}
+
+// BypassCache returns a context whose YouTube metadata calls fetch from the
+// API even when a cached copy is fresh, e.g. for a refresh a user asked for
+func BypassCache(ctx context.Context) context.Context {
+	return context.WithValue(ctx, bypassCacheKey{}, true)
+}
+
+// CacheBypassed reports whether ctx came from BypassCache
+func CacheBypassed(ctx context.Context) bool {
+	bypass, _ := ctx.Value(bypassCacheKey{}).(bool)
+	return bypass
+}
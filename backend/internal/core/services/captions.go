@@ -0,0 +1,220 @@
+package services
+
+import (
+	"fmt"
+	"html"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/CodeWarrior-debug/perspectize/backend/internal/core/domain"
+)
+
+// maxTranscriptSegments bounds how many cues one transcript may have
+const maxTranscriptSegments = 20000
+
+var (
+	// cueTagPattern matches WebVTT/SRT markup: <i>, </b>, <c.color>, <v Speaker>,
+	// inline timestamps like <00:00:01.500>, and SSA overrides like {\an8}
+	cueTagPattern = regexp.MustCompile(`<[^>]*>|\{\\[^}]*\}`)
+	spacePattern  = regexp.MustCompile(`\s+`)
+)
+
+// ParseCaptions parses a WebVTT or SRT caption file into transcript segments
+// ordered by start time. Markup is stripped and empty cues are dropped.
+func ParseCaptions(format domain.CaptionFormat, data string) ([]*domain.TranscriptSegment, error) {
+	data = strings.TrimPrefix(data, "\uFEFF")
+	data = strings.ReplaceAll(data, "\r\n", "\n")
+	data = strings.ReplaceAll(data, "\r", "\n")
+
+	var segments []*domain.TranscriptSegment
+	var err error
+	switch format {
+	case domain.CaptionFormatWebVTT:
+		segments, err = parseWebVTT(data)
+	case domain.CaptionFormatSRT:
+		segments, err = parseSRT(data)
+	default:
+		return nil, fmt.Errorf("%w: unknown caption format %q", domain.ErrInvalidInput, format)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if len(segments) > maxTranscriptSegments {
+		return nil, fmt.Errorf("%w: transcript has %d cues, at most %d allowed", domain.ErrInvalidInput, len(segments), maxTranscriptSegments)
+	}
+
+	sort.SliceStable(segments, func(i, j int) bool { return segments[i].StartMs < segments[j].StartMs })
+	for i, s := range segments {
+		s.Position = i
+	}
+	return segments, nil
+}
+
+// parseWebVTT reads cues from a WebVTT file, skipping NOTE, STYLE and REGION blocks
+func parseWebVTT(data string) ([]*domain.TranscriptSegment, error) {
+	blocks := splitBlocks(data)
+	if len(blocks) == 0 || !strings.HasPrefix(blocks[0].lines[0], "WEBVTT") {
+		return nil, fmt.Errorf("%w: WebVTT file must start with WEBVTT", domain.ErrInvalidInput)
+	}
+
+	var cues []*domain.TranscriptSegment
+	for _, b := range blocks[1:] {
+		first := b.lines[0]
+		if first == "NOTE" || strings.HasPrefix(first, "NOTE ") || first == "STYLE" || first == "REGION" {
+			continue
+		}
+		// An optional cue identifier precedes the timing line
+		timing := 0
+		if !strings.Contains(first, "-->") {
+			timing = 1
+		}
+		if timing >= len(b.lines) || !strings.Contains(b.lines[timing], "-->") {
+			return nil, fmt.Errorf("%w: line %d: expected cue timing", domain.ErrInvalidInput, b.line+timing)
+		}
+		cue, err := parseCue(b.lines[timing], b.lines[timing+1:], b.line+timing)
+		if err != nil {
+			return nil, err
+		}
+		cues = append(cues, cue)
+	}
+	return dedupeRollingCues(cues), nil
+}
+
+// parseSRT reads numbered cues from a SubRip file
+func parseSRT(data string) ([]*domain.TranscriptSegment, error) {
+	var cues []*domain.TranscriptSegment
+	for _, b := range splitBlocks(data) {
+		timing := 0
+		if !strings.Contains(b.lines[0], "-->") {
+			if _, err := strconv.Atoi(strings.TrimSpace(b.lines[0])); err != nil {
+				return nil, fmt.Errorf("%w: line %d: expected cue number", domain.ErrInvalidInput, b.line)
+			}
+			timing = 1
+		}
+		if timing >= len(b.lines) || !strings.Contains(b.lines[timing], "-->") {
+			return nil, fmt.Errorf("%w: line %d: expected cue timing", domain.ErrInvalidInput, b.line+timing)
+		}
+		cue, err := parseCue(b.lines[timing], b.lines[timing+1:], b.line+timing)
+		if err != nil {
+			return nil, err
+		}
+		cues = append(cues, cue)
+	}
+	return dropEmpty(cues), nil
+}
+
+// block is a run of non-blank lines; line is the 1-based number of its first line
+type block struct {
+	line  int
+	lines []string
+}
+
+func splitBlocks(data string) []block {
+	var blocks []block
+	var current *block
+	for i, line := range strings.Split(data, "\n") {
+		if strings.TrimSpace(line) == "" {
+			current = nil
+			continue
+		}
+		if current == nil {
+			blocks = append(blocks, block{line: i + 1})
+			current = &blocks[len(blocks)-1]
+		}
+		current.lines = append(current.lines, line)
+	}
+	return blocks
+}
+
+// parseCue parses a "start --> end [settings]" line and the cue's text lines
+func parseCue(timing string, text []string, line int) (*domain.TranscriptSegment, error) {
+	startText, rest, _ := strings.Cut(timing, "-->")
+	endText := strings.Fields(rest)
+	if len(endText) == 0 {
+		return nil, fmt.Errorf("%w: line %d: missing cue end time", domain.ErrInvalidInput, line)
+	}
+	start, err := parseCueTime(strings.TrimSpace(startText))
+	if err != nil {
+		return nil, fmt.Errorf("%w: line %d: %v", domain.ErrInvalidInput, line, err)
+	}
+	end, err := parseCueTime(endText[0])
+	if err != nil {
+		return nil, fmt.Errorf("%w: line %d: %v", domain.ErrInvalidInput, line, err)
+	}
+	if end < start {
+		return nil, fmt.Errorf("%w: line %d: cue ends before it starts", domain.ErrInvalidInput, line)
+	}
+
+	lines := make([]string, 0, len(text))
+	for _, l := range text {
+		if l = cleanCueText(l); l != "" {
+			lines = append(lines, l)
+		}
+	}
+	return &domain.TranscriptSegment{StartMs: start, EndMs: end, Text: strings.Join(lines, "\n")}, nil
+}
+
+// parseCueTime parses [hh:]mm:ss.mmm (WebVTT) or hh:mm:ss,mmm (SRT) to milliseconds
+func parseCueTime(s string) (int, error) {
+	clock, frac, ok := strings.Cut(strings.Replace(s, ",", ".", 1), ".")
+	if !ok || len(frac) != 3 {
+		return 0, fmt.Errorf("invalid timestamp %q", s)
+	}
+	fields := strings.Split(clock, ":")
+	if len(fields) < 2 || len(fields) > 3 {
+		return 0, fmt.Errorf("invalid timestamp %q", s)
+	}
+
+	ms, err := strconv.Atoi(frac)
+	if err != nil {
+		return 0, fmt.Errorf("invalid timestamp %q", s)
+	}
+	total := 0
+	for i, f := range fields {
+		n, err := strconv.Atoi(f)
+		if err != nil || n < 0 || (i > 0 && (len(f) != 2 || n > 59)) {
+			return 0, fmt.Errorf("invalid timestamp %q", s)
+		}
+		total = total*60 + n
+	}
+	return total*1000 + ms, nil
+}
+
+// cleanCueText strips markup, decodes entities and collapses whitespace
+func cleanCueText(s string) string {
+	s = cueTagPattern.ReplaceAllString(s, "")
+	s = html.UnescapeString(s)
+	return strings.TrimSpace(spacePattern.ReplaceAllString(s, " "))
+}
+
+// dedupeRollingCues undoes YouTube's rolling auto-captions, where each cue
+// repeats the previous cue's last line before adding a new one. Repeated
+// lines are dropped, then empty cues.
+func dedupeRollingCues(cues []*domain.TranscriptSegment) []*domain.TranscriptSegment {
+	var previous string
+	for _, cue := range cues {
+		lines := strings.Split(cue.Text, "\n")
+		for len(lines) > 0 && previous != "" && lines[0] == previous {
+			lines = lines[1:]
+		}
+		if len(lines) > 0 {
+			previous = lines[len(lines)-1]
+		}
+		cue.Text = strings.Join(lines, "\n")
+	}
+	return dropEmpty(cues)
+}
+
+// dropEmpty removes cues without text and joins multi-line cues with spaces
+func dropEmpty(cues []*domain.TranscriptSegment) []*domain.TranscriptSegment {
+	out := cues[:0]
+	for _, cue := range cues {
+		cue.Text = strings.Join(strings.Fields(cue.Text), " ")
+		if cue.Text != "" {
+			out = append(out, cue)
+		}
+	}
+	return out
+}
@@ -0,0 +1,290 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+	"sync"
+
+	"github.com/CodeWarrior-debug/perspectize/backend/internal/core/domain"
+	portservices "github.com/CodeWarrior-debug/perspectize/backend/internal/core/ports/services"
+)
+
+const (
+	// MaxImportURLs caps how many URLs a single import may contain
+	MaxImportURLs = 200
+
+	// DefaultCollectionImportLimit is used when no playlist/channel limit is given
+	DefaultCollectionImportLimit = 200
+
+	// MaxCollectionImportLimit caps how many videos one playlist/channel import may create
+	MaxCollectionImportLimit = 1000
+
+	// importConcurrency bounds in-flight lookups and provider requests per import
+	importConcurrency = 8
+)
+
+// ImportFromURLs creates YouTube content for each URL. Existence checks and
+// video ID extraction run concurrently, metadata is fetched in batches of up
+// to 50 videos, and content is then created in input order so repeated
+// videos within one import resolve to EXISTS. One result is returned per
+// input URL; per-URL failures never fail the whole import.
+func (s *ContentService) ImportFromURLs(ctx context.Context, urls []string) ([]*domain.ContentImportResult, error) {
+	if len(urls) == 0 {
+		return nil, fmt.Errorf("%w: at least one URL is required", domain.ErrInvalidInput)
+	}
+	if len(urls) > MaxImportURLs {
+		return nil, fmt.Errorf("%w: at most %d URLs can be imported at once", domain.ErrInvalidInput, MaxImportURLs)
+	}
+
+	results := make([]*domain.ContentImportResult, len(urls))
+	videoIDs := make([]string, len(urls))
+
+	// Phase 1: skip existing content and extract video IDs
+	forEachBounded(len(urls), importConcurrency, func(i int) {
+		url := strings.TrimSpace(urls[i])
+		videoID, err := s.prepareImport(ctx, url)
+		if err != nil {
+			results[i] = importResultFromError(url, err)
+			return
+		}
+		videoIDs[i] = videoID
+	})
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	// Phase 2: fetch metadata for the remaining videos in batched requests
+	metadata, fetchErrs := s.fetchImportMetadata(ctx, results, videoIDs)
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	// Phase 3: create content sequentially
+	created := make(map[string]*domain.Content)
+	for i := range urls {
+		if results[i] != nil {
+			continue
+		}
+		url := strings.TrimSpace(urls[i])
+		videoID := videoIDs[i]
+
+		if content, ok := created[videoID]; ok {
+			if url != *content.URL {
+				addAlias(ctx, s.repo, content.ID, url)
+			}
+			results[i] = &domain.ContentImportResult{URL: url, Status: domain.ContentImportStatusExists, Content: content}
+			continue
+		}
+
+		m, ok := metadata[videoID]
+		if !ok {
+			err := fetchErrs[videoID]
+			if err == nil {
+				err = fmt.Errorf("%w: video not found: %s", domain.ErrNotFound, videoID)
+			}
+			results[i] = importResultFromError(url, err)
+			continue
+		}
+
+		key := domain.CanonicalKey(domain.ContentTypeYouTube, videoID)
+		content := &domain.Content{
+			URL:          &url,
+			CanonicalKey: &key,
+			ContentType:  domain.ContentTypeYouTube,
+		}
+		applyVideoMetadata(content, m)
+
+		saved, wasCreated, err := s.createYouTube(ctx, content)
+		if err != nil {
+			results[i] = importResultFromError(url, err)
+			continue
+		}
+		if !wasCreated {
+			// Another writer stored the video after phase 1 checked
+			created[videoID] = saved
+			results[i] = &domain.ContentImportResult{URL: url, Status: domain.ContentImportStatusExists, Content: saved}
+			continue
+		}
+		recordStatsSnapshot(ctx, s.repo, saved.ID, m)
+
+		created[videoID] = saved
+		results[i] = &domain.ContentImportResult{URL: url, Status: domain.ContentImportStatusCreated, Content: saved}
+	}
+
+	return results, nil
+}
+
+// ImportYouTubeCollection imports up to limit videos from a YouTube playlist
+// or channel URL through the same batched path as ImportFromURLs. A limit of
+// zero uses DefaultCollectionImportLimit.
+func (s *ContentService) ImportYouTubeCollection(ctx context.Context, url string, limit int) (*domain.CollectionImportSummary, error) {
+	if limit == 0 {
+		limit = DefaultCollectionImportLimit
+	}
+	if limit < 1 || limit > MaxCollectionImportLimit {
+		return nil, fmt.Errorf("%w: limit must be between 1 and %d", domain.ErrInvalidInput, MaxCollectionImportLimit)
+	}
+
+	videoIDs, err := s.youtubeClient.ListCollectionVideoIDs(ctx, strings.TrimSpace(url), limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list playlist videos: %w", err)
+	}
+
+	summary := &domain.CollectionImportSummary{Total: len(videoIDs)}
+	for start := 0; start < len(videoIDs); start += MaxImportURLs {
+		chunk := videoIDs[start:min(start+MaxImportURLs, len(videoIDs))]
+		urls := make([]string, len(chunk))
+		for i, videoID := range chunk {
+			urls[i] = "https://www.youtube.com/watch?v=" + videoID
+		}
+
+		results, err := s.ImportFromURLs(ctx, urls)
+		if err != nil {
+			return nil, err
+		}
+		for _, r := range results {
+			switch r.Status {
+			case domain.ContentImportStatusCreated:
+				summary.Created++
+			case domain.ContentImportStatusExists:
+				summary.Skipped++
+			default:
+				summary.Failed++
+			}
+		}
+	}
+
+	return summary, nil
+}
+
+// prepareImport returns the video ID for a URL that is not yet stored under
+// that URL or another form of it. Existing content is reported as
+// ErrAlreadyExists carrying the stored content.
+func (s *ContentService) prepareImport(ctx context.Context, url string) (string, error) {
+	if url == "" {
+		return "", fmt.Errorf("%w: empty URL", domain.ErrInvalidURL)
+	}
+
+	existing, videoID, err := s.findExistingYouTube(ctx, url)
+	if err != nil {
+		return "", err
+	}
+	if existing != nil {
+		return "", &existingContentError{content: existing}
+	}
+	return videoID, nil
+}
+
+// fetchImportMetadata fetches metadata for every video ID that still needs
+// importing, one batch of up to 50 IDs per request with bounded concurrency.
+// IDs missing from a failed batch map to that batch's error.
+func (s *ContentService) fetchImportMetadata(ctx context.Context, results []*domain.ContentImportResult, videoIDs []string) (map[string]*portservices.VideoMetadata, map[string]error) {
+	var pending []string
+	seen := make(map[string]bool)
+	for i, videoID := range videoIDs {
+		if results[i] != nil || seen[videoID] {
+			continue
+		}
+		seen[videoID] = true
+		pending = append(pending, videoID)
+	}
+
+	var chunks [][]string
+	for start := 0; start < len(pending); start += videosPerRequest {
+		chunks = append(chunks, pending[start:min(start+videosPerRequest, len(pending))])
+	}
+
+	var mu sync.Mutex
+	metadata := make(map[string]*portservices.VideoMetadata, len(pending))
+	fetchErrs := make(map[string]error)
+	forEachBounded(len(chunks), importConcurrency, func(c int) {
+		videos, err := s.youtubeClient.GetVideosMetadata(ctx, chunks[c])
+
+		mu.Lock()
+		defer mu.Unlock()
+		for id, m := range videos {
+			metadata[id] = m
+		}
+		if err != nil {
+			// Any failure here is the provider's, including transport errors
+			err = fmt.Errorf("%w: %w", domain.ErrYouTubeAPI, err)
+			for _, id := range chunks[c] {
+				if _, ok := videos[id]; !ok {
+					fetchErrs[id] = err
+				}
+			}
+		}
+	})
+
+	return metadata, fetchErrs
+}
+
+// existingContentError reports that a URL is already stored. It matches
+// domain.ErrAlreadyExists with errors.Is.
+type existingContentError struct {
+	content *domain.Content
+}
+
+func (e *existingContentError) Error() string {
+	return fmt.Sprintf("%s: content %d", domain.ErrAlreadyExists, e.content.ID)
+}
+
+func (e *existingContentError) Unwrap() error {
+	return domain.ErrAlreadyExists
+}
+
+// importResultFromError maps a per-URL failure to an import result using the
+// domain sentinel errors. Provider and storage details are logged rather than
+// returned, since transport errors can include request URLs.
+func importResultFromError(url string, err error) *domain.ContentImportResult {
+	result := &domain.ContentImportResult{URL: url}
+
+	var existing *existingContentError
+	switch {
+	case errors.As(err, &existing):
+		result.Status = domain.ContentImportStatusExists
+		result.Content = existing.content
+	case errors.Is(err, domain.ErrInvalidURL):
+		result.Status = domain.ContentImportStatusInvalidURL
+		result.Error = "invalid YouTube URL"
+	case errors.Is(err, domain.ErrNotFound):
+		result.Status = domain.ContentImportStatusProviderError
+		result.Error = "video not found"
+	case errors.Is(err, domain.ErrQuotaExceeded):
+		result.Status = domain.ContentImportStatusProviderError
+		result.Error = "YouTube quota exhausted"
+	case errors.Is(err, domain.ErrAlreadyExists):
+		// Different content holds the same unique value, e.g. the title
+		result.Status = domain.ContentImportStatusFailed
+		result.Error = "conflicts with other stored content"
+	case errors.Is(err, domain.ErrYouTubeAPI):
+		slog.Warn("import provider error", "url", url, "error", err)
+		result.Status = domain.ContentImportStatusProviderError
+		result.Error = "failed to fetch video metadata from YouTube"
+	default:
+		slog.Error("import failed", "url", url, "error", err)
+		result.Status = domain.ContentImportStatusFailed
+		result.Error = "failed to import content"
+	}
+	return result
+}
+
+// forEachBounded calls fn for each index in [0, n) with at most limit calls
+// running at once, and returns when all calls have finished
+func forEachBounded(n, limit int, fn func(i int)) {
+	var wg sync.WaitGroup
+	sem := make(chan struct{}, limit)
+	for i := 0; i < n; i++ {
+		sem <- struct{}{}
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			defer func() { <-sem }()
+			fn(i)
+		}(i)
+	}
+	wg.Wait()
+}
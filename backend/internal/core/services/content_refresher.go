@@ -0,0 +1,211 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/CodeWarrior-debug/perspectize/backend/internal/core/domain"
+	"github.com/CodeWarrior-debug/perspectize/backend/internal/core/ports/repositories"
+	portservices "github.com/CodeWarrior-debug/perspectize/backend/internal/core/ports/services"
+)
+
+// RefresherConfig holds settings for the background content refresher
+type RefresherConfig struct {
+	Interval          time.Duration // How often to look for stale content
+	MaxAge            time.Duration // Content not updated within this window is refreshed
+	BatchSize         int           // Max items refreshed per pass
+	RequestsPerSecond int           // Rate limit for YouTube API calls
+}
+
+// DefaultRefresherConfig returns sensible default refresher settings
+func DefaultRefresherConfig() RefresherConfig {
+	return RefresherConfig{
+		Interval:          time.Hour,
+		MaxAge:            24 * time.Hour,
+		BatchSize:         50,
+		RequestsPerSecond: 2,
+	}
+}
+
+// ContentRefresher periodically re-fetches YouTube metadata for content whose
+// stored statistics are older than the configured max age
+type ContentRefresher struct {
+	repo          repositories.ContentRepository
+	youtubeClient portservices.YouTubeClient
+	cfg           RefresherConfig
+}
+
+// NewContentRefresher creates a new content refresher. Zero-valued config
+// fields fall back to DefaultRefresherConfig.
+func NewContentRefresher(repo repositories.ContentRepository, yt portservices.YouTubeClient, cfg RefresherConfig) *ContentRefresher {
+	defaults := DefaultRefresherConfig()
+	if cfg.Interval <= 0 {
+		cfg.Interval = defaults.Interval
+	}
+	if cfg.MaxAge <= 0 {
+		cfg.MaxAge = defaults.MaxAge
+	}
+	if cfg.BatchSize <= 0 {
+		cfg.BatchSize = defaults.BatchSize
+	}
+	if cfg.RequestsPerSecond <= 0 {
+		cfg.RequestsPerSecond = defaults.RequestsPerSecond
+	}
+	return &ContentRefresher{
+		repo:          repo,
+		youtubeClient: yt,
+		cfg:           cfg,
+	}
+}
+
+// Run refreshes stale content every Interval until ctx is canceled.
+// A pass runs immediately on start.
+func (r *ContentRefresher) Run(ctx context.Context) {
+	ticker := time.NewTicker(r.cfg.Interval)
+	defer ticker.Stop()
+
+	for {
+		refreshed, err := r.RefreshStale(ctx)
+		if err != nil && ctx.Err() == nil {
+			slog.Error("content refresh pass failed", "error", err)
+		} else if refreshed > 0 {
+			slog.Info("content refresh pass complete", "refreshed", refreshed)
+		}
+
+		select {
+		case <-ctx.Done():
+			slog.Info("content refresher stopped")
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// videosPerRequest matches the YouTube videos endpoint's per-request ID limit
+const videosPerRequest = 50
+
+// RefreshStale refreshes up to BatchSize content items neither updated nor
+// tried within MaxAge, least recently tried first. Metadata is fetched in
+// batches of up to 50 videos per API request. It returns the number of items
+// refreshed. Failures on individual items are logged and recorded, so they
+// wait another MaxAge instead of heading every pass; videos YouTube no
+// longer returns are marked unavailable and not refreshed again.
+func (r *ContentRefresher) RefreshStale(ctx context.Context) (int, error) {
+	now := time.Now()
+	stale, err := r.repo.ListRefreshDue(ctx, now.Add(-r.cfg.MaxAge), r.cfg.BatchSize)
+	if err != nil {
+		return 0, fmt.Errorf("failed to list stale content: %w", err)
+	}
+
+	// Group content by video ID; distinct URLs can point at the same video
+	var videoIDs []string
+	var failed []int
+	byVideoID := make(map[string][]*domain.Content)
+	for _, content := range stale {
+		videoID, err := youTubeVideoID(r.youtubeClient, content)
+		if err != nil {
+			slog.Warn("skipping content refresh", "contentID", content.ID, "error", err)
+			failed = append(failed, content.ID)
+			continue
+		}
+		if _, seen := byVideoID[videoID]; !seen {
+			videoIDs = append(videoIDs, videoID)
+		}
+		byVideoID[videoID] = append(byVideoID[videoID], content)
+	}
+
+	limiter := time.NewTicker(time.Second / time.Duration(r.cfg.RequestsPerSecond))
+	defer limiter.Stop()
+
+	refreshed := 0
+	for start := 0; start < len(videoIDs); start += videosPerRequest {
+		// First request goes out immediately; later ones wait for the limiter
+		if start > 0 {
+			select {
+			case <-ctx.Done():
+				return refreshed, ctx.Err()
+			case <-limiter.C:
+			}
+		}
+
+		chunk := videoIDs[start:min(start+videosPerRequest, len(videoIDs))]
+		videos, err := r.youtubeClient.GetVideosMetadata(ctx, chunk)
+		if err != nil {
+			if ctx.Err() != nil {
+				r.markFailed(ctx, failed, now)
+				return refreshed, ctx.Err()
+			}
+			if errors.Is(err, domain.ErrQuotaExceeded) && len(videos) == 0 {
+				// Later chunks would fail fast too; resume on the next tick.
+				// Nothing was fetched, so nothing here counts as a failed attempt.
+				slog.Warn("YouTube quota exhausted, ending refresh pass early", "error", err)
+				r.markFailed(ctx, failed, now)
+				return refreshed, nil
+			}
+			// Partial results may still be present
+			slog.Warn("failed to fetch YouTube metadata", "videos", len(chunk), "error", err)
+		}
+
+		var unavailable []int
+		for _, videoID := range chunk {
+			metadata, ok := videos[videoID]
+			if !ok {
+				for _, content := range byVideoID[videoID] {
+					if err == nil {
+						// A successful response without the video means it
+						// was deleted, made private, or never existed
+						unavailable = append(unavailable, content.ID)
+					} else {
+						failed = append(failed, content.ID)
+					}
+				}
+				if err == nil {
+					slog.Warn("video not returned by YouTube, marking unavailable", "videoID", videoID)
+				}
+				continue
+			}
+			for _, content := range byVideoID[videoID] {
+				if err := r.save(ctx, content, metadata); err != nil {
+					slog.Warn("failed to refresh content", "contentID", content.ID, "error", err)
+					failed = append(failed, content.ID)
+					continue
+				}
+				refreshed++
+			}
+		}
+		if len(unavailable) > 0 {
+			if err := r.repo.MarkUnavailable(context.WithoutCancel(ctx), unavailable, now); err != nil {
+				slog.Warn("failed to mark content unavailable", "contentIDs", unavailable, "error", err)
+			}
+		}
+	}
+
+	r.markFailed(ctx, failed, now)
+	return refreshed, nil
+}
+
+// markFailed records failed refresh attempts so the content waits another
+// MaxAge before it is tried again. Errors are logged: the worst case is the
+// content being retried on the next pass.
+func (r *ContentRefresher) markFailed(ctx context.Context, ids []int, at time.Time) {
+	if len(ids) == 0 {
+		return
+	}
+	if err := r.repo.MarkRefreshFailed(context.WithoutCancel(ctx), ids, at); err != nil {
+		slog.Warn("failed to record content refresh failures", "contentIDs", ids, "error", err)
+	}
+}
+
+// save applies fetched metadata to content and records a stats snapshot
+func (r *ContentRefresher) save(ctx context.Context, content *domain.Content, metadata *portservices.VideoMetadata) error {
+	applyVideoMetadata(content, metadata)
+
+	if _, err := r.repo.Update(ctx, content); err != nil {
+		return fmt.Errorf("failed to save content: %w", err)
+	}
+	recordStatsSnapshot(ctx, r.repo, content.ID, metadata)
+	return nil
+}
@@ -4,6 +4,9 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"log/slog"
+	"strings"
+	"time"
 
 	"github.com/CodeWarrior-debug/perspectize/backend/internal/core/domain"
 	"github.com/CodeWarrior-debug/perspectize/backend/internal/core/ports/repositories"
@@ -24,47 +27,126 @@ func NewContentService(repo repositories.ContentRepository, yt portservices.YouT
 	}
 }
 
-// CreateFromYouTube creates content from a YouTube URL
+// CreateFromYouTube creates content from a YouTube URL, failing with
+// ErrAlreadyExists if the URL or another form of it is already stored
 func (s *ContentService) CreateFromYouTube(ctx context.Context, url string) (*domain.Content, error) {
-	// Check if content already exists for this URL
-	existing, err := s.repo.GetByURL(ctx, url)
-	if err == nil && existing != nil {
-		return nil, fmt.Errorf("%w: content with URL %s already exists", domain.ErrAlreadyExists, url)
+	content, _, err := s.CreateOrGetFromYouTube(ctx, url, domain.IfExistsError)
+	return content, err
+}
+
+// CreateOrGetFromYouTube creates content from a YouTube URL, applying
+// ifExists when the URL or another form of it is already stored. created
+// reports whether a new row was inserted.
+func (s *ContentService) CreateOrGetFromYouTube(ctx context.Context, url string, ifExists domain.IfExistsPolicy) (content *domain.Content, created bool, err error) {
+	if ifExists == "" {
+		ifExists = domain.IfExistsError
 	}
-	if err != nil && !errors.Is(err, domain.ErrNotFound) {
-		return nil, fmt.Errorf("failed to check existing content: %w", err)
+	if !ifExists.IsValid() {
+		return nil, false, fmt.Errorf("%w: unknown ifExists policy %q", domain.ErrInvalidInput, ifExists)
 	}
 
-	// Extract video ID from URL
-	videoID, err := s.youtubeClient.ExtractVideoID(url)
+	existing, videoID, err := s.findExistingYouTube(ctx, url)
 	if err != nil {
-		return nil, fmt.Errorf("%w: %v", domain.ErrInvalidURL, err)
+		return nil, false, err
+	}
+	if existing != nil {
+		return s.applyIfExists(ctx, existing, url, ifExists)
 	}
 
 	// Fetch metadata from YouTube API
 	metadata, err := s.youtubeClient.GetVideoMetadata(ctx, videoID)
 	if err != nil {
-		return nil, fmt.Errorf("failed to fetch YouTube metadata: %w", err)
+		return nil, false, fmt.Errorf("failed to fetch YouTube metadata: %w", err)
 	}
 
 	// Create domain content
-	lengthUnits := "seconds"
-	content := &domain.Content{
-		Name:        metadata.Title,
-		URL:         &url,
-		ContentType: domain.ContentTypeYouTube,
-		Length:      &metadata.Duration,
-		LengthUnits: &lengthUnits,
-		Response:    metadata.Response,
+	key := domain.CanonicalKey(domain.ContentTypeYouTube, videoID)
+	content = &domain.Content{
+		URL:          &url,
+		CanonicalKey: &key,
+		ContentType:  domain.ContentTypeYouTube,
 	}
+	applyVideoMetadata(content, metadata)
 
 	// Save to repository
-	created, err := s.repo.Create(ctx, content)
+	saved, created, err := s.createYouTube(ctx, content)
 	if err != nil {
-		return nil, fmt.Errorf("failed to save content: %w", err)
+		return nil, false, err
+	}
+	if !created {
+		return s.applyIfExists(ctx, saved, url, ifExists)
+	}
+	recordStatsSnapshot(ctx, s.repo, saved.ID, metadata)
+
+	return saved, true, nil
+}
+
+// applyIfExists answers a create request for url whose video is already
+// stored as existing, according to ifExists
+func (s *ContentService) applyIfExists(ctx context.Context, existing *domain.Content, url string, ifExists domain.IfExistsPolicy) (*domain.Content, bool, error) {
+	switch ifExists {
+	case domain.IfExistsReturnExisting:
+		return existing, false, nil
+	case domain.IfExistsRefresh:
+		refreshed, err := s.RefreshContent(ctx, existing.ID)
+		if err != nil {
+			return nil, false, err
+		}
+		return refreshed, false, nil
+	default:
+		return nil, false, fmt.Errorf("%w: content %d already exists for URL %s", domain.ErrAlreadyExists, existing.ID, url)
+	}
+}
+
+// createYouTube saves new YouTube content. A concurrent request may store
+// the same video between the existence check and the insert; the unique
+// indexes then reject the insert, and the row that won is returned with
+// created false. A clash with different content, such as another video with
+// the same title, stays an error.
+func (s *ContentService) createYouTube(ctx context.Context, content *domain.Content) (saved *domain.Content, created bool, err error) {
+	saved, err = s.repo.Create(ctx, content)
+	if err == nil {
+		return saved, true, nil
+	}
+	if !errors.Is(err, domain.ErrAlreadyExists) {
+		return nil, false, fmt.Errorf("failed to save content: %w", err)
+	}
+
+	existing, _, findErr := s.findExistingYouTube(ctx, *content.URL)
+	if findErr != nil || existing == nil {
+		return nil, false, err
+	}
+	return existing, false, nil
+}
+
+// findExistingYouTube looks up stored content for a YouTube URL, first by the
+// URL itself (or an alias) and then by the video's canonical key. A match by
+// canonical key records url as an alias. When nothing is stored it returns
+// the extracted video ID.
+func (s *ContentService) findExistingYouTube(ctx context.Context, url string) (*domain.Content, string, error) {
+	existing, err := s.repo.GetByURL(ctx, url)
+	if err == nil && existing != nil {
+		return existing, "", nil
+	}
+	if err != nil && !errors.Is(err, domain.ErrNotFound) {
+		return nil, "", fmt.Errorf("failed to check existing content: %w", err)
+	}
+
+	videoID, err := s.youtubeClient.ExtractVideoID(url)
+	if err != nil {
+		return nil, "", fmt.Errorf("%w: %v", domain.ErrInvalidURL, err)
 	}
 
-	return created, nil
+	// Another URL form of the same video may already be stored
+	existing, err = s.repo.GetByCanonicalKey(ctx, domain.CanonicalKey(domain.ContentTypeYouTube, videoID))
+	if err == nil && existing != nil {
+		addAlias(ctx, s.repo, existing.ID, url)
+		return existing, "", nil
+	}
+	if err != nil && !errors.Is(err, domain.ErrNotFound) {
+		return nil, "", fmt.Errorf("failed to check existing content: %w", err)
+	}
+	return nil, videoID, nil
 }
 
 // GetByID retrieves content by ID
@@ -93,6 +175,26 @@ func (s *ContentService) ListContent(ctx context.Context, params domain.ContentL
 		}
 	}
 
+	if err := validateCountStrategy(params.CountStrategy); err != nil {
+		return nil, err
+	}
+
+	if params.Filter != nil {
+		filter, err := normalizeContentFilter(*params.Filter)
+		if err != nil {
+			return nil, err
+		}
+		params.Filter = &filter
+	}
+
+	hasSearch, err := validateContentSearch(params.Filter)
+	if err != nil {
+		return nil, err
+	}
+	if params.SortBy == domain.ContentSortByRelevance && !hasSearch {
+		return nil, fmt.Errorf("%w: RELEVANCE sort requires a search", domain.ErrInvalidInput)
+	}
+
 	result, err := s.repo.List(ctx, params)
 	if err != nil {
 		return nil, fmt.Errorf("failed to list content: %w", err)
@@ -100,3 +202,382 @@ func (s *ContentService) ListContent(ctx context.Context, params domain.ContentL
 
 	return result, nil
 }
+
+// ContentPage retrieves one page of content by page number
+func (s *ContentService) ContentPage(ctx context.Context, params domain.ContentPageParams) (*domain.ContentPage, error) {
+	if params.Page < 1 {
+		return nil, fmt.Errorf("%w: page must be at least 1", domain.ErrInvalidInput)
+	}
+	if params.PageSize == 0 {
+		params.PageSize = domain.DefaultPageSize
+	}
+	if params.PageSize < 1 || params.PageSize > domain.MaxPageSize {
+		return nil, fmt.Errorf("%w: pageSize must be between 1 and %d", domain.ErrInvalidInput, domain.MaxPageSize)
+	}
+	if (params.Page-1)*params.PageSize >= domain.MaxPageOffset {
+		return nil, fmt.Errorf("%w: pages start at most %d items in; page further with a cursor", domain.ErrInvalidInput, domain.MaxPageOffset)
+	}
+	if err := validateCountStrategy(params.CountStrategy); err != nil {
+		return nil, err
+	}
+
+	if params.Filter != nil {
+		filter, err := normalizeContentFilter(*params.Filter)
+		if err != nil {
+			return nil, err
+		}
+		params.Filter = &filter
+	}
+	hasSearch, err := validateContentSearch(params.Filter)
+	if err != nil {
+		return nil, err
+	}
+	if params.SortBy == domain.ContentSortByRelevance && !hasSearch {
+		return nil, fmt.Errorf("%w: RELEVANCE sort requires a search", domain.ErrInvalidInput)
+	}
+
+	page, err := s.repo.ListPage(ctx, params)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list content page: %w", err)
+	}
+	return page, nil
+}
+
+// ContentFacets counts content matching params.Filter by each requested
+// facet, in request order
+func (s *ContentService) ContentFacets(ctx context.Context, params domain.ContentFacetParams) ([]*domain.ContentFacetCounts, error) {
+	if len(params.Facets) == 0 {
+		return nil, fmt.Errorf("%w: at least one facet is required", domain.ErrInvalidInput)
+	}
+	seen := make(map[domain.ContentFacet]bool, len(params.Facets))
+	facets := make([]domain.ContentFacet, 0, len(params.Facets))
+	for _, facet := range params.Facets {
+		if !facet.IsValid() {
+			return nil, fmt.Errorf("%w: unknown facet %q", domain.ErrInvalidInput, facet)
+		}
+		if !seen[facet] {
+			seen[facet] = true
+			facets = append(facets, facet)
+		}
+	}
+	params.Facets = facets
+
+	if params.Filter != nil {
+		filter, err := normalizeContentFilter(*params.Filter)
+		if err != nil {
+			return nil, err
+		}
+		params.Filter = &filter
+	}
+	if _, err := validateContentSearch(params.Filter); err != nil {
+		return nil, err
+	}
+
+	counts, err := s.repo.Facets(ctx, params)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count content facets: %w", err)
+	}
+	return counts, nil
+}
+
+// validateContentSearch checks f's search against its mode and reports
+// whether f searches at all
+func validateContentSearch(f *domain.ContentFilter) (bool, error) {
+	if f == nil || f.Search == nil || strings.TrimSpace(*f.Search) == "" {
+		return false, nil
+	}
+	switch f.SearchMode {
+	case domain.SearchModeFuzzy:
+		if _, err := domain.ValidateFuzzyQuery(*f.Search); err != nil {
+			return true, err
+		}
+	case "", domain.SearchModeFullText:
+		if _, err := domain.ParseSearchQuery(*f.Search); err != nil {
+			return true, err
+		}
+	default:
+		return true, fmt.Errorf("%w: unknown search mode %q", domain.ErrInvalidInput, f.SearchMode)
+	}
+	return true, nil
+}
+
+// validateCountStrategy checks a listing's count strategy; empty is exact
+func validateCountStrategy(strategy domain.CountStrategy) error {
+	if strategy != "" && !strategy.IsValid() {
+		return fmt.Errorf("%w: unknown count strategy %q", domain.ErrInvalidInput, strategy)
+	}
+	return nil
+}
+
+// normalizeContentFilter validates a list filter and returns a copy with
+// channel titles and tags trimmed
+func normalizeContentFilter(f domain.ContentFilter) (domain.ContentFilter, error) {
+	if err := f.PublishedAt.Validate("publishedAt"); err != nil {
+		return f, err
+	}
+	if err := f.CreatedAt.Validate("createdAt"); err != nil {
+		return f, err
+	}
+
+	var err error
+	if f.ChannelTitles, err = domain.ValidateFilterValues("channelTitles", f.ChannelTitles); err != nil {
+		return f, err
+	}
+	if f.TagsAny, err = domain.ValidateFilterValues("tagsAny", f.TagsAny); err != nil {
+		return f, err
+	}
+	if f.TagsAll, err = domain.ValidateFilterValues("tagsAll", f.TagsAll); err != nil {
+		return f, err
+	}
+
+	if f.AddedByUserID != nil && *f.AddedByUserID <= 0 {
+		return f, fmt.Errorf("%w: addedByUserID must be a positive integer", domain.ErrInvalidInput)
+	}
+	if f.WithoutPerspectivesFromUserID != nil && *f.WithoutPerspectivesFromUserID <= 0 {
+		return f, fmt.Errorf("%w: withoutPerspectivesFromUserID must be a positive integer", domain.ErrInvalidInput)
+	}
+	if f.MinViewCount != nil && *f.MinViewCount < 0 {
+		return f, fmt.Errorf("%w: minViewCount must not be negative", domain.ErrInvalidInput)
+	}
+	if f.MinPerspectives != nil && *f.MinPerspectives < 0 {
+		return f, fmt.Errorf("%w: minPerspectives must not be negative", domain.ErrInvalidInput)
+	}
+	return f, nil
+}
+
+// RefreshContent re-fetches YouTube metadata for existing content and updates
+// its name, length and stored response
+func (s *ContentService) RefreshContent(ctx context.Context, id int) (*domain.Content, error) {
+	if id <= 0 {
+		return nil, fmt.Errorf("%w: content id must be a positive integer", domain.ErrInvalidInput)
+	}
+
+	content, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get content: %w", err)
+	}
+
+	videoID, err := youTubeVideoID(s.youtubeClient, content)
+	if err != nil {
+		return nil, err
+	}
+
+	// An explicit refresh wants YouTube's current data, not a cached copy
+	metadata, err := s.youtubeClient.GetVideoMetadata(portservices.BypassCache(ctx), videoID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch YouTube metadata: %w", err)
+	}
+
+	applyVideoMetadata(content, metadata)
+
+	updated, err := s.repo.Update(ctx, content)
+	if err != nil {
+		return nil, fmt.Errorf("failed to save content: %w", err)
+	}
+	recordStatsSnapshot(ctx, s.repo, updated.ID, metadata)
+
+	return updated, nil
+}
+
+// youTubeVideoID returns the YouTube video ID for stored content
+func youTubeVideoID(yt portservices.YouTubeClient, content *domain.Content) (string, error) {
+	if content.ContentType != domain.ContentTypeYouTube {
+		return "", fmt.Errorf("%w: content %d is not YouTube content", domain.ErrInvalidInput, content.ID)
+	}
+	if content.URL == nil {
+		return "", fmt.Errorf("%w: content %d has no URL", domain.ErrInvalidInput, content.ID)
+	}
+	videoID, err := yt.ExtractVideoID(*content.URL)
+	if err != nil {
+		return "", fmt.Errorf("%w: %v", domain.ErrInvalidURL, err)
+	}
+	return videoID, nil
+}
+
+// applyVideoMetadata copies freshly fetched YouTube metadata onto content
+func applyVideoMetadata(content *domain.Content, metadata *portservices.VideoMetadata) {
+	lengthUnits := "seconds"
+	duration := metadata.Duration
+	content.Name = metadata.Title
+	content.Length = &duration
+	content.LengthUnits = &lengthUnits
+	content.ChannelTitle = optionalString(metadata.ChannelName)
+	content.Description = optionalString(metadata.Description)
+	content.PublishedAt = metadata.PublishedAt
+	content.Tags = metadata.Tags
+	content.ViewCount = metadata.ViewCount
+	content.LikeCount = metadata.LikeCount
+	content.CommentCount = metadata.CommentCount
+	if !metadata.Unchanged || content.Response == nil {
+		content.Response = metadata.Response
+	}
+	content.UnavailableAt = nil // YouTube returned it, so it is available again
+}
+
+// optionalString returns nil for an empty string so blank provider fields
+// are stored as NULL
+func optionalString(s string) *string {
+	if s == "" {
+		return nil
+	}
+	return &s
+}
+
+// addAlias records url as another address of content so later lookups by
+// that URL find it. Aliases are best-effort: a failed insert is logged, not returned.
+func addAlias(ctx context.Context, repo repositories.ContentRepository, contentID int, url string) {
+	if err := repo.AddAlias(ctx, contentID, url); err != nil {
+		slog.Warn("failed to record content alias", "contentID", contentID, "url", url, "error", err)
+	}
+}
+
+// recordStatsSnapshot stores the statistics from a metadata fetch as a history
+// point. History is best-effort: a failed insert is logged, not returned.
+func recordStatsSnapshot(ctx context.Context, repo repositories.ContentRepository, contentID int, metadata *portservices.VideoMetadata) {
+	snapshot := &domain.ContentStatsSnapshot{
+		ContentID:    contentID,
+		CapturedAt:   time.Now().UTC(),
+		ViewCount:    metadata.ViewCount,
+		LikeCount:    metadata.LikeCount,
+		CommentCount: metadata.CommentCount,
+	}
+	if err := repo.RecordStatsSnapshot(ctx, snapshot); err != nil {
+		slog.Warn("failed to record content stats snapshot", "contentID", contentID, "error", err)
+	}
+}
+
+// StatsHistory returns view/like/comment counts for content bucketed by day or week
+func (s *ContentService) StatsHistory(ctx context.Context, params domain.ContentStatsHistoryParams) ([]*domain.ContentStatsBucket, error) {
+	if params.ContentID <= 0 {
+		return nil, fmt.Errorf("%w: content id must be a positive integer", domain.ErrInvalidInput)
+	}
+	if params.Granularity == "" {
+		params.Granularity = domain.StatsGranularityDay
+	}
+	if !params.Granularity.IsValid() {
+		return nil, fmt.Errorf("%w: unknown granularity %q", domain.ErrInvalidInput, params.Granularity)
+	}
+	if params.From != nil && params.To != nil && !params.From.Before(*params.To) {
+		return nil, fmt.Errorf("%w: from must be before to", domain.ErrInvalidInput)
+	}
+
+	buckets, err := s.repo.ListStatsHistory(ctx, params)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get stats history: %w", err)
+	}
+	return buckets, nil
+}
+
+// Update updates an existing content item's name and/or URL
+func (s *ContentService) Update(ctx context.Context, input portservices.UpdateContentInput) (*domain.Content, error) {
+	if input.ID <= 0 {
+		return nil, fmt.Errorf("%w: content id must be a positive integer", domain.ErrInvalidInput)
+	}
+
+	content, err := s.repo.GetByID(ctx, input.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get content: %w", err)
+	}
+
+	// Apply name change if provided
+	if input.Name != nil {
+		name := strings.TrimSpace(*input.Name)
+		if name == "" {
+			return nil, fmt.Errorf("%w: name is required", domain.ErrInvalidInput)
+		}
+		content.Name = name
+	}
+
+	// Apply URL change if provided
+	if input.URL != nil {
+		url := strings.TrimSpace(*input.URL)
+		if url == "" {
+			return nil, fmt.Errorf("%w: url is required", domain.ErrInvalidInput)
+		}
+		if content.ContentType == domain.ContentTypeYouTube {
+			videoID, err := s.youtubeClient.ExtractVideoID(url)
+			if err != nil {
+				return nil, fmt.Errorf("%w: %v", domain.ErrInvalidURL, err)
+			}
+			key := domain.CanonicalKey(domain.ContentTypeYouTube, videoID)
+			existing, err := s.repo.GetByCanonicalKey(ctx, key)
+			if err == nil && existing != nil && existing.ID != content.ID {
+				return nil, fmt.Errorf("%w: content %d already exists for YouTube video %s", domain.ErrAlreadyExists, existing.ID, videoID)
+			}
+			if err != nil && !errors.Is(err, domain.ErrNotFound) {
+				return nil, fmt.Errorf("failed to check existing content: %w", err)
+			}
+			content.CanonicalKey = &key
+		}
+		// Check uniqueness (only if actually changing); the URL may already be
+		// an alias of this content
+		if content.URL == nil || url != *content.URL {
+			existing, err := s.repo.GetByURL(ctx, url)
+			if err == nil && existing != nil && existing.ID != content.ID {
+				return nil, fmt.Errorf("%w: content with URL %s already exists", domain.ErrAlreadyExists, url)
+			}
+			if err != nil && !errors.Is(err, domain.ErrNotFound) {
+				return nil, fmt.Errorf("failed to check existing content: %w", err)
+			}
+		}
+		content.URL = &url
+	}
+
+	// The name is unique among live content; the repository reports a
+	// clash as ErrAlreadyExists
+	updated, err := s.repo.Update(ctx, content)
+	if errors.Is(err, domain.ErrAlreadyExists) {
+		return nil, err
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to update content: %w", err)
+	}
+
+	return updated, nil
+}
+
+// Delete soft-deletes content. With ContentDeletePolicyRestrict the delete is
+// blocked while perspectives reference the content; with
+// ContentDeletePolicyDetach those perspectives have their content_id nulled.
+func (s *ContentService) Delete(ctx context.Context, id int, policy domain.ContentDeletePolicy) error {
+	if id <= 0 {
+		return fmt.Errorf("%w: content id must be a positive integer", domain.ErrInvalidInput)
+	}
+	if policy == "" {
+		policy = domain.ContentDeletePolicyRestrict
+	}
+	if !policy.IsValid() {
+		return fmt.Errorf("%w: unknown delete policy %q", domain.ErrInvalidInput, policy)
+	}
+
+	// Verify content exists
+	if _, err := s.repo.GetByID(ctx, id); err != nil {
+		return fmt.Errorf("failed to get content: %w", err)
+	}
+
+	if err := s.repo.Delete(ctx, id, policy); err != nil {
+		return fmt.Errorf("failed to delete content: %w", err)
+	}
+
+	return nil
+}
+
+// MergeContent merges duplicate content: perspectives, stats history and
+// aliases move from source to target, the source URL is kept as an alias of
+// target, and source is soft-deleted. The merge is audited.
+func (s *ContentService) MergeContent(ctx context.Context, sourceID, targetID int) (*domain.ContentMergeResult, error) {
+	if sourceID <= 0 || targetID <= 0 {
+		return nil, fmt.Errorf("%w: content ids must be positive integers", domain.ErrInvalidInput)
+	}
+	if sourceID == targetID {
+		return nil, fmt.Errorf("%w: cannot merge content into itself", domain.ErrInvalidInput)
+	}
+
+	result, err := s.repo.Merge(ctx, sourceID, targetID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to merge content: %w", err)
+	}
+
+	slog.Info("content merged", "sourceID", sourceID, "targetID", targetID, "perspectivesMoved", result.PerspectivesMoved)
+	return result, nil
+}
@@ -4,6 +4,9 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"sort"
+	"strings"
+	"unicode/utf8"
 
 	"github.com/CodeWarrior-debug/perspectize/backend/internal/core/domain"
 	"github.com/CodeWarrior-debug/perspectize/backend/internal/core/ports/repositories"
@@ -12,15 +15,17 @@ import (
 
 // PerspectiveService implements business logic for perspective operations
 type PerspectiveService struct {
-	repo     repositories.PerspectiveRepository
-	userRepo repositories.UserRepository
+	repo        repositories.PerspectiveRepository
+	userRepo    repositories.UserRepository
+	contentRepo repositories.ContentRepository
 }
 
 // NewPerspectiveService creates a new perspective service
-func NewPerspectiveService(repo repositories.PerspectiveRepository, userRepo repositories.UserRepository) *PerspectiveService {
+func NewPerspectiveService(repo repositories.PerspectiveRepository, userRepo repositories.UserRepository, contentRepo repositories.ContentRepository) *PerspectiveService {
 	return &PerspectiveService{
-		repo:     repo,
-		userRepo: userRepo,
+		repo:        repo,
+		userRepo:    userRepo,
+		contentRepo: contentRepo,
 	}
 }
 
@@ -60,6 +65,11 @@ func (s *PerspectiveService) Create(ctx context.Context, input portservices.Crea
 		}
 	}
 
+	segments, err := s.validateSegments(ctx, input.Segments, input.ContentID)
+	if err != nil {
+		return nil, err
+	}
+
 	// Set default privacy
 	privacy := domain.PrivacyPublic
 	if input.Privacy != nil {
@@ -78,6 +88,7 @@ func (s *PerspectiveService) Create(ctx context.Context, input portservices.Crea
 		Description:        input.Description,
 		Category:           input.Category,
 		Parts:              input.Parts,
+		Segments:           segments,
 		Labels:             input.Labels,
 		CategorizedRatings: input.CategorizedRatings,
 	}
@@ -178,6 +189,19 @@ func (s *PerspectiveService) Update(ctx context.Context, input portservices.Upda
 		existing.Labels = input.Labels
 	}
 
+	// Re-check stored segments when the content changes, since the new
+	// content may be shorter
+	if input.Segments != nil || (input.ContentID != nil && len(existing.Segments) > 0) {
+		segments := existing.Segments
+		if input.Segments != nil {
+			segments = input.Segments
+		}
+		existing.Segments, err = s.validateSegments(ctx, segments, existing.ContentID)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	updated, err := s.repo.Update(ctx, existing)
 	if err != nil {
 		return nil, fmt.Errorf("failed to update perspective: %w", err)
@@ -217,6 +241,41 @@ func (s *PerspectiveService) ListPerspectives(ctx context.Context, params domain
 			return nil, fmt.Errorf("%w: last must be between 1 and 100", domain.ErrInvalidInput)
 		}
 	}
+	if err := validateCountStrategy(params.CountStrategy); err != nil {
+		return nil, err
+	}
+
+	if params.Filter != nil {
+		filter, err := normalizePerspectiveFilter(*params.Filter)
+		if err != nil {
+			return nil, err
+		}
+		params.Filter = &filter
+	}
+
+	hasSearch := params.Filter != nil && params.Filter.Search != nil && strings.TrimSpace(*params.Filter.Search) != ""
+	if hasSearch {
+		if _, err := domain.ParseSearchQuery(*params.Filter.Search); err != nil {
+			return nil, err
+		}
+		// Matches and highlights would reveal private text, so a search only
+		// ever covers public perspectives
+		if params.Filter.Privacy != nil && *params.Filter.Privacy != domain.PrivacyPublic {
+			return nil, fmt.Errorf("%w: search covers public perspectives only", domain.ErrInvalidInput)
+		}
+		public := domain.PrivacyPublic
+		params.Filter.Privacy = &public
+	}
+	if params.SortBy == domain.PerspectiveSortByRelevance && !hasSearch {
+		return nil, fmt.Errorf("%w: RELEVANCE sort requires a search", domain.ErrInvalidInput)
+	}
+	params.SortCategory = strings.TrimSpace(params.SortCategory)
+	if params.SortBy == domain.PerspectiveSortByCategorizedRating && params.SortCategory == "" {
+		return nil, fmt.Errorf("%w: CATEGORIZED_RATING sort requires a sort category", domain.ErrInvalidInput)
+	}
+	if params.SortBy != domain.PerspectiveSortByCategorizedRating && params.SortCategory != "" {
+		return nil, fmt.Errorf("%w: sort category applies only to CATEGORIZED_RATING sort", domain.ErrInvalidInput)
+	}
 
 	result, err := s.repo.List(ctx, params)
 	if err != nil {
@@ -225,3 +284,128 @@ func (s *PerspectiveService) ListPerspectives(ctx context.Context, params domain
 
 	return result, nil
 }
+
+// normalizePerspectiveFilter validates a list filter and returns a copy with
+// labels and category trimmed
+func normalizePerspectiveFilter(f domain.PerspectiveFilter) (domain.PerspectiveFilter, error) {
+	ratings := []struct {
+		name string
+		r    *domain.IntRange
+	}{
+		{"quality", f.Quality},
+		{"agreement", f.Agreement},
+		{"importance", f.Importance},
+		{"confidence", f.Confidence},
+	}
+	for _, rating := range ratings {
+		if err := rating.r.ValidateRating(rating.name); err != nil {
+			return f, err
+		}
+	}
+	if err := f.CreatedAt.Validate("createdAt"); err != nil {
+		return f, err
+	}
+	if err := f.UpdatedAt.Validate("updatedAt"); err != nil {
+		return f, err
+	}
+
+	var err error
+	if f.LabelsAny, err = domain.ValidateFilterValues("labelsAny", f.LabelsAny); err != nil {
+		return f, err
+	}
+	if f.LabelsAll, err = domain.ValidateFilterValues("labelsAll", f.LabelsAll); err != nil {
+		return f, err
+	}
+
+	if f.Category != nil {
+		category := strings.TrimSpace(*f.Category)
+		if category == "" {
+			return f, fmt.Errorf("%w: category must not be blank", domain.ErrInvalidInput)
+		}
+		f.Category = &category
+	}
+	if f.ReviewStatus != nil && !f.ReviewStatus.IsValid() {
+		return f, fmt.Errorf("%w: unknown review status %q", domain.ErrInvalidInput, *f.ReviewStatus)
+	}
+	if cr := f.CategorizedRating; cr != nil {
+		category := strings.TrimSpace(cr.Category)
+		if category == "" {
+			return f, fmt.Errorf("%w: categorized rating category is required", domain.ErrInvalidInput)
+		}
+		if !domain.ValidateRating(&cr.MinRating) {
+			return f, fmt.Errorf("%w: categorized rating minRating must be between %d and %d", domain.ErrInvalidInput, domain.RatingMin, domain.RatingMax)
+		}
+		f.CategorizedRating = &domain.CategorizedRatingFilter{Category: category, MinRating: cr.MinRating}
+	}
+	return f, nil
+}
+
+// validateSegments checks segments against the perspective's content and
+// returns them ordered by start time with positions assigned
+func (s *PerspectiveService) validateSegments(ctx context.Context, segments []domain.PerspectiveSegment, contentID *int) ([]domain.PerspectiveSegment, error) {
+	if len(segments) == 0 {
+		return segments, nil
+	}
+	if len(segments) > domain.MaxPerspectiveSegments {
+		return nil, fmt.Errorf("%w: at most %d segments are allowed", domain.ErrInvalidInput, domain.MaxPerspectiveSegments)
+	}
+	if contentID == nil {
+		return nil, fmt.Errorf("%w: segments require a content_id", domain.ErrInvalidInput)
+	}
+
+	content, err := s.contentRepo.GetByID(ctx, *contentID)
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return nil, fmt.Errorf("%w: content with id %d not found", domain.ErrNotFound, *contentID)
+		}
+		return nil, fmt.Errorf("failed to validate content: %w", err)
+	}
+	// Length is only comparable when it is a duration in seconds
+	lengthMs := -1
+	if content.Length != nil && (content.LengthUnits == nil || *content.LengthUnits == "seconds") {
+		lengthMs = *content.Length * 1000
+	}
+
+	validated := make([]domain.PerspectiveSegment, len(segments))
+	for i, seg := range segments {
+		if seg.StartMs < 0 {
+			return nil, fmt.Errorf("%w: segment %d starts before 0", domain.ErrInvalidInput, i)
+		}
+		if seg.EndMs <= seg.StartMs {
+			return nil, fmt.Errorf("%w: segment %d must end after it starts", domain.ErrInvalidInput, i)
+		}
+		if lengthMs >= 0 && seg.EndMs > lengthMs {
+			return nil, fmt.Errorf("%w: segment %d ends at %.3fs, after the content's length of %ds",
+				domain.ErrInvalidInput, i, float64(seg.EndMs)/1000, *content.Length)
+		}
+		if seg.Note != nil && utf8.RuneCountInString(*seg.Note) > domain.MaxSegmentNoteLength {
+			return nil, fmt.Errorf("%w: segment %d note exceeds %d characters", domain.ErrInvalidInput, i, domain.MaxSegmentNoteLength)
+		}
+		ratings := []struct {
+			name  string
+			value *int
+		}{
+			{"quality", seg.Quality},
+			{"agreement", seg.Agreement},
+			{"importance", seg.Importance},
+			{"confidence", seg.Confidence},
+		}
+		for _, r := range ratings {
+			if !domain.ValidateRating(r.value) {
+				return nil, fmt.Errorf("%w: segment %d %s %d", domain.ErrInvalidRating, i, r.name, *r.value)
+			}
+		}
+		validated[i] = seg
+	}
+
+	sort.SliceStable(validated, func(i, j int) bool {
+		if validated[i].StartMs != validated[j].StartMs {
+			return validated[i].StartMs < validated[j].StartMs
+		}
+		return validated[i].EndMs < validated[j].EndMs
+	})
+	for i := range validated {
+		validated[i].Position = i
+	}
+	return validated, nil
+}
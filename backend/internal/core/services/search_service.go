@@ -0,0 +1,46 @@
+package services
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/CodeWarrior-debug/perspectize/backend/internal/core/domain"
+	"github.com/CodeWarrior-debug/perspectize/backend/internal/core/ports/repositories"
+)
+
+// Limits on the number of suggestions returned
+const (
+	defaultSuggestionLimit = 5
+	maxSuggestionLimit     = 20
+)
+
+// SearchService implements search helpers that span content and users
+type SearchService struct {
+	repo repositories.SearchRepository
+}
+
+// NewSearchService creates a new search service
+func NewSearchService(repo repositories.SearchRepository) *SearchService {
+	return &SearchService{repo: repo}
+}
+
+// Suggest returns content names, channel titles and usernames similar to
+// query, for offering "did you mean" corrections. limit defaults to 5.
+func (s *SearchService) Suggest(ctx context.Context, query string, limit int) ([]*domain.SearchSuggestion, error) {
+	query, err := domain.ValidateFuzzyQuery(query)
+	if err != nil {
+		return nil, err
+	}
+	if limit == 0 {
+		limit = defaultSuggestionLimit
+	}
+	if limit < 1 || limit > maxSuggestionLimit {
+		return nil, fmt.Errorf("%w: first must be between 1 and %d", domain.ErrInvalidInput, maxSuggestionLimit)
+	}
+
+	suggestions, err := s.repo.Suggest(ctx, query, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to suggest search terms: %w", err)
+	}
+	return suggestions, nil
+}
@@ -0,0 +1,183 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/CodeWarrior-debug/perspectize/backend/internal/core/domain"
+	"github.com/CodeWarrior-debug/perspectize/backend/internal/core/ports/repositories"
+	portservices "github.com/CodeWarrior-debug/perspectize/backend/internal/core/ports/services"
+)
+
+const (
+	// defaultTranscriptLanguage is used when no caption language is given
+	defaultTranscriptLanguage = "en"
+	// maxCaptionBytes bounds the size of an uploaded caption file
+	maxCaptionBytes = 5 << 20
+	// Bounds on transcript search result counts
+	defaultTranscriptSearchLimit = 20
+	maxTranscriptSearchLimit     = 100
+)
+
+// languageTagPattern accepts BCP 47 tags such as "en", "pt-BR" or "zh-Hant"
+var languageTagPattern = regexp.MustCompile(`^[A-Za-z]{2,3}(-[A-Za-z0-9]{2,8})*$`)
+
+// TranscriptService implements business logic for content transcripts
+type TranscriptService struct {
+	repo          repositories.TranscriptRepository
+	contentRepo   repositories.ContentRepository
+	youtubeClient portservices.YouTubeClient
+	captions      portservices.CaptionProvider
+}
+
+// NewTranscriptService creates a new transcript service
+func NewTranscriptService(repo repositories.TranscriptRepository, contentRepo repositories.ContentRepository, yt portservices.YouTubeClient, captions portservices.CaptionProvider) *TranscriptService {
+	return &TranscriptService{
+		repo:          repo,
+		contentRepo:   contentRepo,
+		youtubeClient: yt,
+		captions:      captions,
+	}
+}
+
+// FetchFromYouTube downloads the video's caption track in language and stores
+// it as the content's transcript
+func (s *TranscriptService) FetchFromYouTube(ctx context.Context, contentID int, language string) (*domain.Transcript, error) {
+	language, err := normalizeLanguage(language)
+	if err != nil {
+		return nil, err
+	}
+	content, err := s.getContent(ctx, contentID)
+	if err != nil {
+		return nil, err
+	}
+	videoID, err := youTubeVideoID(s.youtubeClient, content)
+	if err != nil {
+		return nil, err
+	}
+
+	captions, err := s.captions.FetchCaptions(ctx, videoID, language)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch captions: %w", err)
+	}
+	segments, err := ParseCaptions(captions.Format, captions.Data)
+	if err != nil {
+		return nil, fmt.Errorf("%w: YouTube captions could not be parsed", domain.ErrYouTubeAPI)
+	}
+	if captions.Language != "" {
+		language = captions.Language
+	}
+	return s.store(ctx, content.ID, language, domain.TranscriptSourceYouTube, segments)
+}
+
+// Upload parses a WebVTT or SRT caption file and stores it as the content's transcript
+func (s *TranscriptService) Upload(ctx context.Context, input portservices.UploadTranscriptInput) (*domain.Transcript, error) {
+	language, err := normalizeLanguage(input.Language)
+	if err != nil {
+		return nil, err
+	}
+	if !input.Format.IsValid() {
+		return nil, fmt.Errorf("%w: unknown caption format %q", domain.ErrInvalidInput, input.Format)
+	}
+	if len(input.Data) > maxCaptionBytes {
+		return nil, fmt.Errorf("%w: caption file larger than %d bytes", domain.ErrInvalidInput, maxCaptionBytes)
+	}
+	content, err := s.getContent(ctx, input.ContentID)
+	if err != nil {
+		return nil, err
+	}
+
+	segments, err := ParseCaptions(input.Format, input.Data)
+	if err != nil {
+		return nil, err
+	}
+	return s.store(ctx, content.ID, language, domain.TranscriptSourceUpload, segments)
+}
+
+// Segments returns the transcript segments overlapping [FromMs, ToMs)
+func (s *TranscriptService) Segments(ctx context.Context, params domain.TranscriptRangeParams) ([]*domain.TranscriptSegment, error) {
+	if params.ContentID <= 0 {
+		return nil, fmt.Errorf("%w: content id must be a positive integer", domain.ErrInvalidInput)
+	}
+	if (params.FromMs != nil && *params.FromMs < 0) || (params.ToMs != nil && *params.ToMs < 0) {
+		return nil, fmt.Errorf("%w: from and to must not be negative", domain.ErrInvalidInput)
+	}
+	if params.FromMs != nil && params.ToMs != nil && *params.FromMs >= *params.ToMs {
+		return nil, fmt.Errorf("%w: from must be before to", domain.ErrInvalidInput)
+	}
+
+	segments, err := s.repo.ListSegments(ctx, params)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get transcript: %w", err)
+	}
+	return segments, nil
+}
+
+// Search finds transcript segments matching a full-text query
+func (s *TranscriptService) Search(ctx context.Context, params domain.TranscriptSearchParams) ([]*domain.TranscriptMatch, error) {
+	params.Query = strings.TrimSpace(params.Query)
+	if params.Query == "" {
+		return nil, fmt.Errorf("%w: search query is required", domain.ErrInvalidInput)
+	}
+	if params.ContentID != nil && *params.ContentID <= 0 {
+		return nil, fmt.Errorf("%w: content id must be a positive integer", domain.ErrInvalidInput)
+	}
+	if params.Limit <= 0 {
+		params.Limit = defaultTranscriptSearchLimit
+	}
+	if params.Limit > maxTranscriptSearchLimit {
+		return nil, fmt.Errorf("%w: first must be at most %d", domain.ErrInvalidInput, maxTranscriptSearchLimit)
+	}
+
+	matches, err := s.repo.Search(ctx, params)
+	if err != nil {
+		return nil, fmt.Errorf("failed to search transcripts: %w", err)
+	}
+	return matches, nil
+}
+
+func (s *TranscriptService) getContent(ctx context.Context, contentID int) (*domain.Content, error) {
+	if contentID <= 0 {
+		return nil, fmt.Errorf("%w: content id must be a positive integer", domain.ErrInvalidInput)
+	}
+	content, err := s.contentRepo.GetByID(ctx, contentID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get content: %w", err)
+	}
+	return content, nil
+}
+
+func (s *TranscriptService) store(ctx context.Context, contentID int, language string, source domain.TranscriptSource, segments []*domain.TranscriptSegment) (*domain.Transcript, error) {
+	if len(segments) == 0 {
+		return nil, fmt.Errorf("%w: captions contain no text", domain.ErrInvalidInput)
+	}
+	for _, segment := range segments {
+		segment.ContentID = contentID
+	}
+
+	transcript, err := s.repo.Replace(ctx, &domain.Transcript{
+		ContentID:    contentID,
+		Language:     language,
+		Source:       source,
+		Segments:     segments,
+		SegmentCount: len(segments),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to save transcript: %w", err)
+	}
+	return transcript, nil
+}
+
+// normalizeLanguage validates a caption language tag, defaulting to English
+func normalizeLanguage(language string) (string, error) {
+	language = strings.TrimSpace(language)
+	if language == "" {
+		return defaultTranscriptLanguage, nil
+	}
+	if !languageTagPattern.MatchString(language) {
+		return "", fmt.Errorf("%w: invalid language tag %q", domain.ErrInvalidInput, language)
+	}
+	return language, nil
+}
@@ -127,6 +127,27 @@ func (s *UserService) ListAll(ctx context.Context) ([]*domain.User, error) {
 	return users, nil
 }
 
+// Search finds active users whose username is similar to query, tolerating
+// typos. limit defaults to 10.
+func (s *UserService) Search(ctx context.Context, query string, limit int) ([]*domain.User, error) {
+	query, err := domain.ValidateFuzzyQuery(query)
+	if err != nil {
+		return nil, err
+	}
+	if limit == 0 {
+		limit = 10
+	}
+	if limit < 1 || limit > 100 {
+		return nil, fmt.Errorf("%w: first must be between 1 and 100", domain.ErrInvalidInput)
+	}
+
+	users, err := s.repo.Search(ctx, query, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to search users: %w", err)
+	}
+	return users, nil
+}
+
 // Update updates an existing user's username and/or email
 func (s *UserService) Update(ctx context.Context, input portservices.UpdateUserInput) (*domain.User, error) {
 	if input.ID <= 0 {
@@ -0,0 +1,21 @@
+-- Restore original perspectives -> content FK (default, no explicit clause)
+ALTER TABLE public.perspectives DROP CONSTRAINT perspectives_content_fk;
+ALTER TABLE public.perspectives
+ADD CONSTRAINT perspectives_content_fk
+    FOREIGN KEY (content_id) REFERENCES public.content(id);
+
+DROP INDEX IF EXISTS public.idx_perspectives_content_id;
+
+-- Permanently remove soft-deleted rows (detach any remaining perspectives first)
+-- so the original table-wide unique constraints can be restored.
+UPDATE public.perspectives SET content_id = NULL
+WHERE content_id IN (SELECT id FROM public.content WHERE deleted_at IS NOT NULL);
+DELETE FROM public.content WHERE deleted_at IS NOT NULL;
+
+DROP INDEX IF EXISTS public.content_unique_url;
+DROP INDEX IF EXISTS public.content_unique_name;
+ALTER TABLE public.content ADD CONSTRAINT content_unique_url UNIQUE(url);
+ALTER TABLE public.content ADD CONSTRAINT content_unique_name UNIQUE(name);
+
+DROP INDEX IF EXISTS public.idx_content_deleted_at;
+ALTER TABLE public.content DROP COLUMN IF EXISTS deleted_at;
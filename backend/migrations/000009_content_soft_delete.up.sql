@@ -0,0 +1,21 @@
+-- Soft delete for content. Deleted rows keep their id so perspectives and
+-- history can still reference them; queries filter on deleted_at IS NULL.
+ALTER TABLE public.content ADD COLUMN deleted_at timestamptz NULL;
+CREATE INDEX idx_content_deleted_at ON public.content (deleted_at);
+
+-- Uniqueness only applies to live rows so a deleted video can be re-added.
+ALTER TABLE public.content DROP CONSTRAINT IF EXISTS content_unique_url;
+ALTER TABLE public.content DROP CONSTRAINT IF EXISTS content_unique_name;
+CREATE UNIQUE INDEX content_unique_url ON public.content (url) WHERE deleted_at IS NULL;
+CREATE UNIQUE INDEX content_unique_name ON public.content (name) WHERE deleted_at IS NULL;
+
+-- Index the FK used by delete policies (RESTRICT count / DETACH update)
+CREATE INDEX IF NOT EXISTS idx_perspectives_content_id ON public.perspectives (content_id);
+
+-- Make perspectives -> content FK explicitly ON DELETE RESTRICT (was implicit default),
+-- matching the user FKs from migration 000006. Hard deletes must go through the
+-- service, which detaches or blocks first.
+ALTER TABLE public.perspectives DROP CONSTRAINT perspectives_content_fk;
+ALTER TABLE public.perspectives
+ADD CONSTRAINT perspectives_content_fk
+    FOREIGN KEY (content_id) REFERENCES public.content(id) ON DELETE RESTRICT;
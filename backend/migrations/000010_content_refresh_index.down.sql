@@ -0,0 +1 @@
+DROP INDEX IF EXISTS public.idx_content_type_updated_at;
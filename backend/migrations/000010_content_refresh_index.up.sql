@@ -0,0 +1,4 @@
+-- Supports the background refresher's oldest-first scan of stale YouTube content
+CREATE INDEX IF NOT EXISTS idx_content_type_updated_at
+    ON public.content (content_type, updated_at)
+    WHERE deleted_at IS NULL;
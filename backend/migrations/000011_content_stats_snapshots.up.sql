@@ -0,0 +1,17 @@
+-- Point-in-time YouTube statistics, recorded on every metadata fetch so growth
+-- can be charted. Counts are NULL when the channel hides them.
+CREATE TABLE public.content_stats_snapshots (
+    id bigserial NOT NULL,
+    content_id integer NOT NULL,
+    captured_at timestamptz DEFAULT NOW() NOT NULL,
+    view_count bigint NULL,
+    like_count bigint NULL,
+    comment_count bigint NULL,
+    CONSTRAINT content_stats_snapshots_pk PRIMARY KEY (id),
+    CONSTRAINT content_stats_snapshots_content_fk
+        FOREIGN KEY (content_id) REFERENCES public.content(id) ON DELETE CASCADE
+);
+
+-- History queries filter by content and range-scan captured_at
+CREATE INDEX idx_content_stats_snapshots_content_captured
+    ON public.content_stats_snapshots (content_id, captured_at);
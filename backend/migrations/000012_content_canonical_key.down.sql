@@ -0,0 +1,5 @@
+-- Rows merged by the up migration stay soft-deleted; their perspectives and
+-- stats history remain on the surviving row.
+DROP INDEX IF EXISTS public.content_unique_canonical_key;
+DROP TABLE IF EXISTS public.content_aliases;
+ALTER TABLE public.content DROP COLUMN IF EXISTS canonical_key;
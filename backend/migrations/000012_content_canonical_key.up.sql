@@ -0,0 +1,67 @@
+-- Provider-level identity for content, so different URL forms of the same
+-- video (youtu.be/X, watch?v=X&t=30, m.youtube.com/...) map to one row.
+-- YouTube keys are 'youtube:<video id>'.
+ALTER TABLE public.content ADD COLUMN canonical_key varchar NULL;
+
+-- Backfill with the same URL forms the YouTube adapter's ExtractVideoID accepts
+UPDATE public.content
+SET canonical_key = 'youtube:' || substring(url from '(?:youtube\.com/watch\?v=|youtu\.be/|(?:youtube\.com|youtube-nocookie\.com)/(?:embed|v|e|shorts|live)/)([a-zA-Z0-9_-]{11})')
+WHERE content_type = 'youtube'
+  AND url ~ '(?:youtube\.com/watch\?v=|youtu\.be/|(?:youtube\.com|youtube-nocookie\.com)/(?:embed|v|e|shorts|live)/)[a-zA-Z0-9_-]{11}';
+
+-- Other URLs known to point at a content row
+CREATE TABLE public.content_aliases (
+    id serial NOT NULL,
+    content_id integer NOT NULL,
+    url varchar NOT NULL,
+    created_at timestamptz DEFAULT NOW() NOT NULL,
+    CONSTRAINT content_aliases_pk PRIMARY KEY (id),
+    CONSTRAINT content_aliases_unique_url UNIQUE (url),
+    CONSTRAINT content_aliases_content_fk
+        FOREIGN KEY (content_id) REFERENCES public.content(id) ON DELETE CASCADE
+);
+CREATE INDEX idx_content_aliases_content_id ON public.content_aliases (content_id);
+
+-- Merge existing duplicates. The oldest live row per key survives; the others
+-- hand over their perspectives and stats history, leave their URL behind as an
+-- alias, and are soft-deleted.
+CREATE TEMP TABLE content_duplicates AS
+SELECT c.id AS duplicate_id, k.keeper_id
+FROM public.content c
+JOIN (
+    SELECT canonical_key, MIN(id) AS keeper_id
+    FROM public.content
+    WHERE deleted_at IS NULL AND canonical_key IS NOT NULL
+    GROUP BY canonical_key
+    HAVING COUNT(*) > 1
+) k ON c.canonical_key = k.canonical_key
+WHERE c.deleted_at IS NULL AND c.id <> k.keeper_id;
+
+UPDATE public.perspectives p
+SET content_id = d.keeper_id
+FROM content_duplicates d
+WHERE p.content_id = d.duplicate_id;
+
+UPDATE public.content_stats_snapshots s
+SET content_id = d.keeper_id
+FROM content_duplicates d
+WHERE s.content_id = d.duplicate_id;
+
+INSERT INTO public.content_aliases (content_id, url)
+SELECT d.keeper_id, c.url
+FROM content_duplicates d
+JOIN public.content c ON c.id = d.duplicate_id
+WHERE c.url IS NOT NULL
+ON CONFLICT (url) DO NOTHING;
+
+UPDATE public.content c
+SET deleted_at = NOW()
+FROM content_duplicates d
+WHERE c.id = d.duplicate_id;
+
+DROP TABLE content_duplicates;
+
+-- Like url and name, the key only has to be unique among live rows
+CREATE UNIQUE INDEX content_unique_canonical_key
+    ON public.content (canonical_key)
+    WHERE deleted_at IS NULL;
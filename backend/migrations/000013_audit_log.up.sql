@@ -0,0 +1,12 @@
+-- Append-only record of administrative changes such as content merges
+CREATE TABLE public.audit_log (
+    id bigserial NOT NULL,
+    action varchar NOT NULL,
+    entity_type varchar NOT NULL,
+    entity_id integer NOT NULL,
+    details jsonb NULL,
+    created_at timestamptz DEFAULT NOW() NOT NULL,
+    CONSTRAINT audit_log_pk PRIMARY KEY (id)
+);
+
+CREATE INDEX idx_audit_log_entity ON public.audit_log (entity_type, entity_id);
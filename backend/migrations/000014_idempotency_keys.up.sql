@@ -0,0 +1,9 @@
+-- Responses to mutations sent with an Idempotency-Key header. response is
+-- NULL while the first request is in flight; expired keys are replaced on reuse.
+CREATE TABLE public.idempotency_keys (
+    key varchar NOT NULL,
+    request_hash varchar NOT NULL,
+    response jsonb NULL,
+    created_at timestamptz DEFAULT NOW() NOT NULL,
+    CONSTRAINT idempotency_keys_pk PRIMARY KEY (key)
+);
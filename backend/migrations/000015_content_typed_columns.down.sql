@@ -0,0 +1,15 @@
+-- Responses stored trimmed or dropped while these columns existed are not
+-- restored; the refresher repopulates them on its next pass.
+DROP INDEX IF EXISTS public.idx_content_tags;
+DROP INDEX IF EXISTS public.idx_content_channel_title;
+DROP INDEX IF EXISTS public.idx_content_published_at;
+DROP INDEX IF EXISTS public.idx_content_like_count;
+DROP INDEX IF EXISTS public.idx_content_view_count;
+ALTER TABLE public.content
+    DROP COLUMN IF EXISTS comment_count,
+    DROP COLUMN IF EXISTS like_count,
+    DROP COLUMN IF EXISTS view_count,
+    DROP COLUMN IF EXISTS tags,
+    DROP COLUMN IF EXISTS published_at,
+    DROP COLUMN IF EXISTS description,
+    DROP COLUMN IF EXISTS channel_title;
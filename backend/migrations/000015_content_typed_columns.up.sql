@@ -0,0 +1,51 @@
+-- YouTube metadata previously read out of the stored API response, promoted to
+-- typed columns so it can be filtered, sorted and indexed. The response itself
+-- may now be stored trimmed or not at all, so these columns are the source of
+-- truth for the fields they hold.
+ALTER TABLE public.content
+    ADD COLUMN channel_title varchar NULL,
+    ADD COLUMN description text NULL,
+    ADD COLUMN published_at timestamptz NULL,
+    ADD COLUMN tags text[] NULL,
+    ADD COLUMN view_count bigint NULL,
+    ADD COLUMN like_count bigint NULL,
+    ADD COLUMN comment_count bigint NULL;
+
+-- Backfill from existing responses. Statistics YouTube hid are stored as empty
+-- strings or omitted; those stay NULL rather than failing the cast.
+UPDATE public.content c
+SET channel_title = NULLIF(item->'snippet'->>'channelTitle', ''),
+    description   = NULLIF(item->'snippet'->>'description', ''),
+    published_at  = CASE
+        WHEN item->'snippet'->>'publishedAt' ~ '^\d{4}-\d{2}-\d{2}T'
+        THEN (item->'snippet'->>'publishedAt')::timestamptz
+    END,
+    tags = CASE
+        WHEN jsonb_typeof(item->'snippet'->'tags') = 'array'
+        THEN ARRAY(SELECT jsonb_array_elements_text(item->'snippet'->'tags'))
+    END,
+    view_count = CASE
+        WHEN item->'statistics'->>'viewCount' ~ '^\d{1,18}$'
+        THEN (item->'statistics'->>'viewCount')::bigint
+    END,
+    like_count = CASE
+        WHEN item->'statistics'->>'likeCount' ~ '^\d{1,18}$'
+        THEN (item->'statistics'->>'likeCount')::bigint
+    END,
+    comment_count = CASE
+        WHEN item->'statistics'->>'commentCount' ~ '^\d{1,18}$'
+        THEN (item->'statistics'->>'commentCount')::bigint
+    END
+FROM (
+    SELECT id, response->'items'->0 AS item
+    FROM public.content
+    WHERE jsonb_typeof(response->'items'->0) = 'object'
+) r
+WHERE c.id = r.id;
+
+-- Sort keys for content listing, and lookups by channel and tag
+CREATE INDEX idx_content_view_count ON public.content (view_count) WHERE deleted_at IS NULL;
+CREATE INDEX idx_content_like_count ON public.content (like_count) WHERE deleted_at IS NULL;
+CREATE INDEX idx_content_published_at ON public.content (published_at) WHERE deleted_at IS NULL;
+CREATE INDEX idx_content_channel_title ON public.content (channel_title) WHERE deleted_at IS NULL;
+CREATE INDEX idx_content_tags ON public.content USING GIN (tags);
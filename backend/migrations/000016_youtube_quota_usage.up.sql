@@ -0,0 +1,9 @@
+-- YouTube Data API quota units spent per method per quota day (Pacific time),
+-- so the daily budget survives restarts
+CREATE TABLE public.youtube_quota_usage (
+    day date NOT NULL,
+    method varchar NOT NULL,
+    units integer DEFAULT 0 NOT NULL,
+    updated_at timestamptz DEFAULT NOW() NOT NULL,
+    CONSTRAINT youtube_quota_usage_pk PRIMARY KEY (day, method)
+);
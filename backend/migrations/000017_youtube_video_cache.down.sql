@@ -0,0 +1 @@
+DROP TABLE IF EXISTS public.youtube_video_cache;
@@ -0,0 +1,11 @@
+-- Persistent cache of YouTube videos.list items with per-part fetch times and
+-- the ETag used for conditional requests
+CREATE TABLE public.youtube_video_cache (
+    video_id varchar NOT NULL,
+    item jsonb NOT NULL,
+    fetched_at jsonb DEFAULT '{}'::jsonb NOT NULL,
+    etag varchar NULL,
+    etag_parts varchar NULL,
+    updated_at timestamptz DEFAULT NOW() NOT NULL,
+    CONSTRAINT youtube_video_cache_pk PRIMARY KEY (video_id)
+);
@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS public.transcript_segments;
+DROP TABLE IF EXISTS public.content_transcripts;
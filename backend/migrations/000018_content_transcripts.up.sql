@@ -0,0 +1,35 @@
+-- One transcript per content item, fetched from YouTube or uploaded as WebVTT/SRT
+CREATE TABLE public.content_transcripts (
+    content_id integer NOT NULL,
+    language varchar NOT NULL,
+    source varchar NOT NULL,
+    segment_count integer NOT NULL,
+    created_at timestamptz DEFAULT NOW() NOT NULL,
+    updated_at timestamptz DEFAULT NOW() NOT NULL,
+    CONSTRAINT content_transcripts_pk PRIMARY KEY (content_id),
+    CONSTRAINT content_transcripts_content_fk
+        FOREIGN KEY (content_id) REFERENCES public.content(id) ON DELETE CASCADE
+);
+
+-- Timed caption cues. The 'simple' text search configuration neither stems
+-- nor drops stop words, so it behaves the same for every caption language.
+CREATE TABLE public.transcript_segments (
+    content_id integer NOT NULL,
+    position integer NOT NULL,
+    start_ms integer NOT NULL,
+    end_ms integer NOT NULL,
+    text text NOT NULL,
+    text_search tsvector GENERATED ALWAYS AS (to_tsvector('simple', text)) STORED,
+    CONSTRAINT transcript_segments_pk PRIMARY KEY (content_id, position),
+    CONSTRAINT transcript_segments_transcript_fk
+        FOREIGN KEY (content_id) REFERENCES public.content_transcripts(content_id)
+        ON DELETE CASCADE ON UPDATE CASCADE,
+    CONSTRAINT transcript_segments_times_check CHECK (start_ms >= 0 AND end_ms >= start_ms)
+);
+
+-- Time-window reads scan one transcript by start time
+CREATE INDEX idx_transcript_segments_content_start
+    ON public.transcript_segments (content_id, start_ms);
+
+CREATE INDEX idx_transcript_segments_text_search
+    ON public.transcript_segments USING GIN (text_search);
@@ -0,0 +1,43 @@
+-- Time ranges within a perspective's content, each with an optional note and
+-- optional ratings. Times are milliseconds from the start of the content.
+CREATE TABLE public.perspective_segments (
+    perspective_id integer NOT NULL,
+    position integer NOT NULL,
+    start_ms integer NOT NULL,
+    end_ms integer NOT NULL,
+    note text NULL,
+    quality valid_integer_range NULL,
+    agreement valid_integer_range NULL,
+    importance valid_integer_range NULL,
+    confidence valid_integer_range NULL,
+    CONSTRAINT perspective_segments_pk PRIMARY KEY (perspective_id, position),
+    CONSTRAINT perspective_segments_perspective_fk
+        FOREIGN KEY (perspective_id) REFERENCES public.perspectives(id) ON DELETE CASCADE,
+    CONSTRAINT perspective_segments_times_check CHECK (start_ms >= 0 AND end_ms > start_ms)
+);
+
+-- Convert legacy parts. Each part is read as the second at which a referenced
+-- section starts; it runs until the next part or the end of the content.
+-- Parts that cannot form a range (out of range, past the content's length, or the
+-- last part of content with no known length) are skipped. The parts column
+-- itself is left untouched.
+INSERT INTO public.perspective_segments (perspective_id, position, start_ms, end_ms)
+SELECT perspective_id,
+       row_number() OVER (PARTITION BY perspective_id ORDER BY start_s) - 1,
+       start_s * 1000,
+       end_s * 1000
+FROM (
+    SELECT perspective_id,
+           start_s,
+           LEAST(lead(start_s) OVER (PARTITION BY perspective_id ORDER BY start_s), length_s) AS end_s
+    FROM (
+        SELECT DISTINCT p.id AS perspective_id,
+               part AS start_s,
+               CASE WHEN c.length_units IS NULL OR c.length_units = 'seconds' THEN c.length END AS length_s
+        FROM public.perspectives p
+        CROSS JOIN LATERAL unnest(p.parts) AS part
+        JOIN public.content c ON c.id = p.content_id
+        WHERE part BETWEEN 0 AND 2147483 -- largest second whose milliseconds fit in integer
+    ) starts
+) ranges
+WHERE end_s IS NOT NULL AND end_s > start_s;
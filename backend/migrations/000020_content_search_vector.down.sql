@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS public.idx_content_search_vector;
+ALTER TABLE public.content DROP COLUMN IF EXISTS search_vector;
+DROP FUNCTION IF EXISTS public.content_tags_text(text[]);
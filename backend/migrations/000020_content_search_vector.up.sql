@@ -0,0 +1,17 @@
+-- array_to_string is only STABLE, which a generated column cannot use; for
+-- text[] its result never changes, so this wrapper is safely IMMUTABLE
+CREATE FUNCTION public.content_tags_text(tags text[]) RETURNS text
+    LANGUAGE sql IMMUTABLE PARALLEL SAFE
+    AS $$ SELECT COALESCE(array_to_string(tags, ' '), '') $$;
+
+-- Weighted search document: names rank above channels and tags, which rank
+-- above descriptions
+ALTER TABLE public.content
+    ADD COLUMN search_vector tsvector GENERATED ALWAYS AS (
+        setweight(to_tsvector('english', COALESCE(name, '')), 'A') ||
+        setweight(to_tsvector('english', COALESCE(channel_title, '')), 'B') ||
+        setweight(to_tsvector('english', public.content_tags_text(tags)), 'B') ||
+        setweight(to_tsvector('english', COALESCE(description, '')), 'C')
+    ) STORED;
+
+CREATE INDEX idx_content_search_vector ON public.content USING GIN (search_vector);
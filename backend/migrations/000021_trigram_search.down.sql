@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS public.idx_users_username_trgm;
+DROP INDEX IF EXISTS public.idx_content_channel_title_trgm;
+DROP INDEX IF EXISTS public.idx_content_name_trgm;
+DROP EXTENSION IF EXISTS pg_trgm;
@@ -0,0 +1,6 @@
+-- Trigram indexes back fuzzy search (%) and substring ILIKE on names
+CREATE EXTENSION IF NOT EXISTS pg_trgm;
+
+CREATE INDEX idx_content_name_trgm ON public.content USING GIN (name gin_trgm_ops);
+CREATE INDEX idx_content_channel_title_trgm ON public.content USING GIN (channel_title gin_trgm_ops);
+CREATE INDEX idx_users_username_trgm ON public.users USING GIN (username gin_trgm_ops);
@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS public.idx_perspectives_search_vector;
+ALTER TABLE public.perspectives DROP COLUMN IF EXISTS search_vector;
+DROP FUNCTION IF EXISTS public.text_array_to_text(text[]);
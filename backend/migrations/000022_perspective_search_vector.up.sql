@@ -0,0 +1,17 @@
+-- Labels get their own IMMUTABLE array_to_string wrapper rather than sharing
+-- content_tags_text, so changes to how tags are indexed leave labels alone
+CREATE FUNCTION public.text_array_to_text(arr text[]) RETURNS text
+    LANGUAGE sql IMMUTABLE PARALLEL SAFE
+    AS $$ SELECT COALESCE(array_to_string(arr, ' '), '') $$;
+
+-- Weighted search document: category and labels are short, deliberate
+-- keywords, so they rank above like text, which ranks above descriptions
+ALTER TABLE public.perspectives
+    ADD COLUMN search_vector tsvector GENERATED ALWAYS AS (
+        setweight(to_tsvector('english', COALESCE(category, '')), 'A') ||
+        setweight(to_tsvector('english', public.text_array_to_text(labels)), 'A') ||
+        setweight(to_tsvector('english', COALESCE("like", '')), 'B') ||
+        setweight(to_tsvector('english', COALESCE(description, '')), 'C')
+    ) STORED;
+
+CREATE INDEX idx_perspectives_search_vector ON public.perspectives USING GIN (search_vector);
@@ -0,0 +1,12 @@
+DROP INDEX IF EXISTS public.idx_perspectives_updated_at;
+DROP INDEX IF EXISTS public.idx_perspectives_created_at;
+DROP INDEX IF EXISTS public.idx_perspectives_categorized_ratings;
+DROP INDEX IF EXISTS public.idx_perspectives_review_status;
+DROP INDEX IF EXISTS public.idx_perspectives_category_lower;
+DROP INDEX IF EXISTS public.idx_perspectives_labels;
+DROP INDEX IF EXISTS public.idx_perspectives_confidence;
+DROP INDEX IF EXISTS public.idx_perspectives_importance;
+DROP INDEX IF EXISTS public.idx_perspectives_agreement;
+DROP INDEX IF EXISTS public.idx_perspectives_quality;
+DROP INDEX IF EXISTS public.idx_perspectives_user_id;
+DROP FUNCTION IF EXISTS public.perspective_ratings_jsonb(jsonb[]);
@@ -0,0 +1,19 @@
+-- to_jsonb is only STABLE, which an index expression cannot use; for
+-- jsonb[] its result never changes, so this wrapper is safely IMMUTABLE
+CREATE FUNCTION public.perspective_ratings_jsonb(ratings jsonb[]) RETURNS jsonb
+    LANGUAGE sql IMMUTABLE PARALLEL SAFE
+    AS $$ SELECT COALESCE(to_jsonb(ratings), '[]'::jsonb) $$;
+
+-- Indexes for perspective list filters
+CREATE INDEX idx_perspectives_user_id ON public.perspectives (user_id);
+CREATE INDEX idx_perspectives_quality ON public.perspectives (quality);
+CREATE INDEX idx_perspectives_agreement ON public.perspectives (agreement);
+CREATE INDEX idx_perspectives_importance ON public.perspectives (importance);
+CREATE INDEX idx_perspectives_confidence ON public.perspectives (confidence);
+CREATE INDEX idx_perspectives_labels ON public.perspectives USING GIN (labels);
+CREATE INDEX idx_perspectives_category_lower ON public.perspectives (lower(category));
+CREATE INDEX idx_perspectives_review_status ON public.perspectives (review_status);
+CREATE INDEX idx_perspectives_categorized_ratings ON public.perspectives
+    USING GIN (public.perspective_ratings_jsonb(categorized_ratings) jsonb_path_ops);
+CREATE INDEX idx_perspectives_created_at ON public.perspectives (created_at);
+CREATE INDEX idx_perspectives_updated_at ON public.perspectives (updated_at);
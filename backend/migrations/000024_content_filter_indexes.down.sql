@@ -0,0 +1,4 @@
+CREATE INDEX IF NOT EXISTS idx_perspectives_content_id ON public.perspectives (content_id);
+DROP INDEX IF EXISTS public.idx_perspectives_content_id_user_id;
+DROP INDEX IF EXISTS public.idx_content_added_by_user_id;
+DROP INDEX IF EXISTS public.idx_content_created_at;
@@ -0,0 +1,8 @@
+-- Indexes for content list filters
+CREATE INDEX idx_content_created_at ON public.content (created_at) WHERE deleted_at IS NULL;
+CREATE INDEX idx_content_added_by_user_id ON public.content (added_by_user_id) WHERE deleted_at IS NULL;
+
+-- Serves the "no perspectives from user X" probe per content row. It leads
+-- with content_id, so it also serves everything idx_perspectives_content_id did.
+CREATE INDEX idx_perspectives_content_id_user_id ON public.perspectives (content_id, user_id);
+DROP INDEX IF EXISTS public.idx_perspectives_content_id;
@@ -0,0 +1,8 @@
+DROP INDEX IF EXISTS public.idx_content_refresh_due;
+CREATE INDEX IF NOT EXISTS idx_content_type_updated_at
+    ON public.content (content_type, updated_at)
+    WHERE deleted_at IS NULL;
+
+ALTER TABLE public.content
+    DROP COLUMN IF EXISTS unavailable_at,
+    DROP COLUMN IF EXISTS refresh_attempted_at;
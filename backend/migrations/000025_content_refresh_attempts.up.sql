@@ -0,0 +1,15 @@
+-- Lets the background refresher move past content it cannot refresh.
+-- refresh_attempted_at is when a refresh last failed, so the row waits as
+-- long as freshly refreshed content before it is tried again.
+-- unavailable_at is when YouTube stopped returning the video (deleted, made
+-- private, or never existed); the refresher leaves such rows alone.
+ALTER TABLE public.content
+    ADD COLUMN refresh_attempted_at timestamptz NULL,
+    ADD COLUMN unavailable_at timestamptz NULL;
+
+-- The refresher now scans by the later of the last update and the last
+-- failed attempt, so it needs a different index than 000010's
+DROP INDEX IF EXISTS public.idx_content_type_updated_at;
+CREATE INDEX idx_content_refresh_due
+    ON public.content (content_type, GREATEST(updated_at, refresh_attempted_at))
+    WHERE deleted_at IS NULL AND unavailable_at IS NULL;
@@ -13,7 +13,7 @@ import (
 // run against config file values only. t.Setenv restores originals on cleanup.
 func clearConfigEnvVars(t *testing.T) {
 	t.Helper()
-	for _, key := range []string{"DATABASE_URL", "DATABASE_PASSWORD", "YOUTUBE_API_KEY"} {
+	for _, key := range []string{"DATABASE_URL", "DATABASE_PASSWORD", "YOUTUBE_API_KEY", "CURSOR_SECRETS"} {
 		t.Setenv(key, "")
 	}
 }
@@ -38,6 +38,29 @@ func TestLoad_RealConfigFile(t *testing.T) {
 	assert.Equal(t, "testuser", cfg.Database.User)
 	assert.Equal(t, "disable", cfg.Database.SSLMode)
 	assert.Equal(t, "", cfg.YouTube.APIKey, "API key should be empty in example config")
+	assert.False(t, cfg.YouTube.Refresh.Enabled, "Refresher should be disabled in example config")
+	assert.Equal(t, 60, cfg.YouTube.Refresh.IntervalMinutes)
+	assert.Equal(t, 24, cfg.YouTube.Refresh.MaxAgeHours)
+	assert.Equal(t, 50, cfg.YouTube.Refresh.BatchSize)
+	assert.Equal(t, 2, cfg.YouTube.Refresh.RequestsPerSecond)
+	assert.Equal(t, "trimmed", cfg.YouTube.ResponseStorage)
+	assert.Equal(t, 10000, cfg.YouTube.Quota.DailyBudget)
+	assert.Equal(t, 5, cfg.YouTube.Quota.FailureThreshold)
+	assert.Equal(t, 60, cfg.YouTube.Quota.CooldownSeconds)
+	assert.Equal(t, 10, cfg.YouTube.HTTP.TimeoutSeconds)
+	assert.Equal(t, 3, cfg.YouTube.HTTP.MaxAttempts)
+	assert.Equal(t, 500, cfg.YouTube.HTTP.RetryBaseDelayMs)
+	assert.Equal(t, 5000, cfg.YouTube.HTTP.RetryMaxDelayMs)
+	assert.Equal(t, int64(10485760), cfg.YouTube.HTTP.MaxResponseBytes)
+	assert.True(t, cfg.YouTube.Cache.Enabled)
+	assert.Equal(t, 10000, cfg.YouTube.Cache.Capacity)
+	assert.True(t, cfg.YouTube.Cache.Persistent)
+	assert.Equal(t, 1440, cfg.YouTube.Cache.SnippetTTLMinutes)
+	assert.Equal(t, 60, cfg.YouTube.Cache.StatisticsTTLMinutes)
+	assert.Equal(t, 10080, cfg.YouTube.Cache.ContentDetailsTTLMinutes)
+	assert.Equal(t, 0.3, cfg.Search.SimilarityThreshold)
+	assert.Equal(t, 30, cfg.Pagination.CountCacheTTLSeconds)
+	assert.Empty(t, cfg.Pagination.CursorSecrets, "Cursor secrets should be empty in example config")
 	assert.Equal(t, "info", cfg.Logging.Level)
 	assert.Equal(t, "json", cfg.Logging.Format)
 }
@@ -51,6 +74,7 @@ func TestLoad_RealConfigWithEnvOverrides(t *testing.T) {
 	// Set environment variables (t.Setenv auto-restores on cleanup)
 	t.Setenv("DATABASE_PASSWORD", "secret123")
 	t.Setenv("YOUTUBE_API_KEY", "yt_key_456")
+	t.Setenv("CURSOR_SECRETS", "new_secret, old_secret")
 
 	cfg, err := config.Load(configPath)
 	assert.NoError(t, err)
@@ -59,6 +83,7 @@ func TestLoad_RealConfigWithEnvOverrides(t *testing.T) {
 	// Verify environment variables override empty values from config.example.json
 	assert.Equal(t, "secret123", cfg.Database.Password, "DATABASE_PASSWORD env var should override config")
 	assert.Equal(t, "yt_key_456", cfg.YouTube.APIKey, "YOUTUBE_API_KEY env var should override config")
+	assert.Equal(t, []string{"new_secret", "old_secret"}, cfg.Pagination.CursorSecrets, "CURSOR_SECRETS env var should override config")
 }
 
 // TestLoad_InvalidPath tests error handling for missing file
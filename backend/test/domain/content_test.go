@@ -13,6 +13,10 @@ func TestContentType_YouTube(t *testing.T) {
 	assert.Equal(t, domain.ContentType("YOUTUBE"), domain.ContentTypeYouTube)
 }
 
+func TestCanonicalKey(t *testing.T) {
+	assert.Equal(t, "youtube:dQw4w9WgXcQ", domain.CanonicalKey(domain.ContentTypeYouTube, "dQw4w9WgXcQ"))
+}
+
 func TestContent_RequiredFields(t *testing.T) {
 	content := domain.Content{
 		ID:          1,
@@ -69,3 +73,10 @@ func TestContent_NilOptionalFields(t *testing.T) {
 	assert.Nil(t, content.Length)
 	assert.Nil(t, content.LengthUnits)
 }
+
+func TestStatsGranularity_IsValid(t *testing.T) {
+	assert.True(t, domain.StatsGranularityDay.IsValid())
+	assert.True(t, domain.StatsGranularityWeek.IsValid())
+	assert.False(t, domain.StatsGranularity("MONTH").IsValid())
+	assert.False(t, domain.StatsGranularity("").IsValid())
+}
@@ -0,0 +1,58 @@
+package domain_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/CodeWarrior-debug/perspectize/backend/internal/core/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func intPtr(n int) *int { return &n }
+
+func TestIntRange_Validate(t *testing.T) {
+	var unset *domain.IntRange
+	assert.NoError(t, unset.Validate("quality"))
+	assert.NoError(t, (&domain.IntRange{Min: intPtr(5)}).Validate("quality"))
+	assert.NoError(t, (&domain.IntRange{Min: intPtr(5), Max: intPtr(5)}).Validate("quality"))
+
+	err := (&domain.IntRange{Min: intPtr(6), Max: intPtr(5)}).Validate("quality")
+	assert.ErrorIs(t, err, domain.ErrInvalidInput)
+	assert.Contains(t, err.Error(), "quality min must not exceed max")
+}
+
+func TestIntRange_ValidateRating(t *testing.T) {
+	assert.NoError(t, (&domain.IntRange{Min: intPtr(0), Max: intPtr(10000)}).ValidateRating("agreement"))
+
+	for _, r := range []domain.IntRange{
+		{Min: intPtr(-1)},
+		{Max: intPtr(10001)},
+		{Min: intPtr(9000), Max: intPtr(100)},
+	} {
+		assert.ErrorIs(t, r.ValidateRating("agreement"), domain.ErrInvalidInput)
+	}
+}
+
+func TestTimeRange_Validate(t *testing.T) {
+	jan := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	feb := time.Date(2026, 2, 1, 0, 0, 0, 0, time.UTC)
+
+	assert.NoError(t, (&domain.TimeRange{From: &jan, To: &feb}).Validate("createdAt"))
+	assert.NoError(t, (&domain.TimeRange{To: &jan}).Validate("createdAt"))
+	assert.ErrorIs(t, (&domain.TimeRange{From: &jan, To: &jan}).Validate("createdAt"), domain.ErrInvalidInput)
+	assert.ErrorIs(t, (&domain.TimeRange{From: &feb, To: &jan}).Validate("createdAt"), domain.ErrInvalidInput)
+}
+
+func TestValidateFilterValues(t *testing.T) {
+	got, err := domain.ValidateFilterValues("labels", []string{" health ", "science"})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"health", "science"}, got)
+
+	_, err = domain.ValidateFilterValues("labels", []string{"health", "  "})
+	assert.ErrorIs(t, err, domain.ErrInvalidInput)
+
+	_, err = domain.ValidateFilterValues("labels", strings.Fields(strings.Repeat("x ", domain.MaxFilterValues+1)))
+	assert.ErrorIs(t, err, domain.ErrInvalidInput)
+}
@@ -62,6 +62,14 @@ func TestReviewStatusConstants(t *testing.T) {
 	assert.Equal(t, domain.ReviewStatus("REJECTED"), domain.ReviewStatusRejected)
 }
 
+func TestReviewStatus_IsValid(t *testing.T) {
+	assert.True(t, domain.ReviewStatusPending.IsValid())
+	assert.True(t, domain.ReviewStatusApproved.IsValid())
+	assert.True(t, domain.ReviewStatusRejected.IsValid())
+	assert.False(t, domain.ReviewStatus("pending").IsValid())
+	assert.False(t, domain.ReviewStatus("").IsValid())
+}
+
 func TestRatingConstants(t *testing.T) {
 	assert.Equal(t, 0, domain.RatingMin)
 	assert.Equal(t, 10000, domain.RatingMax)
@@ -80,6 +88,13 @@ func TestCategorizedRating(t *testing.T) {
 func TestPerspectiveSortByConstants(t *testing.T) {
 	assert.Equal(t, domain.PerspectiveSortBy("CREATED_AT"), domain.PerspectiveSortByCreatedAt)
 	assert.Equal(t, domain.PerspectiveSortBy("UPDATED_AT"), domain.PerspectiveSortByUpdatedAt)
+	assert.Equal(t, domain.PerspectiveSortBy("RELEVANCE"), domain.PerspectiveSortByRelevance)
+	assert.Equal(t, domain.PerspectiveSortBy("QUALITY"), domain.PerspectiveSortByQuality)
+	assert.Equal(t, domain.PerspectiveSortBy("AGREEMENT"), domain.PerspectiveSortByAgreement)
+	assert.Equal(t, domain.PerspectiveSortBy("IMPORTANCE"), domain.PerspectiveSortByImportance)
+	assert.Equal(t, domain.PerspectiveSortBy("CONFIDENCE"), domain.PerspectiveSortByConfidence)
+	assert.Equal(t, domain.PerspectiveSortBy("CATEGORIZED_RATING"), domain.PerspectiveSortByCategorizedRating)
+	assert.Equal(t, domain.PerspectiveSortBy("CONTENT_NAME"), domain.PerspectiveSortByContentName)
 }
 
 func TestPerspectiveWithCategorizedRatings(t *testing.T) {
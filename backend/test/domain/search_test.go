@@ -0,0 +1,100 @@
+package domain_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/CodeWarrior-debug/perspectize/backend/internal/core/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseSearchQuery(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		want  []domain.SearchTerm
+	}{
+		{
+			name:  "words",
+			input: "Climate  Policy",
+			want:  []domain.SearchTerm{{Words: []string{"climate"}}, {Words: []string{"policy"}}},
+		},
+		{
+			name:  "phrase",
+			input: `"carbon tax" debate`,
+			want:  []domain.SearchTerm{{Words: []string{"carbon", "tax"}}, {Words: []string{"debate"}}},
+		},
+		{
+			name:  "prefix",
+			input: `clim* "net zer*"`,
+			want:  []domain.SearchTerm{{Words: []string{"clim"}, Prefix: true}, {Words: []string{"net", "zer"}, Prefix: true}},
+		},
+		{
+			name:  "exclusion",
+			input: `energy -nuclear -"fossil fuel"`,
+			want: []domain.SearchTerm{
+				{Words: []string{"energy"}},
+				{Words: []string{"nuclear"}, Exclude: true},
+				{Words: []string{"fossil", "fuel"}, Exclude: true},
+			},
+		},
+		{
+			name:  "punctuation splits words",
+			input: "state-of-the-art",
+			want:  []domain.SearchTerm{{Words: []string{"state", "of", "the", "art"}}},
+		},
+		{
+			name:  "unclosed quote runs to the end",
+			input: `"sea level rise`,
+			want:  []domain.SearchTerm{{Words: []string{"sea", "level", "rise"}}},
+		},
+		{
+			name:  "unicode letters",
+			input: "Café Müller",
+			want:  []domain.SearchTerm{{Words: []string{"café"}}, {Words: []string{"müller"}}},
+		},
+		{
+			name:  "tsquery operators are stripped",
+			input: "a&b | !c:*",
+			want:  []domain.SearchTerm{{Words: []string{"a", "b"}}, {Words: []string{"c"}, Prefix: true}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			q, err := domain.ParseSearchQuery(tt.input)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, q.Terms)
+		})
+	}
+}
+
+func TestParseSearchQuery_Invalid(t *testing.T) {
+	for _, input := range []string{"", "   ", `"" - *`, strings.Repeat("word ", domain.MaxSearchTerms+1)} {
+		_, err := domain.ParseSearchQuery(input)
+		assert.ErrorIs(t, err, domain.ErrInvalidInput, "input %q", input)
+	}
+}
+
+func TestValidateFuzzyQuery(t *testing.T) {
+	got, err := domain.ValidateFuzzyQuery("  climat chnage  ")
+	require.NoError(t, err)
+	assert.Equal(t, "climat chnage", got)
+
+	// Length is counted in characters, not bytes
+	_, err = domain.ValidateFuzzyQuery(strings.Repeat("é", domain.MaxFuzzyQueryLength))
+	assert.NoError(t, err)
+
+	for _, input := range []string{"", "   ", strings.Repeat("a", domain.MaxFuzzyQueryLength+1)} {
+		_, err := domain.ValidateFuzzyQuery(input)
+		assert.ErrorIs(t, err, domain.ErrInvalidInput, "input %q", input)
+	}
+}
+
+func TestSearchMode_IsValid(t *testing.T) {
+	assert.True(t, domain.SearchModeFullText.IsValid())
+	assert.True(t, domain.SearchModeFuzzy.IsValid())
+	assert.False(t, domain.SearchMode("").IsValid())
+	assert.False(t, domain.SearchMode("REGEX").IsValid())
+}
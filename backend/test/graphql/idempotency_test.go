@@ -0,0 +1,176 @@
+package graphql_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/CodeWarrior-debug/perspectize/backend/internal/adapters/graphql/idempotency"
+	"github.com/CodeWarrior-debug/perspectize/backend/internal/core/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/vektah/gqlparser/v2/ast"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+)
+
+// memoryIdempotencyRepo is an in-memory IdempotencyRepository
+type memoryIdempotencyRepo struct {
+	mu      sync.Mutex
+	records map[string]*domain.IdempotencyRecord
+}
+
+func newMemoryIdempotencyRepo() *memoryIdempotencyRepo {
+	return &memoryIdempotencyRepo{records: make(map[string]*domain.IdempotencyRecord)}
+}
+
+func (m *memoryIdempotencyRepo) Reserve(ctx context.Context, key, requestHash string, expiresBefore, pendingBefore time.Time) (*domain.IdempotencyRecord, bool, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if r, ok := m.records[key]; ok {
+		stale := r.CreatedAt.Before(expiresBefore) || (r.Response == nil && r.CreatedAt.Before(pendingBefore))
+		if !stale {
+			return r, false, nil
+		}
+	}
+	m.records[key] = &domain.IdempotencyRecord{Key: key, RequestHash: requestHash, CreatedAt: time.Now()}
+	return nil, true, nil
+}
+
+func (m *memoryIdempotencyRepo) Complete(ctx context.Context, key string, response json.RawMessage) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.records[key].Response = response
+	return nil
+}
+
+func (m *memoryIdempotencyRepo) Release(ctx context.Context, key string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	delete(m.records, key)
+	return nil
+}
+
+// runOperation sends one operation through the middleware and counts how
+// often the wrapped handler executes
+func runOperation(mw graphql.OperationMiddleware, op ast.Operation, key string, variables map[string]any, calls *int, resp *graphql.Response) *graphql.Response {
+	headers := http.Header{}
+	if key != "" {
+		headers.Set(idempotency.KeyHeader, key)
+	}
+	ctx := graphql.WithOperationContext(context.Background(), &graphql.OperationContext{
+		RawQuery:  "mutation M($url: String!) { createContentFromYouTube(input: {url: $url}) { created } }",
+		Variables: variables,
+		Headers:   headers,
+		Operation: &ast.OperationDefinition{Operation: op},
+	})
+
+	next := func(ctx context.Context) graphql.ResponseHandler {
+		*calls++
+		return graphql.OneShot(resp)
+	}
+	return mw(ctx, next)(ctx)
+}
+
+func TestIdempotency_ReplaysStoredResponse(t *testing.T) {
+	mw := idempotency.Middleware(newMemoryIdempotencyRepo(), time.Hour, time.Minute)
+	vars := map[string]any{"url": "https://youtu.be/dQw4w9WgXcQ"}
+	ok := &graphql.Response{Data: json.RawMessage(`{"createContentFromYouTube":{"created":true}}`)}
+
+	calls := 0
+	first := runOperation(mw, ast.Mutation, "key-1", vars, &calls, ok)
+	second := runOperation(mw, ast.Mutation, "key-1", vars, &calls, ok)
+
+	assert.Equal(t, 1, calls, "retry must not run the mutation again")
+	assert.JSONEq(t, string(first.Data), string(second.Data))
+}
+
+func TestIdempotency_RejectsKeyReuseForDifferentRequest(t *testing.T) {
+	mw := idempotency.Middleware(newMemoryIdempotencyRepo(), time.Hour, time.Minute)
+	ok := &graphql.Response{Data: json.RawMessage(`{}`)}
+
+	calls := 0
+	runOperation(mw, ast.Mutation, "key-1", map[string]any{"url": "a"}, &calls, ok)
+	resp := runOperation(mw, ast.Mutation, "key-1", map[string]any{"url": "b"}, &calls, ok)
+
+	assert.Equal(t, 1, calls)
+	require.Len(t, resp.Errors, 1)
+	assert.Contains(t, resp.Errors[0].Message, "already used for a different request")
+}
+
+func TestIdempotency_ErrorsAreNotStored(t *testing.T) {
+	mw := idempotency.Middleware(newMemoryIdempotencyRepo(), time.Hour, time.Minute)
+	failed := &graphql.Response{Errors: gqlerror.List{{Message: "failed to create content"}}}
+	vars := map[string]any{"url": "a"}
+
+	calls := 0
+	runOperation(mw, ast.Mutation, "key-1", vars, &calls, failed)
+	runOperation(mw, ast.Mutation, "key-1", vars, &calls, failed)
+
+	assert.Equal(t, 2, calls, "a failed request can be retried with the same key")
+}
+
+func TestIdempotency_ExpiredKeysRunAgain(t *testing.T) {
+	mw := idempotency.Middleware(newMemoryIdempotencyRepo(), -time.Second, time.Minute)
+	ok := &graphql.Response{Data: json.RawMessage(`{}`)}
+	vars := map[string]any{"url": "a"}
+
+	calls := 0
+	runOperation(mw, ast.Mutation, "key-1", vars, &calls, ok)
+	runOperation(mw, ast.Mutation, "key-1", vars, &calls, ok)
+
+	assert.Equal(t, 2, calls)
+}
+
+func TestIdempotency_IgnoresQueriesAndMissingKeys(t *testing.T) {
+	mw := idempotency.Middleware(newMemoryIdempotencyRepo(), time.Hour, time.Minute)
+	ok := &graphql.Response{Data: json.RawMessage(`{}`)}
+	vars := map[string]any{"url": "a"}
+
+	calls := 0
+	runOperation(mw, ast.Query, "key-1", vars, &calls, ok)
+	runOperation(mw, ast.Query, "key-1", vars, &calls, ok)
+	runOperation(mw, ast.Mutation, "", vars, &calls, ok)
+	runOperation(mw, ast.Mutation, "", vars, &calls, ok)
+
+	assert.Equal(t, 4, calls)
+}
+
+func TestIdempotency_StalePendingKeysRunAgain(t *testing.T) {
+	repo := newMemoryIdempotencyRepo()
+	mw := idempotency.Middleware(repo, 24*time.Hour, 5*time.Minute)
+	ok := &graphql.Response{Data: json.RawMessage(`{}`)}
+	vars := map[string]any{"url": "a"}
+
+	// A request that reserved its key and then died without completing
+	calls := 0
+	crashed := func(ctx context.Context) graphql.ResponseHandler {
+		calls++
+		return func(ctx context.Context) *graphql.Response { panic("server crashed") }
+	}
+	ctx := graphql.WithOperationContext(context.Background(), &graphql.OperationContext{
+		RawQuery:  "mutation M($url: String!) { createContentFromYouTube(input: {url: $url}) { created } }",
+		Variables: vars,
+		Headers:   http.Header{idempotency.KeyHeader: {"key-1"}},
+		Operation: &ast.OperationDefinition{Operation: ast.Mutation},
+	})
+	mw(ctx, crashed)
+	require.NotNil(t, repo.records["key-1"])
+
+	resp := runOperation(mw, ast.Mutation, "key-1", vars, &calls, ok)
+	require.Len(t, resp.Errors, 1)
+	assert.Contains(t, resp.Errors[0].Message, "still in progress", "the lease has not run out")
+
+	repo.records["key-1"].CreatedAt = time.Now().Add(-6 * time.Minute)
+	resp = runOperation(mw, ast.Mutation, "key-1", vars, &calls, ok)
+	assert.Empty(t, resp.Errors)
+	assert.Equal(t, 2, calls, "the stale reservation is taken over")
+
+	// A stored response outlives the lease
+	repo.records["key-1"].CreatedAt = time.Now().Add(-time.Hour)
+	runOperation(mw, ast.Mutation, "key-1", vars, &calls, ok)
+	assert.Equal(t, 2, calls)
+}
@@ -0,0 +1,63 @@
+package repositories_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/CodeWarrior-debug/perspectize/backend/internal/adapters/repositories/postgres"
+	"github.com/CodeWarrior-debug/perspectize/backend/internal/core/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestContentCreate_UniqueViolationsAreAlreadyExists(t *testing.T) {
+	db := connectTestDB(t)
+	ctx := context.Background()
+
+	tx := db.Begin()
+	require.NoError(t, tx.Error)
+	defer tx.Rollback()
+
+	suffix := time.Now().UnixNano()
+	user, err := postgres.NewGormUserRepository(tx).Create(ctx, &domain.User{
+		Username: fmt.Sprintf("create-test-%d", suffix),
+		Email:    fmt.Sprintf("create-test-%d@example.com", suffix),
+		Active:   true,
+	})
+	require.NoError(t, err)
+
+	repo := postgres.NewGormContentRepository(tx)
+	content := func(name, url, key string) *domain.Content {
+		return &domain.Content{
+			Name:          fmt.Sprintf("%s %d", name, suffix),
+			URL:           &url,
+			CanonicalKey:  &key,
+			ContentType:   domain.ContentTypeYouTube,
+			AddedByUserID: user.ID,
+		}
+	}
+	url := fmt.Sprintf("https://www.youtube.com/watch?v=create%d", suffix)
+	key := fmt.Sprintf("youtube:create%d", suffix)
+	_, err = repo.Create(ctx, content("Original", url, key))
+	require.NoError(t, err)
+
+	tests := []struct {
+		name    string
+		content *domain.Content
+	}{
+		{"same canonical key", content("Other", url+"&t=1", key)},
+		{"same URL", content("Other", url, key+"x")},
+		{"same name", content("Original", url+"&t=2", key+"y")},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// A failed statement aborts the transaction, so each case gets a savepoint
+			require.NoError(t, tx.SavePoint("create").Error)
+			_, err := repo.Create(ctx, tt.content)
+			require.NoError(t, tx.RollbackTo("create").Error)
+			assert.ErrorIs(t, err, domain.ErrAlreadyExists)
+		})
+	}
+}
@@ -0,0 +1,309 @@
+package repositories_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/CodeWarrior-debug/perspectize/backend/internal/adapters/repositories/postgres"
+	"github.com/CodeWarrior-debug/perspectize/backend/internal/core/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+)
+
+// contentFilterFixtures holds the IDs seeded by seedContentFilterFixtures
+type contentFilterFixtures struct {
+	adderID    int // Added every content row
+	reviewerID int // Wrote a perspective on content[0] only
+	content    [4]int
+}
+
+// seedContentFilterFixtures creates content in tx covering every filter,
+// including rows whose metadata is NULL
+func seedContentFilterFixtures(t *testing.T, ctx context.Context, tx *gorm.DB) contentFilterFixtures {
+	t.Helper()
+	suffix := time.Now().UnixNano()
+
+	userRepo := postgres.NewGormUserRepository(tx)
+	var users [2]int
+	for i := range users {
+		user, err := userRepo.Create(ctx, &domain.User{
+			Username: fmt.Sprintf("filter-test-%d-%d", suffix, i),
+			Email:    fmt.Sprintf("filter-test-%d-%d@example.com", suffix, i),
+			Active:   true,
+		})
+		require.NoError(t, err)
+		users[i] = user.ID
+	}
+	fixtures := contentFilterFixtures{adderID: users[0], reviewerID: users[1]}
+
+	str := func(s string) *string { return &s }
+	count := func(v int64) *int64 { return &v }
+	date := func(s string) *time.Time {
+		d, err := time.Parse(time.DateOnly, s)
+		require.NoError(t, err)
+		return &d
+	}
+	seconds := func(v int) *int { return &v }
+	rows := []domain.Content{
+		{Name: "Filter Alpha", ChannelTitle: str("Chan A"), Tags: []string{"go", "db"}, ViewCount: count(100), PublishedAt: date("2024-01-10"), Length: seconds(100)},
+		{Name: "Filter Bravo", ChannelTitle: str("Chan B"), Tags: []string{"go"}, ViewCount: count(5000), PublishedAt: date("2025-06-01"), Length: seconds(600)},
+		{Name: "Filter Charlie"},
+		{Name: "Filter Delta", ChannelTitle: str("Chan A"), Tags: []string{"db", "sql"}, ViewCount: count(20000), PublishedAt: date("2025-12-01"), Length: seconds(3600)},
+	}
+
+	contentRepo := postgres.NewGormContentRepository(tx)
+	for i, row := range rows {
+		row.Name = fmt.Sprintf("%s %d", row.Name, suffix)
+		row.URL = str(fmt.Sprintf("https://example.com/filter-test/%d/%d", suffix, i))
+		row.ContentType = domain.ContentTypeYouTube
+		row.AddedByUserID = fixtures.adderID
+		c, err := contentRepo.Create(ctx, &row)
+		require.NoError(t, err)
+		fixtures.content[i] = c.ID
+	}
+
+	perspectiveRepo := postgres.NewGormPerspectiveRepository(tx)
+	for _, p := range []struct{ user, content int }{
+		{fixtures.reviewerID, 0},
+		{fixtures.adderID, 0},
+		{fixtures.adderID, 3},
+	} {
+		_, err := perspectiveRepo.Create(ctx, &domain.Perspective{
+			UserID:    p.user,
+			ContentID: &fixtures.content[p.content],
+			Privacy:   domain.PrivacyPrivate,
+		})
+		require.NoError(t, err)
+	}
+	return fixtures
+}
+
+func TestContentList_FiltersHoldUnderEverySort(t *testing.T) {
+	db := connectTestDB(t)
+	ctx := context.Background()
+
+	tx := db.Begin()
+	require.NoError(t, tx.Error)
+	defer tx.Rollback()
+
+	fx := seedContentFilterFixtures(t, ctx, tx)
+	repo := postgres.NewGormContentRepository(tx)
+
+	intPtr := func(v int) *int { return &v }
+	int64Ptr := func(v int64) *int64 { return &v }
+	timePtr := func(v time.Time) *time.Time { return &v }
+	cutoff := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name   string
+		filter domain.ContentFilter
+		want   []int // Indexes into fx.content
+	}{
+		{name: "published from", filter: domain.ContentFilter{PublishedAt: &domain.TimeRange{From: &cutoff}}, want: []int{1, 3}},
+		{name: "published to", filter: domain.ContentFilter{PublishedAt: &domain.TimeRange{To: &cutoff}}, want: []int{0}},
+		{name: "created from", filter: domain.ContentFilter{CreatedAt: &domain.TimeRange{From: timePtr(time.Now().Add(-24 * time.Hour))}}, want: []int{0, 1, 2, 3}},
+		{name: "one channel", filter: domain.ContentFilter{ChannelTitles: []string{"Chan A"}}, want: []int{0, 3}},
+		{name: "several channels", filter: domain.ContentFilter{ChannelTitles: []string{"Chan A", "Chan B"}}, want: []int{0, 1, 3}},
+		{name: "any tag", filter: domain.ContentFilter{TagsAny: []string{"sql", "rust"}}, want: []int{3}},
+		{name: "all tags", filter: domain.ContentFilter{TagsAll: []string{"go", "db"}}, want: []int{0}},
+		{name: "min view count", filter: domain.ContentFilter{MinViewCount: int64Ptr(5000)}, want: []int{1, 3}},
+		{name: "at least one perspective", filter: domain.ContentFilter{MinPerspectives: intPtr(1)}, want: []int{0, 3}},
+		{name: "at least two perspectives", filter: domain.ContentFilter{MinPerspectives: intPtr(2)}, want: []int{0}},
+		{name: "none from user", filter: domain.ContentFilter{WithoutPerspectivesFromUserID: &fx.reviewerID}, want: []int{1, 2, 3}},
+		{
+			name: "combined",
+			filter: domain.ContentFilter{
+				TagsAny:                       []string{"db"},
+				MinPerspectives:               intPtr(1),
+				WithoutPerspectivesFromUserID: &fx.reviewerID,
+			},
+			want: []int{3},
+		},
+	}
+
+	sorts := []domain.ContentSortBy{
+		domain.ContentSortByCreatedAt,
+		domain.ContentSortByUpdatedAt,
+		domain.ContentSortByName,
+		domain.ContentSortByViewCount,
+		domain.ContentSortByLikeCount,
+		domain.ContentSortByPublishedAt,
+		domain.ContentSortByRelevance,
+	}
+	for _, tt := range tests {
+		want := make([]int, len(tt.want))
+		for i, idx := range tt.want {
+			want[i] = fx.content[idx]
+		}
+
+		for _, sortBy := range sorts {
+			for _, order := range []domain.SortOrder{domain.SortOrderAsc, domain.SortOrderDesc} {
+				t.Run(fmt.Sprintf("%s/%s_%s", tt.name, sortBy, order), func(t *testing.T) {
+					filter := tt.filter
+					filter.AddedByUserID = &fx.adderID
+					if sortBy == domain.ContentSortByRelevance {
+						search := "filter"
+						filter.Search = &search
+					}
+					pageSize := 2
+					params := domain.ContentListParams{
+						First:             &pageSize,
+						SortBy:            sortBy,
+						SortOrder:         order,
+						IncludeTotalCount: true,
+						Filter:            &filter,
+					}
+
+					var got []int
+					for page := 0; page <= len(fx.content); page++ {
+						result, err := repo.List(ctx, params)
+						require.NoError(t, err)
+						require.NotNil(t, result.TotalCount)
+						assert.Equal(t, len(want), *result.TotalCount)
+						for _, item := range result.Items {
+							got = append(got, item.ID)
+						}
+						if !result.HasNext {
+							break
+						}
+						params.After = result.EndCursor
+					}
+
+					assert.ElementsMatch(t, want, got)
+				})
+			}
+		}
+	}
+}
+
+func TestContentList_MissingSortKeysSortLast(t *testing.T) {
+	db := connectTestDB(t)
+	ctx := context.Background()
+
+	tx := db.Begin()
+	require.NoError(t, tx.Error)
+	defer tx.Rollback()
+
+	fx := seedContentFilterFixtures(t, ctx, tx)
+	repo := postgres.NewGormContentRepository(tx)
+
+	// View counts and publish dates rise with 0, 1, 3; 2 has neither
+	tests := []struct {
+		order domain.SortOrder
+		want  []int // Indexes into fx.content
+	}{
+		{order: domain.SortOrderAsc, want: []int{0, 1, 3, 2}},
+		{order: domain.SortOrderDesc, want: []int{3, 1, 0, 2}},
+	}
+
+	for _, sortBy := range []domain.ContentSortBy{domain.ContentSortByViewCount, domain.ContentSortByPublishedAt} {
+		for _, tt := range tests {
+			t.Run(fmt.Sprintf("%s_%s", sortBy, tt.order), func(t *testing.T) {
+				pageSize := 1
+				params := domain.ContentListParams{
+					First:     &pageSize,
+					SortBy:    sortBy,
+					SortOrder: tt.order,
+					Filter:    &domain.ContentFilter{AddedByUserID: &fx.adderID},
+				}
+
+				var got []int
+				for page := 0; page <= len(fx.content); page++ {
+					result, err := repo.List(ctx, params)
+					require.NoError(t, err)
+					for _, item := range result.Items {
+						got = append(got, item.ID)
+					}
+					if !result.HasNext {
+						break
+					}
+					params.After = result.EndCursor
+				}
+
+				want := make([]int, len(tt.want))
+				for i, idx := range tt.want {
+					want[i] = fx.content[idx]
+				}
+				assert.Equal(t, want, got)
+			})
+		}
+	}
+}
+
+func TestContentFacets_CountWhatListReturns(t *testing.T) {
+	db := connectTestDB(t)
+	ctx := context.Background()
+
+	tx := db.Begin()
+	require.NoError(t, tx.Error)
+	defer tx.Rollback()
+
+	fx := seedContentFilterFixtures(t, ctx, tx)
+	repo := postgres.NewGormContentRepository(tx)
+	allFacets := []domain.ContentFacet{
+		domain.ContentFacetContentType,
+		domain.ContentFacetChannel,
+		domain.ContentFacetTag,
+		domain.ContentFacetLengthBucket,
+		domain.ContentFacetPublishYear,
+	}
+	search := "filter"
+
+	tests := []struct {
+		name   string
+		filter domain.ContentFilter
+		want   map[domain.ContentFacet][]domain.FacetValue
+	}{
+		{
+			name:   "all of the user's content",
+			filter: domain.ContentFilter{},
+			want: map[domain.ContentFacet][]domain.FacetValue{
+				domain.ContentFacetContentType:  {{Value: "YOUTUBE", Count: 4}},
+				domain.ContentFacetChannel:      {{Value: "Chan A", Count: 2}, {Value: "Chan B", Count: 1}},
+				domain.ContentFacetTag:          {{Value: "db", Count: 2}, {Value: "go", Count: 2}, {Value: "sql", Count: 1}},
+				domain.ContentFacetLengthBucket: {{Value: "LONG", Count: 1}, {Value: "MEDIUM", Count: 1}, {Value: "SHORT", Count: 1}},
+				domain.ContentFacetPublishYear:  {{Value: "2025", Count: 2}, {Value: "2024", Count: 1}},
+			},
+		},
+		{
+			name:   "filtered and searched",
+			filter: domain.ContentFilter{TagsAny: []string{"db"}, Search: &search},
+			want: map[domain.ContentFacet][]domain.FacetValue{
+				domain.ContentFacetContentType:  {{Value: "YOUTUBE", Count: 2}},
+				domain.ContentFacetChannel:      {{Value: "Chan A", Count: 2}},
+				domain.ContentFacetTag:          {{Value: "db", Count: 2}, {Value: "go", Count: 1}, {Value: "sql", Count: 1}},
+				domain.ContentFacetLengthBucket: {{Value: "LONG", Count: 1}, {Value: "SHORT", Count: 1}},
+				domain.ContentFacetPublishYear:  {{Value: "2024", Count: 1}, {Value: "2025", Count: 1}},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			filter := tt.filter
+			filter.AddedByUserID = &fx.adderID
+
+			counts, err := repo.Facets(ctx, domain.ContentFacetParams{Filter: &filter, Facets: allFacets})
+			require.NoError(t, err)
+			require.Len(t, counts, len(allFacets))
+			for i, c := range counts {
+				assert.Equal(t, allFacets[i], c.Facet)
+				assert.Equal(t, tt.want[c.Facet], c.Values, "facet %s", c.Facet)
+			}
+
+			// The content type facet covers every row, so it must sum to List's total
+			listed, err := repo.List(ctx, domain.ContentListParams{
+				SortBy:            domain.ContentSortByCreatedAt,
+				SortOrder:         domain.SortOrderDesc,
+				IncludeTotalCount: true,
+				Filter:            &filter,
+			})
+			require.NoError(t, err)
+			require.NotNil(t, listed.TotalCount)
+			assert.Equal(t, *listed.TotalCount, counts[0].Values[0].Count)
+		})
+	}
+}
@@ -0,0 +1,94 @@
+package repositories_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/CodeWarrior-debug/perspectize/backend/internal/adapters/repositories/postgres"
+	"github.com/CodeWarrior-debug/perspectize/backend/internal/core/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// contentIDs returns the IDs of items in order
+func contentIDs(items []*domain.Content) []int {
+	ids := make([]int, len(items))
+	for i, item := range items {
+		ids[i] = item.ID
+	}
+	return ids
+}
+
+func TestContentListPage_MatchesCursorListing(t *testing.T) {
+	db := connectTestDB(t)
+	ctx := context.Background()
+
+	tx := db.Begin()
+	require.NoError(t, tx.Error)
+	defer tx.Rollback()
+
+	fx := seedContentFilterFixtures(t, ctx, tx)
+	repo := postgres.NewGormContentRepository(tx)
+	search := "filter"
+	filter := &domain.ContentFilter{AddedByUserID: &fx.adderID, Search: &search}
+
+	sorts := []domain.ContentSortBy{
+		domain.ContentSortByCreatedAt,
+		domain.ContentSortByName,
+		domain.ContentSortByViewCount,
+		domain.ContentSortByPublishedAt,
+		domain.ContentSortByRelevance,
+	}
+	for _, sortBy := range sorts {
+		for _, order := range []domain.SortOrder{domain.SortOrderAsc, domain.SortOrderDesc} {
+			all := len(fx.content)
+			listed, err := repo.List(ctx, domain.ContentListParams{First: &all, SortBy: sortBy, SortOrder: order, Filter: filter})
+			require.NoError(t, err)
+			want := contentIDs(listed.Items)
+			require.Len(t, want, len(fx.content))
+
+			for _, pageSize := range []int{1, 3} {
+				t.Run(fmt.Sprintf("%s_%s_by_%d", sortBy, order, pageSize), func(t *testing.T) {
+					var got []int
+					totalPages := (len(want) + pageSize - 1) / pageSize
+					for page := 1; page <= totalPages+1; page++ {
+						result, err := repo.ListPage(ctx, domain.ContentPageParams{
+							Page: page, PageSize: pageSize, SortBy: sortBy, SortOrder: order, Filter: filter,
+						})
+						require.NoError(t, err)
+						assert.Equal(t, len(want), result.TotalCount)
+						assert.Equal(t, totalPages, result.TotalPages)
+
+						if page > totalPages {
+							assert.Empty(t, result.Items)
+							assert.Nil(t, result.EndCursor)
+							continue
+						}
+						ids := contentIDs(result.Items)
+						got = append(got, ids...)
+
+						// Switching to cursors from either boundary lands where the pages do
+						if page == 1 {
+							assert.Nil(t, result.StartCursor)
+						} else {
+							require.NotNil(t, result.StartCursor)
+							resumed, err := repo.List(ctx, domain.ContentListParams{
+								First: &pageSize, After: result.StartCursor, SortBy: sortBy, SortOrder: order, Filter: filter,
+							})
+							require.NoError(t, err)
+							assert.Equal(t, ids, contentIDs(resumed.Items))
+						}
+						require.NotNil(t, result.EndCursor)
+						next, err := repo.List(ctx, domain.ContentListParams{
+							First: &all, After: result.EndCursor, SortBy: sortBy, SortOrder: order, Filter: filter,
+						})
+						require.NoError(t, err)
+						assert.Equal(t, want[len(got):], contentIDs(next.Items))
+					}
+					assert.Equal(t, want, got)
+				})
+			}
+		}
+	}
+}
@@ -0,0 +1,77 @@
+package repositories_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/CodeWarrior-debug/perspectize/backend/internal/adapters/repositories/postgres"
+	"github.com/CodeWarrior-debug/perspectize/backend/internal/core/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestContentListRefreshDue_SkipsFailedAndUnavailableContent(t *testing.T) {
+	db := connectTestDB(t)
+	ctx := context.Background()
+
+	tx := db.Begin()
+	require.NoError(t, tx.Error)
+	defer tx.Rollback()
+
+	suffix := time.Now().UnixNano()
+	user, err := postgres.NewGormUserRepository(tx).Create(ctx, &domain.User{
+		Username: fmt.Sprintf("refresh-test-%d", suffix),
+		Email:    fmt.Sprintf("refresh-test-%d@example.com", suffix),
+		Active:   true,
+	})
+	require.NoError(t, err)
+
+	// Updated long before anything else in the database, a day apart
+	epoch := time.Date(2001, 1, 1, 0, 0, 0, 0, time.UTC)
+	repo := postgres.NewGormContentRepository(tx)
+	var ids []int
+	for i := range 3 {
+		url := fmt.Sprintf("https://www.youtube.com/watch?v=refresh%d%d", suffix, i)
+		c, err := repo.Create(ctx, &domain.Content{
+			Name:          fmt.Sprintf("Refresh %d %d", suffix, i),
+			URL:           &url,
+			ContentType:   domain.ContentTypeYouTube,
+			AddedByUserID: user.ID,
+		})
+		require.NoError(t, err)
+		require.NoError(t, tx.Exec("UPDATE content SET updated_at = ? WHERE id = ?", epoch.AddDate(0, 0, i), c.ID).Error)
+		ids = append(ids, c.ID)
+	}
+	due := func(before time.Time) []int {
+		contents, err := repo.ListRefreshDue(ctx, before, 10)
+		require.NoError(t, err)
+		var got []int
+		for _, c := range contents {
+			got = append(got, c.ID)
+		}
+		return got
+	}
+	require.Equal(t, ids, due(epoch.AddDate(0, 0, 10)))
+
+	// A failed attempt sends the row to the back of the queue
+	require.NoError(t, repo.MarkRefreshFailed(ctx, []int{ids[0]}, epoch.AddDate(0, 0, 5)))
+	assert.Equal(t, []int{ids[1], ids[2], ids[0]}, due(epoch.AddDate(0, 0, 10)))
+	assert.Equal(t, []int{ids[1], ids[2]}, due(epoch.AddDate(0, 0, 4)))
+
+	// Unavailable content is not due at all, and marking it is not an update
+	require.NoError(t, repo.MarkUnavailable(ctx, []int{ids[1]}, epoch.AddDate(0, 0, 6)))
+	assert.Equal(t, []int{ids[2], ids[0]}, due(epoch.AddDate(0, 0, 10)))
+	unavailable, err := repo.GetByID(ctx, ids[1])
+	require.NoError(t, err)
+	require.NotNil(t, unavailable.UnavailableAt)
+	assert.True(t, unavailable.UnavailableAt.Equal(epoch.AddDate(0, 0, 6)))
+	assert.True(t, unavailable.UpdatedAt.Equal(epoch.AddDate(0, 0, 1)))
+
+	// Saving fetched metadata makes it available again
+	unavailable.UnavailableAt = nil
+	updated, err := repo.Update(ctx, unavailable)
+	require.NoError(t, err)
+	assert.Nil(t, updated.UnavailableAt)
+}
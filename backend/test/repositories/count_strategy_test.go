@@ -0,0 +1,90 @@
+package repositories_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/CodeWarrior-debug/perspectize/backend/internal/adapters/repositories/postgres"
+	"github.com/CodeWarrior-debug/perspectize/backend/internal/core/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestContentList_CountStrategies(t *testing.T) {
+	db := connectTestDB(t)
+	ctx := context.Background()
+
+	tx := db.Begin()
+	require.NoError(t, tx.Error)
+	defer tx.Rollback()
+
+	fx := seedContentFilterFixtures(t, ctx, tx)
+	repo := postgres.NewGormContentRepository(tx)
+	filter := &domain.ContentFilter{AddedByUserID: &fx.adderID}
+	count := func(strategy domain.CountStrategy) (int, bool) {
+		t.Helper()
+		result, err := repo.List(ctx, domain.ContentListParams{
+			SortBy:            domain.ContentSortByCreatedAt,
+			SortOrder:         domain.SortOrderDesc,
+			IncludeTotalCount: true,
+			CountStrategy:     strategy,
+			Filter:            filter,
+		})
+		require.NoError(t, err)
+		require.NotNil(t, result.TotalCount)
+		return *result.TotalCount, result.TotalCountExact
+	}
+
+	n, exact := count(domain.CountStrategyExact)
+	assert.Equal(t, 4, n)
+	assert.True(t, exact)
+
+	// Four rows is far below the estimate cutoff, so they are counted exactly
+	n, exact = count(domain.CountStrategyEstimated)
+	assert.Equal(t, 4, n)
+	assert.True(t, exact)
+
+	n, exact = count(domain.CountStrategyCached)
+	assert.Equal(t, 4, n)
+	assert.True(t, exact, "a cache miss counts exactly")
+
+	url := fmt.Sprintf("https://example.com/count-test/%d", fx.content[0])
+	_, err := repo.Create(ctx, &domain.Content{
+		Name:          fmt.Sprintf("Count Test %d", fx.content[0]),
+		URL:           &url,
+		ContentType:   domain.ContentTypeYouTube,
+		AddedByUserID: fx.adderID,
+	})
+	require.NoError(t, err)
+
+	n, exact = count(domain.CountStrategyCached)
+	assert.Equal(t, 4, n, "a cache hit serves the stored count")
+	assert.False(t, exact)
+
+	n, exact = count(domain.CountStrategyExact)
+	assert.Equal(t, 5, n)
+	assert.True(t, exact)
+}
+
+func TestPerspectiveList_EstimatedCount(t *testing.T) {
+	db := connectTestDB(t)
+	ctx := context.Background()
+
+	tx := db.Begin()
+	require.NoError(t, tx.Error)
+	defer tx.Rollback()
+
+	// Unfiltered, so the planner estimate comes from table statistics;
+	// whatever it is, the EXPLAIN must run and yield a count
+	repo := postgres.NewGormPerspectiveRepository(tx)
+	result, err := repo.List(ctx, domain.PerspectiveListParams{
+		SortBy:            domain.PerspectiveSortByCreatedAt,
+		SortOrder:         domain.SortOrderDesc,
+		IncludeTotalCount: true,
+		CountStrategy:     domain.CountStrategyEstimated,
+	})
+	require.NoError(t, err)
+	require.NotNil(t, result.TotalCount)
+	assert.GreaterOrEqual(t, *result.TotalCount, 0)
+}
@@ -0,0 +1,148 @@
+package repositories_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/CodeWarrior-debug/perspectize/backend/internal/adapters/repositories/postgres"
+	"github.com/CodeWarrior-debug/perspectize/backend/internal/core/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestContentList_SignedCursors(t *testing.T) {
+	db := connectTestDB(t)
+	ctx := context.Background()
+
+	tx := db.Begin()
+	require.NoError(t, tx.Error)
+	defer tx.Rollback()
+
+	fx := seedContentFilterFixtures(t, ctx, tx)
+	oldKey, newKey := []byte("old-secret"), []byte("new-secret")
+	repo := postgres.NewGormContentRepository(tx, postgres.WithCursorKeys(oldKey))
+
+	filter := &domain.ContentFilter{AddedByUserID: &fx.adderID}
+	pageSize := 2
+	params := domain.ContentListParams{
+		First:     &pageSize,
+		SortBy:    domain.ContentSortByName,
+		SortOrder: domain.SortOrderAsc,
+		Filter:    filter,
+	}
+	first, err := repo.List(ctx, params)
+	require.NoError(t, err)
+	require.NotNil(t, first.EndCursor)
+
+	t.Run("resumes with the same sort and filter", func(t *testing.T) {
+		next := params
+		next.After = first.EndCursor
+		result, err := repo.List(ctx, next)
+		require.NoError(t, err)
+		assert.Equal(t, []int{fx.content[2], fx.content[3]}, contentIDs(result.Items))
+	})
+
+	t.Run("rotated keys still verify", func(t *testing.T) {
+		rotated := postgres.NewGormContentRepository(tx, postgres.WithCursorKeys(newKey, oldKey))
+		next := params
+		next.After = first.EndCursor
+		result, err := rotated.List(ctx, next)
+		require.NoError(t, err)
+		assert.Equal(t, []int{fx.content[2], fx.content[3]}, contentIDs(result.Items))
+	})
+
+	otherFilter := *filter
+	otherFilter.TagsAny = []string{"go"}
+	tampered := []byte(*first.EndCursor)
+	tampered[len(tampered)/2] ^= 1
+
+	tests := []struct {
+		name   string
+		repo   *postgres.GormContentRepository
+		modify func(p *domain.ContentListParams)
+		want   string
+	}{
+		{
+			name:   "tampered",
+			modify: func(p *domain.ContentListParams) { s := string(tampered); p.After = &s },
+			want:   "invalid cursor",
+		},
+		{
+			name:   "unsigned paginator cursor",
+			modify: func(p *domain.ContentListParams) { s := "WyJGaWx0ZXIgQWxwaGEiLDFd"; p.After = &s },
+			want:   "invalid cursor",
+		},
+		{
+			name:   "retired key",
+			repo:   postgres.NewGormContentRepository(tx, postgres.WithCursorKeys(newKey)),
+			modify: func(p *domain.ContentListParams) {},
+			want:   "invalid cursor",
+		},
+		{
+			name:   "other sort",
+			modify: func(p *domain.ContentListParams) { p.SortBy = domain.ContentSortByViewCount },
+			want:   "cursor was issued for sort NAME ASC, not VIEW_COUNT ASC",
+		},
+		{
+			name:   "other direction",
+			modify: func(p *domain.ContentListParams) { p.SortOrder = domain.SortOrderDesc },
+			want:   "cursor was issued for sort NAME ASC, not NAME DESC",
+		},
+		{
+			name:   "other filter",
+			modify: func(p *domain.ContentListParams) { p.Filter = &otherFilter },
+			want:   "cursor was issued for a different filter",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := repo
+			if tt.repo != nil {
+				r = tt.repo
+			}
+			next := params
+			next.After = first.EndCursor
+			tt.modify(&next)
+
+			result, err := r.List(ctx, next)
+			assert.Nil(t, result)
+			require.Error(t, err)
+			assert.ErrorIs(t, err, domain.ErrInvalidInput)
+			assert.Contains(t, err.Error(), tt.want)
+		})
+	}
+}
+
+func TestPerspectiveList_CursorBoundToSortCategory(t *testing.T) {
+	db := connectTestDB(t)
+	ctx := context.Background()
+
+	tx := db.Begin()
+	require.NoError(t, tx.Error)
+	defer tx.Rollback()
+
+	seedContentFilterFixtures(t, ctx, tx)
+	repo := postgres.NewGormPerspectiveRepository(tx)
+
+	pageSize := 1
+	params := domain.PerspectiveListParams{
+		First:        &pageSize,
+		SortBy:       domain.PerspectiveSortByCategorizedRating,
+		SortOrder:    domain.SortOrderDesc,
+		SortCategory: "clarity",
+	}
+	first, err := repo.List(ctx, params)
+	require.NoError(t, err)
+	require.NotNil(t, first.EndCursor)
+
+	params.After = first.EndCursor
+	_, err = repo.List(ctx, params)
+	require.NoError(t, err)
+
+	params.SortCategory = "accuracy"
+	_, err = repo.List(ctx, params)
+	require.Error(t, err)
+	assert.ErrorIs(t, err, domain.ErrInvalidInput)
+	assert.Contains(t, err.Error(), "cursor was issued for sort CATEGORIZED_RATING:clarity DESC")
+}
@@ -0,0 +1,28 @@
+package repositories_test
+
+import (
+	"os"
+	"testing"
+
+	"github.com/CodeWarrior-debug/perspectize/backend/pkg/database"
+	"gorm.io/gorm"
+)
+
+// connectTestDB opens the migrated test database, skipping the test when
+// PostgreSQL is not running unless REQUIRE_TEST_DB is set, as it is in CI
+func connectTestDB(t *testing.T) *gorm.DB {
+	t.Helper()
+	dsn := "host=localhost port=5432 user=testuser password=testpass dbname=testdb sslmode=disable"
+	db, err := database.ConnectGORM(dsn, database.DefaultPoolConfig())
+	if err != nil {
+		if os.Getenv("REQUIRE_TEST_DB") != "" {
+			t.Fatalf("PostgreSQL not available: %v", err)
+		}
+		t.Skip("Skipping test - PostgreSQL not available. Run 'make docker-up' and 'make migrate-up' to start database.")
+	}
+	t.Cleanup(func() {
+		sqlDB, _ := db.DB()
+		sqlDB.Close()
+	})
+	return db
+}
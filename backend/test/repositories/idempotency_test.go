@@ -0,0 +1,43 @@
+package repositories_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/CodeWarrior-debug/perspectize/backend/internal/adapters/repositories/postgres"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIdempotencyReserve_TakesOverStalePendingKeys(t *testing.T) {
+	db := connectTestDB(t)
+	ctx := context.Background()
+
+	tx := db.Begin()
+	require.NoError(t, tx.Error)
+	defer tx.Rollback()
+
+	repo := postgres.NewGormIdempotencyRepository(tx)
+	pending := fmt.Sprintf("pending-%d", time.Now().UnixNano())
+	completed := fmt.Sprintf("completed-%d", time.Now().UnixNano())
+	now := time.Now()
+	reserve := func(key string) bool {
+		_, reserved, err := repo.Reserve(ctx, key, "hash", now.Add(-24*time.Hour), now.Add(-5*time.Minute))
+		require.NoError(t, err)
+		return reserved
+	}
+
+	require.True(t, reserve(pending))
+	require.True(t, reserve(completed))
+	require.NoError(t, repo.Complete(ctx, completed, json.RawMessage(`{"data":{}}`)))
+	assert.False(t, reserve(pending), "a fresh pending key is still held")
+
+	// Both keys outlive the lease; only the one without a response is freed
+	require.NoError(t, tx.Exec("UPDATE idempotency_keys SET created_at = ? WHERE key IN ?",
+		now.Add(-time.Hour), []string{pending, completed}).Error)
+	assert.True(t, reserve(pending))
+	assert.False(t, reserve(completed))
+}
@@ -0,0 +1,65 @@
+package repositories_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/CodeWarrior-debug/perspectize/backend/internal/adapters/repositories/postgres"
+	"github.com/CodeWarrior-debug/perspectize/backend/internal/core/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPerspectiveList_LabelFiltersMatchSeveralValues(t *testing.T) {
+	db := connectTestDB(t)
+	ctx := context.Background()
+
+	tx := db.Begin()
+	require.NoError(t, tx.Error)
+	defer tx.Rollback()
+
+	suffix := time.Now().UnixNano()
+	user, err := postgres.NewGormUserRepository(tx).Create(ctx, &domain.User{
+		Username: fmt.Sprintf("label-test-%d", suffix),
+		Email:    fmt.Sprintf("label-test-%d@example.com", suffix),
+		Active:   true,
+	})
+	require.NoError(t, err)
+
+	repo := postgres.NewGormPerspectiveRepository(tx)
+	var ids []int
+	for _, labels := range [][]string{{"econ", "policy"}, {"econ"}, {"science"}} {
+		p, err := repo.Create(ctx, &domain.Perspective{UserID: user.ID, Labels: labels, Privacy: domain.PrivacyPublic})
+		require.NoError(t, err)
+		ids = append(ids, p.ID)
+	}
+
+	tests := []struct {
+		name   string
+		filter domain.PerspectiveFilter
+		want   []int
+	}{
+		{name: "any", filter: domain.PerspectiveFilter{LabelsAny: []string{"policy", "science"}}, want: []int{ids[0], ids[2]}},
+		{name: "all", filter: domain.PerspectiveFilter{LabelsAll: []string{"econ", "policy"}}, want: []int{ids[0]}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			filter := tt.filter
+			filter.UserID = &user.ID
+			result, err := repo.List(ctx, domain.PerspectiveListParams{
+				SortBy:    domain.PerspectiveSortByCreatedAt,
+				SortOrder: domain.SortOrderAsc,
+				Filter:    &filter,
+			})
+			require.NoError(t, err)
+
+			var got []int
+			for _, item := range result.Items {
+				got = append(got, item.ID)
+			}
+			assert.ElementsMatch(t, tt.want, got)
+		})
+	}
+}
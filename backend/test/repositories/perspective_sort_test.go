@@ -0,0 +1,212 @@
+package repositories_test
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/CodeWarrior-debug/perspectize/backend/internal/adapters/repositories/postgres"
+	"github.com/CodeWarrior-debug/perspectize/backend/internal/core/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+)
+
+// sortFixture is a perspective as seeded, with the values each sort reads
+type sortFixture struct {
+	id          int
+	ratings     map[domain.PerspectiveSortBy]*int
+	categorized *int
+	contentName *string
+}
+
+// seedSortFixtures creates one user's perspectives in tx with NULLs and ties
+// in every sort key
+func seedSortFixtures(t *testing.T, ctx context.Context, tx *gorm.DB) (int, []sortFixture) {
+	t.Helper()
+	suffix := time.Now().UnixNano()
+
+	user, err := postgres.NewGormUserRepository(tx).Create(ctx, &domain.User{
+		Username: fmt.Sprintf("sort-test-%d", suffix),
+		Email:    fmt.Sprintf("sort-test-%d@example.com", suffix),
+		Active:   true,
+	})
+	require.NoError(t, err)
+
+	contentRepo := postgres.NewGormContentRepository(tx)
+	names := []string{"Charlie Video", "Alpha Video", "Bravo Video"}
+	contentIDs := make([]int, len(names))
+	for i, name := range names {
+		url := fmt.Sprintf("https://example.com/sort-test/%d/%d", suffix, i)
+		c, err := contentRepo.Create(ctx, &domain.Content{
+			Name:          name,
+			URL:           &url,
+			ContentType:   domain.ContentTypeYouTube,
+			AddedByUserID: user.ID,
+		})
+		require.NoError(t, err)
+		contentIDs[i] = c.ID
+	}
+
+	rating := func(v int) *int { return &v }
+	rows := []struct {
+		quality, agreement, importance, confidence, clarity *int
+		content                                             int // Index into names, or -1 for none
+	}{
+		{rating(5000), nil, rating(1), rating(9000), rating(300), 0},
+		{nil, rating(7000), rating(1), nil, nil, 1},
+		{rating(5000), rating(7000), nil, rating(0), rating(300), 1},
+		{rating(0), nil, rating(10000), rating(0), rating(10000), -1},
+		{nil, nil, nil, nil, nil, 2},
+		{rating(10000), rating(2500), rating(1), nil, rating(0), -1},
+		{rating(5000), rating(2500), rating(4000), rating(9000), nil, 0},
+		{nil, rating(0), rating(4000), rating(5000), rating(300), 2},
+	}
+
+	perspectiveRepo := postgres.NewGormPerspectiveRepository(tx)
+	fixtures := make([]sortFixture, len(rows))
+	for i, row := range rows {
+		p := &domain.Perspective{
+			UserID:     user.ID,
+			Quality:    row.quality,
+			Agreement:  row.agreement,
+			Importance: row.importance,
+			Confidence: row.confidence,
+			Privacy:    domain.PrivacyPublic,
+		}
+		if row.clarity != nil {
+			p.CategorizedRatings = []domain.CategorizedRating{
+				{Category: "depth", Rating: 10000 - *row.clarity},
+				{Category: "clarity", Rating: *row.clarity},
+			}
+		}
+		fixture := sortFixture{
+			ratings: map[domain.PerspectiveSortBy]*int{
+				domain.PerspectiveSortByQuality:    row.quality,
+				domain.PerspectiveSortByAgreement:  row.agreement,
+				domain.PerspectiveSortByImportance: row.importance,
+				domain.PerspectiveSortByConfidence: row.confidence,
+			},
+			categorized: row.clarity,
+		}
+		if row.content >= 0 {
+			p.ContentID = &contentIDs[row.content]
+			fixture.contentName = &names[row.content]
+		}
+
+		created, err := perspectiveRepo.Create(ctx, p)
+		require.NoError(t, err)
+		fixture.id = created.ID
+		fixtures[i] = fixture
+	}
+	return user.ID, fixtures
+}
+
+// expectedOrder sorts fixtures as the repository should: by key with NULLs
+// last in both directions, then by ID in the sort direction
+func expectedOrder(fixtures []sortFixture, sortBy domain.PerspectiveSortBy, order domain.SortOrder) []int {
+	sorted := append([]sortFixture(nil), fixtures...)
+	sort.Slice(sorted, func(i, j int) bool {
+		a, b := sorted[i], sorted[j]
+		var cmp int
+		switch sortBy {
+		case domain.PerspectiveSortByContentName:
+			cmp = compareNullsLast(a.contentName, b.contentName, order)
+		case domain.PerspectiveSortByCategorizedRating:
+			cmp = compareNullsLast(a.categorized, b.categorized, order)
+		default:
+			cmp = compareNullsLast(a.ratings[sortBy], b.ratings[sortBy], order)
+		}
+		if cmp != 0 {
+			return cmp < 0
+		}
+		if order == domain.SortOrderAsc {
+			return a.id < b.id
+		}
+		return a.id > b.id
+	})
+
+	ids := make([]int, len(sorted))
+	for i, f := range sorted {
+		ids[i] = f.id
+	}
+	return ids
+}
+
+// compareNullsLast orders a before b (-1) or after it (1) in order, with
+// nil after every value
+func compareNullsLast[T int | string](a, b *T, order domain.SortOrder) int {
+	switch {
+	case a == nil && b == nil:
+		return 0
+	case a == nil:
+		return 1
+	case b == nil:
+		return -1
+	case *a == *b:
+		return 0
+	case (*a < *b) == (order == domain.SortOrderAsc):
+		return -1
+	default:
+		return 1
+	}
+}
+
+func TestPerspectiveList_SortPagesNeitherOverlapNorDropRows(t *testing.T) {
+	db := connectTestDB(t)
+	ctx := context.Background()
+
+	tx := db.Begin()
+	require.NoError(t, tx.Error)
+	defer tx.Rollback()
+
+	userID, fixtures := seedSortFixtures(t, ctx, tx)
+	repo := postgres.NewGormPerspectiveRepository(tx)
+
+	sorts := []domain.PerspectiveSortBy{
+		domain.PerspectiveSortByQuality,
+		domain.PerspectiveSortByAgreement,
+		domain.PerspectiveSortByImportance,
+		domain.PerspectiveSortByConfidence,
+		domain.PerspectiveSortByCategorizedRating,
+		domain.PerspectiveSortByContentName,
+	}
+	for _, sortBy := range sorts {
+		for _, order := range []domain.SortOrder{domain.SortOrderAsc, domain.SortOrderDesc} {
+			for _, pageSize := range []int{1, 2, 3, len(fixtures)} {
+				t.Run(fmt.Sprintf("%s_%s_by_%d", sortBy, order, pageSize), func(t *testing.T) {
+					params := domain.PerspectiveListParams{
+						First:     &pageSize,
+						SortBy:    sortBy,
+						SortOrder: order,
+						Filter:    &domain.PerspectiveFilter{UserID: &userID},
+					}
+					if sortBy == domain.PerspectiveSortByCategorizedRating {
+						params.SortCategory = "clarity"
+					}
+
+					var got []int
+					seen := make(map[int]bool)
+					for page := 0; page <= len(fixtures); page++ {
+						result, err := repo.List(ctx, params)
+						require.NoError(t, err)
+						assert.LessOrEqual(t, len(result.Items), pageSize)
+						for _, item := range result.Items {
+							assert.False(t, seen[item.ID], "perspective %d appeared on two pages", item.ID)
+							seen[item.ID] = true
+							got = append(got, item.ID)
+						}
+						if !result.HasNext {
+							break
+						}
+						params.After = result.EndCursor
+					}
+
+					assert.Equal(t, expectedOrder(fixtures, sortBy, order), got)
+				})
+			}
+		}
+	}
+}
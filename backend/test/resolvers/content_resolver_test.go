@@ -3,11 +3,13 @@ package resolvers_test
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
 	"net/http/httptest"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/99designs/gqlgen/graphql/handler"
 	"github.com/CodeWarrior-debug/perspectize/backend/internal/adapters/graphql/generated"
@@ -21,10 +23,20 @@ import (
 
 // mockContentRepository implements repositories.ContentRepository for testing
 type mockContentRepository struct {
-	createFn   func(ctx context.Context, content *domain.Content) (*domain.Content, error)
-	getByIDFn  func(ctx context.Context, id int) (*domain.Content, error)
-	getByURLFn func(ctx context.Context, url string) (*domain.Content, error)
-	listFn     func(ctx context.Context, params domain.ContentListParams) (*domain.PaginatedContent, error)
+	createFn            func(ctx context.Context, content *domain.Content) (*domain.Content, error)
+	getByIDFn           func(ctx context.Context, id int) (*domain.Content, error)
+	getByURLFn          func(ctx context.Context, url string) (*domain.Content, error)
+	getByCanonicalKeyFn func(ctx context.Context, key string) (*domain.Content, error)
+	addAliasFn          func(ctx context.Context, contentID int, url string) error
+	listFn              func(ctx context.Context, params domain.ContentListParams) (*domain.PaginatedContent, error)
+	listPageFn          func(ctx context.Context, params domain.ContentPageParams) (*domain.ContentPage, error)
+	facetsFn            func(ctx context.Context, params domain.ContentFacetParams) ([]*domain.ContentFacetCounts, error)
+	updateFn            func(ctx context.Context, content *domain.Content) (*domain.Content, error)
+	deleteFn            func(ctx context.Context, id int, policy domain.ContentDeletePolicy) error
+	mergeFn             func(ctx context.Context, sourceID, targetID int) (*domain.ContentMergeResult, error)
+	listRefreshDueFn    func(ctx context.Context, before time.Time, limit int) ([]*domain.Content, error)
+	recordStatsFn       func(ctx context.Context, snapshot *domain.ContentStatsSnapshot) error
+	listStatsHistoryFn  func(ctx context.Context, params domain.ContentStatsHistoryParams) ([]*domain.ContentStatsBucket, error)
 }
 
 func (m *mockContentRepository) Create(ctx context.Context, content *domain.Content) (*domain.Content, error) {
@@ -48,6 +60,20 @@ func (m *mockContentRepository) GetByURL(ctx context.Context, url string) (*doma
 	return nil, domain.ErrNotFound
 }
 
+func (m *mockContentRepository) GetByCanonicalKey(ctx context.Context, key string) (*domain.Content, error) {
+	if m.getByCanonicalKeyFn != nil {
+		return m.getByCanonicalKeyFn(ctx, key)
+	}
+	return nil, domain.ErrNotFound
+}
+
+func (m *mockContentRepository) AddAlias(ctx context.Context, contentID int, url string) error {
+	if m.addAliasFn != nil {
+		return m.addAliasFn(ctx, contentID, url)
+	}
+	return nil
+}
+
 func (m *mockContentRepository) List(ctx context.Context, params domain.ContentListParams) (*domain.PaginatedContent, error) {
 	if m.listFn != nil {
 		return m.listFn(ctx, params)
@@ -55,14 +81,81 @@ func (m *mockContentRepository) List(ctx context.Context, params domain.ContentL
 	return &domain.PaginatedContent{Items: []*domain.Content{}}, nil
 }
 
+func (m *mockContentRepository) ListPage(ctx context.Context, params domain.ContentPageParams) (*domain.ContentPage, error) {
+	if m.listPageFn != nil {
+		return m.listPageFn(ctx, params)
+	}
+	return &domain.ContentPage{Items: []*domain.Content{}, Page: params.Page, PageSize: params.PageSize}, nil
+}
+
+func (m *mockContentRepository) Facets(ctx context.Context, params domain.ContentFacetParams) ([]*domain.ContentFacetCounts, error) {
+	if m.facetsFn != nil {
+		return m.facetsFn(ctx, params)
+	}
+	return []*domain.ContentFacetCounts{}, nil
+}
+
+func (m *mockContentRepository) Update(ctx context.Context, content *domain.Content) (*domain.Content, error) {
+	if m.updateFn != nil {
+		return m.updateFn(ctx, content)
+	}
+	return content, nil
+}
+
+func (m *mockContentRepository) Delete(ctx context.Context, id int, policy domain.ContentDeletePolicy) error {
+	if m.deleteFn != nil {
+		return m.deleteFn(ctx, id, policy)
+	}
+	return nil
+}
+
+func (m *mockContentRepository) Merge(ctx context.Context, sourceID, targetID int) (*domain.ContentMergeResult, error) {
+	if m.mergeFn != nil {
+		return m.mergeFn(ctx, sourceID, targetID)
+	}
+	return nil, domain.ErrNotFound
+}
+
+func (m *mockContentRepository) ListRefreshDue(ctx context.Context, before time.Time, limit int) ([]*domain.Content, error) {
+	if m.listRefreshDueFn != nil {
+		return m.listRefreshDueFn(ctx, before, limit)
+	}
+	return []*domain.Content{}, nil
+}
+
+func (m *mockContentRepository) MarkRefreshFailed(ctx context.Context, ids []int, at time.Time) error {
+	return nil
+}
+
+func (m *mockContentRepository) MarkUnavailable(ctx context.Context, ids []int, at time.Time) error {
+	return nil
+}
+
 func (m *mockContentRepository) ReassignByUser(ctx context.Context, fromUserID, toUserID int) error {
 	return nil
 }
 
+func (m *mockContentRepository) RecordStatsSnapshot(ctx context.Context, snapshot *domain.ContentStatsSnapshot) error {
+	if m.recordStatsFn != nil {
+		return m.recordStatsFn(ctx, snapshot)
+	}
+	return nil
+}
+
+func (m *mockContentRepository) ListStatsHistory(ctx context.Context, params domain.ContentStatsHistoryParams) ([]*domain.ContentStatsBucket, error) {
+	if m.listStatsHistoryFn != nil {
+		return m.listStatsHistoryFn(ctx, params)
+	}
+	return []*domain.ContentStatsBucket{}, nil
+}
+
 // mockYouTubeClient implements services.YouTubeClient for testing
 type mockYouTubeClient struct {
-	getVideoMetadataFn func(ctx context.Context, videoID string) (*portservices.VideoMetadata, error)
-	extractVideoIDFn   func(url string) (string, error)
+	getVideoMetadataFn  func(ctx context.Context, videoID string) (*portservices.VideoMetadata, error)
+	getVideosMetadataFn func(ctx context.Context, videoIDs []string) (map[string]*portservices.VideoMetadata, error)
+	extractVideoIDFn    func(url string) (string, error)
+
+	listCollectionVideoIDsFn func(ctx context.Context, collectionURL string, limit int) ([]string, error)
 }
 
 func (m *mockYouTubeClient) GetVideoMetadata(ctx context.Context, videoID string) (*portservices.VideoMetadata, error) {
@@ -72,6 +165,29 @@ func (m *mockYouTubeClient) GetVideoMetadata(ctx context.Context, videoID string
 	return nil, fmt.Errorf("not implemented")
 }
 
+// GetVideosMetadata falls back to getVideoMetadataFn per ID when no batch fn is set
+func (m *mockYouTubeClient) GetVideosMetadata(ctx context.Context, videoIDs []string) (map[string]*portservices.VideoMetadata, error) {
+	if m.getVideosMetadataFn != nil {
+		return m.getVideosMetadataFn(ctx, videoIDs)
+	}
+	result := make(map[string]*portservices.VideoMetadata, len(videoIDs))
+	for _, id := range videoIDs {
+		metadata, err := m.GetVideoMetadata(ctx, id)
+		if err != nil {
+			return result, err
+		}
+		result[id] = metadata
+	}
+	return result, nil
+}
+
+func (m *mockYouTubeClient) ListCollectionVideoIDs(ctx context.Context, collectionURL string, limit int) ([]string, error) {
+	if m.listCollectionVideoIDsFn != nil {
+		return m.listCollectionVideoIDsFn(ctx, collectionURL, limit)
+	}
+	return nil, fmt.Errorf("not implemented")
+}
+
 func (m *mockYouTubeClient) ExtractVideoID(url string) (string, error) {
 	if m.extractVideoIDFn != nil {
 		return m.extractVideoIDFn(url)
@@ -87,6 +203,7 @@ type mockUserRepository struct {
 	getByUsernameFn func(ctx context.Context, username string) (*domain.User, error)
 	getByEmailFn    func(ctx context.Context, email string) (*domain.User, error)
 	listAllFn       func(ctx context.Context) ([]*domain.User, error)
+	searchFn        func(ctx context.Context, query string, limit int) ([]*domain.User, error)
 }
 
 func (m *mockUserRepository) Create(ctx context.Context, user *domain.User) (*domain.User, error) {
@@ -125,6 +242,13 @@ func (m *mockUserRepository) ListAll(ctx context.Context) ([]*domain.User, error
 	return []*domain.User{}, nil
 }
 
+func (m *mockUserRepository) Search(ctx context.Context, query string, limit int) ([]*domain.User, error) {
+	if m.searchFn != nil {
+		return m.searchFn(ctx, query, limit)
+	}
+	return []*domain.User{}, nil
+}
+
 func (m *mockUserRepository) Update(ctx context.Context, user *domain.User) (*domain.User, error) {
 	return user, nil
 }
@@ -196,8 +320,8 @@ func setupTestServer(repo *mockContentRepository, ytClient *mockYouTubeClient) *
 	perspectiveRepo := &mockPerspectiveRepository{}
 	contentService := services.NewContentService(repo, ytClient)
 	userService := services.NewUserService(userRepo, repo, perspectiveRepo)
-	perspectiveService := services.NewPerspectiveService(perspectiveRepo, userRepo)
-	resolver := resolvers.NewResolver(contentService, userService, perspectiveService)
+	perspectiveService := services.NewPerspectiveService(perspectiveRepo, userRepo, repo)
+	resolver := resolvers.NewResolver(contentService, userService, perspectiveService, nil, nil, nil)
 	srv := handler.NewDefaultServer(generated.NewExecutableSchema(generated.Config{Resolvers: resolver}))
 	return httptest.NewServer(srv)
 }
@@ -362,23 +486,27 @@ func TestCreateContentFromYouTube_Success(t *testing.T) {
 	server := setupTestServer(repo, ytClient)
 	defer server.Close()
 
-	result := executeGraphQL(t, server, `mutation { createContentFromYouTube(input: { url: "https://www.youtube.com/watch?v=dQw4w9WgXcQ" }) { id name contentType } }`)
+	result := executeGraphQL(t, server, `mutation { createContentFromYouTube(input: { url: "https://www.youtube.com/watch?v=dQw4w9WgXcQ" }) { created content { id name contentType } } }`)
 
 	assert.Empty(t, result.Errors)
 
 	var data struct {
 		CreateContentFromYouTube struct {
-			ID          string `json:"id"`
-			Name        string `json:"name"`
-			ContentType string `json:"contentType"`
+			Created bool `json:"created"`
+			Content struct {
+				ID          string `json:"id"`
+				Name        string `json:"name"`
+				ContentType string `json:"contentType"`
+			} `json:"content"`
 		} `json:"createContentFromYouTube"`
 	}
 	err := json.Unmarshal(result.Data, &data)
 	require.NoError(t, err)
 
-	assert.Equal(t, "42", data.CreateContentFromYouTube.ID)
-	assert.Equal(t, "Amazing Video", data.CreateContentFromYouTube.Name)
-	assert.Equal(t, "YOUTUBE", data.CreateContentFromYouTube.ContentType)
+	assert.True(t, data.CreateContentFromYouTube.Created)
+	assert.Equal(t, "42", data.CreateContentFromYouTube.Content.ID)
+	assert.Equal(t, "Amazing Video", data.CreateContentFromYouTube.Content.Name)
+	assert.Equal(t, "YOUTUBE", data.CreateContentFromYouTube.Content.ContentType)
 }
 
 func TestCreateContentFromYouTube_AlreadyExists(t *testing.T) {
@@ -392,12 +520,63 @@ func TestCreateContentFromYouTube_AlreadyExists(t *testing.T) {
 	server := setupTestServer(repo, &mockYouTubeClient{})
 	defer server.Close()
 
-	result := executeGraphQL(t, server, `mutation { createContentFromYouTube(input: { url: "https://www.youtube.com/watch?v=dQw4w9WgXcQ" }) { id } }`)
+	result := executeGraphQL(t, server, `mutation { createContentFromYouTube(input: { url: "https://www.youtube.com/watch?v=dQw4w9WgXcQ" }) { content { id } } }`)
 
 	require.NotEmpty(t, result.Errors)
 	assert.Contains(t, result.Errors[0].Message, "content already exists")
 }
 
+func TestCreateContentFromYouTube_ReturnExisting(t *testing.T) {
+	existingURL := "https://www.youtube.com/watch?v=dQw4w9WgXcQ"
+	repo := &mockContentRepository{
+		getByURLFn: func(ctx context.Context, url string) (*domain.Content, error) {
+			return &domain.Content{ID: 1, Name: "Stored", URL: &existingURL, ContentType: domain.ContentTypeYouTube}, nil
+		},
+	}
+
+	server := setupTestServer(repo, &mockYouTubeClient{})
+	defer server.Close()
+
+	result := executeGraphQL(t, server, `mutation {
+		createContentFromYouTube(input: { url: "https://www.youtube.com/watch?v=dQw4w9WgXcQ", ifExists: RETURN_EXISTING }) {
+			created content { id name }
+		}
+	}`)
+
+	require.Empty(t, result.Errors)
+	assert.JSONEq(t, `{"createContentFromYouTube": {"created": false, "content": {"id": "1", "name": "Stored"}}}`, string(result.Data))
+}
+
+func TestCreateContentFromYouTube_Refresh(t *testing.T) {
+	existingURL := "https://www.youtube.com/watch?v=dQw4w9WgXcQ"
+	stored := &domain.Content{ID: 1, Name: "Old Title", URL: &existingURL, ContentType: domain.ContentTypeYouTube}
+	repo := &mockContentRepository{
+		getByURLFn: func(ctx context.Context, url string) (*domain.Content, error) {
+			return stored, nil
+		},
+		getByIDFn: func(ctx context.Context, id int) (*domain.Content, error) {
+			return stored, nil
+		},
+	}
+	ytClient := &mockYouTubeClient{
+		getVideoMetadataFn: func(ctx context.Context, videoID string) (*portservices.VideoMetadata, error) {
+			return &portservices.VideoMetadata{Title: "New Title"}, nil
+		},
+	}
+
+	server := setupTestServer(repo, ytClient)
+	defer server.Close()
+
+	result := executeGraphQL(t, server, `mutation {
+		createContentFromYouTube(input: { url: "https://www.youtube.com/watch?v=dQw4w9WgXcQ", ifExists: REFRESH }) {
+			created content { id name }
+		}
+	}`)
+
+	require.Empty(t, result.Errors)
+	assert.JSONEq(t, `{"createContentFromYouTube": {"created": false, "content": {"id": "1", "name": "New Title"}}}`, string(result.Data))
+}
+
 func TestCreateContentFromYouTube_InvalidURL(t *testing.T) {
 	repo := &mockContentRepository{
 		getByURLFn: func(ctx context.Context, url string) (*domain.Content, error) {
@@ -414,12 +593,400 @@ func TestCreateContentFromYouTube_InvalidURL(t *testing.T) {
 	server := setupTestServer(repo, ytClient)
 	defer server.Close()
 
-	result := executeGraphQL(t, server, `mutation { createContentFromYouTube(input: { url: "not-a-youtube-url" }) { id } }`)
+	result := executeGraphQL(t, server, `mutation { createContentFromYouTube(input: { url: "not-a-youtube-url" }) { content { id } } }`)
 
 	require.NotEmpty(t, result.Errors)
 	assert.Contains(t, result.Errors[0].Message, "invalid YouTube URL")
 }
 
+// --- UpdateContent / DeleteContent Mutation Tests ---
+
+func TestUpdateContent_Success(t *testing.T) {
+	repo := &mockContentRepository{
+		getByIDFn: func(ctx context.Context, id int) (*domain.Content, error) {
+			return &domain.Content{ID: id, Name: "Old Title", ContentType: domain.ContentTypeYouTube}, nil
+		},
+	}
+
+	server := setupTestServer(repo, &mockYouTubeClient{})
+	defer server.Close()
+
+	result := executeGraphQL(t, server, `mutation { updateContent(input: { id: "5", name: "Fixed Title" }) { id name } }`)
+
+	assert.Empty(t, result.Errors)
+
+	var data struct {
+		UpdateContent struct {
+			ID   string `json:"id"`
+			Name string `json:"name"`
+		} `json:"updateContent"`
+	}
+	err := json.Unmarshal(result.Data, &data)
+	require.NoError(t, err)
+
+	assert.Equal(t, "5", data.UpdateContent.ID)
+	assert.Equal(t, "Fixed Title", data.UpdateContent.Name)
+}
+
+func TestUpdateContent_NotFound(t *testing.T) {
+	server := setupTestServer(&mockContentRepository{}, &mockYouTubeClient{})
+	defer server.Close()
+
+	result := executeGraphQL(t, server, `mutation { updateContent(input: { id: "5", name: "Fixed Title" }) { id } }`)
+
+	require.NotEmpty(t, result.Errors)
+	assert.Contains(t, result.Errors[0].Message, "content not found")
+}
+
+func TestUpdateContent_NameTaken(t *testing.T) {
+	repo := &mockContentRepository{
+		getByIDFn: func(ctx context.Context, id int) (*domain.Content, error) {
+			return &domain.Content{ID: id, Name: "Old Title"}, nil
+		},
+		updateFn: func(ctx context.Context, content *domain.Content) (*domain.Content, error) {
+			return nil, fmt.Errorf("%w: content named %q already exists", domain.ErrAlreadyExists, content.Name)
+		},
+	}
+	server := setupTestServer(repo, &mockYouTubeClient{})
+	defer server.Close()
+
+	result := executeGraphQL(t, server, `mutation { updateContent(input: { id: "5", name: "Taken" }) { id } }`)
+
+	require.NotEmpty(t, result.Errors)
+	assert.Contains(t, result.Errors[0].Message, `content already exists`)
+	assert.Contains(t, result.Errors[0].Message, `content named "Taken" already exists`)
+}
+
+func TestDeleteContent_Success(t *testing.T) {
+	var gotPolicy domain.ContentDeletePolicy
+	repo := &mockContentRepository{
+		getByIDFn: func(ctx context.Context, id int) (*domain.Content, error) {
+			return &domain.Content{ID: id}, nil
+		},
+		deleteFn: func(ctx context.Context, id int, policy domain.ContentDeletePolicy) error {
+			gotPolicy = policy
+			return nil
+		},
+	}
+
+	server := setupTestServer(repo, &mockYouTubeClient{})
+	defer server.Close()
+
+	result := executeGraphQL(t, server, `mutation { deleteContent(id: "5", policy: DETACH) }`)
+
+	assert.Empty(t, result.Errors)
+	assert.JSONEq(t, `{"deleteContent": true}`, string(result.Data))
+	assert.Equal(t, domain.ContentDeletePolicyDetach, gotPolicy)
+}
+
+func TestDeleteContent_HasPerspectives(t *testing.T) {
+	repo := &mockContentRepository{
+		getByIDFn: func(ctx context.Context, id int) (*domain.Content, error) {
+			return &domain.Content{ID: id}, nil
+		},
+		deleteFn: func(ctx context.Context, id int, policy domain.ContentDeletePolicy) error {
+			assert.Equal(t, domain.ContentDeletePolicyRestrict, policy)
+			return domain.ErrContentInUse
+		},
+	}
+
+	server := setupTestServer(repo, &mockYouTubeClient{})
+	defer server.Close()
+
+	result := executeGraphQL(t, server, `mutation { deleteContent(id: "5") }`)
+
+	require.NotEmpty(t, result.Errors)
+	assert.Contains(t, result.Errors[0].Message, "content has perspectives")
+}
+
+// --- refreshContent Mutation Tests ---
+
+func TestMergeContent_Success(t *testing.T) {
+	targetURL := "https://www.youtube.com/watch?v=dQw4w9WgXcQ"
+	repo := &mockContentRepository{
+		mergeFn: func(ctx context.Context, sourceID, targetID int) (*domain.ContentMergeResult, error) {
+			assert.Equal(t, 8, sourceID)
+			assert.Equal(t, 2, targetID)
+			return &domain.ContentMergeResult{
+				Target:            &domain.Content{ID: 2, Name: "Kept", URL: &targetURL, ContentType: domain.ContentTypeYouTube},
+				PerspectivesMoved: 1,
+			}, nil
+		},
+	}
+	server := setupTestServer(repo, &mockYouTubeClient{})
+	defer server.Close()
+
+	result := executeGraphQL(t, server, `mutation { mergeContent(sourceID: "8", targetID: "2") { id name } }`)
+
+	require.Empty(t, result.Errors)
+	assert.JSONEq(t, `{"mergeContent": {"id": "2", "name": "Kept"}}`, string(result.Data))
+}
+
+func TestMergeContent_Errors(t *testing.T) {
+	tests := []struct {
+		name  string
+		query string
+		want  string
+	}{
+		{"not found", `mutation { mergeContent(sourceID: "8", targetID: "2") { id } }`, "content not found"},
+		{"same content", `mutation { mergeContent(sourceID: "2", targetID: "2") { id } }`, "cannot merge content into itself"},
+		{"bad id", `mutation { mergeContent(sourceID: "abc", targetID: "2") { id } }`, `parsing "abc"`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := setupTestServer(&mockContentRepository{}, &mockYouTubeClient{})
+			defer server.Close()
+
+			result := executeGraphQL(t, server, tt.query)
+
+			require.NotEmpty(t, result.Errors)
+			assert.Contains(t, result.Errors[0].Message, tt.want)
+		})
+	}
+}
+
+func TestRefreshContent_Success(t *testing.T) {
+	url := "https://www.youtube.com/watch?v=dQw4w9WgXcQ"
+	repo := &mockContentRepository{
+		getByIDFn: func(ctx context.Context, id int) (*domain.Content, error) {
+			return &domain.Content{ID: id, Name: "Old Title", URL: &url, ContentType: domain.ContentTypeYouTube}, nil
+		},
+	}
+	ytClient := &mockYouTubeClient{
+		getVideoMetadataFn: func(ctx context.Context, videoID string) (*portservices.VideoMetadata, error) {
+			return &portservices.VideoMetadata{Title: "New Title", Duration: 212}, nil
+		},
+	}
+
+	server := setupTestServer(repo, ytClient)
+	defer server.Close()
+
+	result := executeGraphQL(t, server, `mutation { refreshContent(id: "5") { id name length } }`)
+
+	assert.Empty(t, result.Errors)
+	assert.JSONEq(t, `{"refreshContent": {"id": "5", "name": "New Title", "length": 212}}`, string(result.Data))
+}
+
+func TestRefreshContent_NotFound(t *testing.T) {
+	server := setupTestServer(&mockContentRepository{}, &mockYouTubeClient{})
+	defer server.Close()
+
+	result := executeGraphQL(t, server, `mutation { refreshContent(id: "5") { id } }`)
+
+	require.NotEmpty(t, result.Errors)
+	assert.Contains(t, result.Errors[0].Message, "content not found")
+}
+
+func TestRefreshContent_YouTubeError(t *testing.T) {
+	url := "https://www.youtube.com/watch?v=dQw4w9WgXcQ"
+	repo := &mockContentRepository{
+		getByIDFn: func(ctx context.Context, id int) (*domain.Content, error) {
+			return &domain.Content{ID: id, URL: &url, ContentType: domain.ContentTypeYouTube}, nil
+		},
+	}
+	ytClient := &mockYouTubeClient{
+		getVideoMetadataFn: func(ctx context.Context, videoID string) (*portservices.VideoMetadata, error) {
+			return nil, fmt.Errorf("%w: status 403", domain.ErrYouTubeAPI)
+		},
+	}
+
+	server := setupTestServer(repo, ytClient)
+	defer server.Close()
+
+	result := executeGraphQL(t, server, `mutation { refreshContent(id: "5") { id } }`)
+
+	require.NotEmpty(t, result.Errors)
+	assert.Contains(t, result.Errors[0].Message, "failed to fetch video metadata from YouTube")
+}
+
+// --- Content.statsHistory Field Tests ---
+
+func TestContentStatsHistory_Weekly(t *testing.T) {
+	views := int64(3000000000)
+	var got domain.ContentStatsHistoryParams
+	repo := &mockContentRepository{
+		getByIDFn: func(ctx context.Context, id int) (*domain.Content, error) {
+			return &domain.Content{ID: id, Name: "Video", ContentType: domain.ContentTypeYouTube}, nil
+		},
+		listStatsHistoryFn: func(ctx context.Context, params domain.ContentStatsHistoryParams) ([]*domain.ContentStatsBucket, error) {
+			got = params
+			return []*domain.ContentStatsBucket{
+				{BucketStart: time.Date(2026, 3, 2, 0, 0, 0, 0, time.UTC), ViewCount: &views, Samples: 4},
+			}, nil
+		},
+	}
+
+	server := setupTestServer(repo, &mockYouTubeClient{})
+	defer server.Close()
+
+	result := executeGraphQL(t, server, `{
+		contentByID(id: "7") {
+			statsHistory(from: "2026-03-01", to: "2026-04-01T00:00:00Z", granularity: WEEK) {
+				bucketStart viewCount likeCount samples
+			}
+		}
+	}`)
+
+	require.Empty(t, result.Errors)
+	assert.JSONEq(t, `{"contentByID": {"statsHistory": [
+		{"bucketStart": "2026-03-02T00:00:00Z", "viewCount": 3000000000, "likeCount": null, "samples": 4}
+	]}}`, string(result.Data))
+	assert.Equal(t, 7, got.ContentID)
+	assert.Equal(t, domain.StatsGranularityWeek, got.Granularity)
+	require.NotNil(t, got.From)
+	assert.Equal(t, time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC), *got.From)
+	require.NotNil(t, got.To)
+	assert.Equal(t, time.Date(2026, 4, 1, 0, 0, 0, 0, time.UTC), *got.To)
+}
+
+func TestContentStatsHistory_InvalidDate(t *testing.T) {
+	repo := &mockContentRepository{
+		getByIDFn: func(ctx context.Context, id int) (*domain.Content, error) {
+			return &domain.Content{ID: id, Name: "Video", ContentType: domain.ContentTypeYouTube}, nil
+		},
+	}
+
+	server := setupTestServer(repo, &mockYouTubeClient{})
+	defer server.Close()
+
+	result := executeGraphQL(t, server, `{ contentByID(id: "7") { statsHistory(from: "last tuesday") { samples } } }`)
+
+	require.NotEmpty(t, result.Errors)
+	assert.Contains(t, result.Errors[0].Message, "invalid from date")
+}
+
+// --- importContent Mutation Tests ---
+
+func TestImportContent_PerURLResults(t *testing.T) {
+	existingURL := "https://www.youtube.com/watch?v=existing"
+	repo := &mockContentRepository{
+		getByURLFn: func(ctx context.Context, url string) (*domain.Content, error) {
+			if url == existingURL {
+				return &domain.Content{ID: 3, Name: "Existing", URL: &existingURL, ContentType: domain.ContentTypeYouTube}, nil
+			}
+			return nil, domain.ErrNotFound
+		},
+		createFn: func(ctx context.Context, content *domain.Content) (*domain.Content, error) {
+			content.ID = 11
+			return content, nil
+		},
+	}
+	ytClient := &mockYouTubeClient{
+		extractVideoIDFn: func(url string) (string, error) {
+			if url == "bogus" {
+				return "", fmt.Errorf("could not extract video ID")
+			}
+			return "dQw4w9WgXcQ", nil
+		},
+		getVideosMetadataFn: func(ctx context.Context, videoIDs []string) (map[string]*portservices.VideoMetadata, error) {
+			return map[string]*portservices.VideoMetadata{
+				"dQw4w9WgXcQ": {Title: "Imported", Duration: 212},
+			}, nil
+		},
+	}
+
+	server := setupTestServer(repo, ytClient)
+	defer server.Close()
+
+	result := executeGraphQL(t, server, `mutation {
+		importContent(urls: ["https://youtu.be/dQw4w9WgXcQ", "https://www.youtube.com/watch?v=existing", "bogus"]) {
+			url status error content { id name }
+		}
+	}`)
+
+	require.Empty(t, result.Errors)
+	assert.JSONEq(t, `{"importContent": [
+		{"url": "https://youtu.be/dQw4w9WgXcQ", "status": "CREATED", "error": null, "content": {"id": "11", "name": "Imported"}},
+		{"url": "https://www.youtube.com/watch?v=existing", "status": "EXISTS", "error": null, "content": {"id": "3", "name": "Existing"}},
+		{"url": "bogus", "status": "INVALID_URL", "error": "invalid YouTube URL", "content": null}
+	]}`, string(result.Data))
+}
+
+func TestImportContent_EmptyList(t *testing.T) {
+	server := setupTestServer(&mockContentRepository{}, &mockYouTubeClient{})
+	defer server.Close()
+
+	result := executeGraphQL(t, server, `mutation { importContent(urls: []) { status } }`)
+
+	require.NotEmpty(t, result.Errors)
+	assert.Contains(t, result.Errors[0].Message, "at least one URL is required")
+}
+
+func TestImportYouTubePlaylist_Summary(t *testing.T) {
+	repo := &mockContentRepository{
+		getByURLFn: func(ctx context.Context, url string) (*domain.Content, error) {
+			return nil, domain.ErrNotFound
+		},
+		createFn: func(ctx context.Context, content *domain.Content) (*domain.Content, error) {
+			content.ID = 21
+			return content, nil
+		},
+	}
+	var gotLimit int
+	ytClient := &mockYouTubeClient{
+		listCollectionVideoIDsFn: func(ctx context.Context, collectionURL string, limit int) ([]string, error) {
+			gotLimit = limit
+			return []string{"dQw4w9WgXcQ"}, nil
+		},
+		getVideosMetadataFn: func(ctx context.Context, videoIDs []string) (map[string]*portservices.VideoMetadata, error) {
+			return map[string]*portservices.VideoMetadata{"dQw4w9WgXcQ": {Title: "Imported"}}, nil
+		},
+	}
+
+	server := setupTestServer(repo, ytClient)
+	defer server.Close()
+
+	result := executeGraphQL(t, server, `mutation {
+		importYouTubePlaylist(url: "https://www.youtube.com/playlist?list=PLabc", limit: 25) {
+			total created skipped failed
+		}
+	}`)
+
+	require.Empty(t, result.Errors)
+	assert.Equal(t, 25, gotLimit)
+	assert.JSONEq(t, `{"importYouTubePlaylist": {"total": 1, "created": 1, "skipped": 0, "failed": 0}}`, string(result.Data))
+}
+
+func TestImportYouTubePlaylist_Errors(t *testing.T) {
+	tests := []struct {
+		name    string
+		listErr error
+		want    string
+	}{
+		{"invalid URL", fmt.Errorf("%w: not a playlist", domain.ErrInvalidURL), "invalid YouTube playlist or channel URL"},
+		{"not found", fmt.Errorf("%w: channel not found", domain.ErrNotFound), "playlist or channel not found"},
+		{"provider error", fmt.Errorf("%w: status 403", domain.ErrYouTubeAPI), "failed to fetch playlist from YouTube"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ytClient := &mockYouTubeClient{
+				listCollectionVideoIDsFn: func(ctx context.Context, collectionURL string, limit int) ([]string, error) {
+					return nil, tt.listErr
+				},
+			}
+			server := setupTestServer(&mockContentRepository{}, ytClient)
+			defer server.Close()
+
+			result := executeGraphQL(t, server, `mutation { importYouTubePlaylist(url: "https://www.youtube.com/@x") { total } }`)
+
+			require.NotEmpty(t, result.Errors)
+			assert.Equal(t, tt.want, result.Errors[0].Message)
+		})
+	}
+}
+
+func TestImportYouTubePlaylist_InvalidLimit(t *testing.T) {
+	server := setupTestServer(&mockContentRepository{}, &mockYouTubeClient{})
+	defer server.Close()
+
+	result := executeGraphQL(t, server, `mutation { importYouTubePlaylist(url: "https://www.youtube.com/@channel", limit: 5000) { total } }`)
+
+	require.NotEmpty(t, result.Errors)
+	assert.Contains(t, result.Errors[0].Message, "invalid input")
+}
+
 // --- Paginated Content Query Tests ---
 
 func TestPaginatedContentQuery_DefaultPagination(t *testing.T) {
@@ -895,6 +1462,366 @@ func TestPaginatedContentQuery_WithMinMaxLengthFilter(t *testing.T) {
 	assert.Len(t, data.Content.Items, 1)
 }
 
+func TestPaginatedContentQuery_SearchByRelevance(t *testing.T) {
+	repo := &mockContentRepository{
+		listFn: func(ctx context.Context, params domain.ContentListParams) (*domain.PaginatedContent, error) {
+			require.NotNil(t, params.Filter)
+			require.NotNil(t, params.Filter.Search)
+			assert.Equal(t, `"carbon tax" clim*`, *params.Filter.Search)
+			assert.Equal(t, domain.ContentSortByRelevance, params.SortBy)
+			assert.Equal(t, domain.SortOrderDesc, params.SortOrder)
+
+			snippet := "a <b>carbon</b> <b>tax</b> would cut emissions"
+			return &domain.PaginatedContent{
+				Items: []*domain.Content{{
+					ID:          3,
+					Name:        "Carbon Tax Explained",
+					ContentType: domain.ContentTypeYouTube,
+					SearchMatch: &domain.ContentSearchMatch{
+						Rank:                 0.75,
+						NameHighlight:        "<b>Carbon</b> <b>Tax</b> Explained",
+						DescriptionHighlight: &snippet,
+					},
+				}},
+			}, nil
+		},
+	}
+
+	server := setupTestServer(repo, &mockYouTubeClient{})
+	defer server.Close()
+
+	result := executeGraphQL(t, server, `{ content(sortBy: RELEVANCE, filter: { search: "\"carbon tax\" clim*" }) {
+		items { id searchMatch { rank name description } }
+	} }`)
+	require.Empty(t, result.Errors)
+	assert.JSONEq(t, `{"content": {"items": [{"id": "3", "searchMatch": {
+		"rank": 0.75,
+		"name": "<b>Carbon</b> <b>Tax</b> Explained",
+		"description": "a <b>carbon</b> <b>tax</b> would cut emissions"
+	}}]}}`, string(result.Data))
+}
+
+func TestPaginatedContentQuery_SearchValidation(t *testing.T) {
+	tests := []struct {
+		name  string
+		query string
+		want  string
+	}{
+		{name: "relevance without search", query: `{ content(sortBy: RELEVANCE) { items { id } } }`, want: "RELEVANCE sort requires a search"},
+		{name: "search without words", query: `{ content(filter: { search: "-- ** !!" }) { items { id } } }`, want: "search must contain at least one word"},
+		{name: "fuzzy search too long", query: `{ content(filter: { search: "` + strings.Repeat("a", domain.MaxFuzzyQueryLength+1) + `", searchMode: FUZZY }) { items { id } } }`, want: "search must be at most 200 characters"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &mockContentRepository{
+				listFn: func(ctx context.Context, params domain.ContentListParams) (*domain.PaginatedContent, error) {
+					t.Fatal("repository should not be called")
+					return nil, nil
+				},
+			}
+			server := setupTestServer(repo, &mockYouTubeClient{})
+			defer server.Close()
+
+			result := executeGraphQL(t, server, tt.query)
+
+			require.NotEmpty(t, result.Errors)
+			assert.Contains(t, result.Errors[0].Message, tt.want)
+		})
+	}
+}
+
+func TestPaginatedContentQuery_FuzzySearch(t *testing.T) {
+	repo := &mockContentRepository{
+		listFn: func(ctx context.Context, params domain.ContentListParams) (*domain.PaginatedContent, error) {
+			require.NotNil(t, params.Filter)
+			require.NotNil(t, params.Filter.Search)
+			assert.Equal(t, "climat chnage", *params.Filter.Search)
+			assert.Equal(t, domain.SearchModeFuzzy, params.Filter.SearchMode)
+			return &domain.PaginatedContent{
+				Items: []*domain.Content{{
+					ID:          4,
+					Name:        "Climate Change Explained",
+					ContentType: domain.ContentTypeYouTube,
+					SearchMatch: &domain.ContentSearchMatch{Rank: 0.5, NameHighlight: "Climate Change Explained"},
+				}},
+			}, nil
+		},
+	}
+
+	server := setupTestServer(repo, &mockYouTubeClient{})
+	defer server.Close()
+
+	result := executeGraphQL(t, server, `{ content(sortBy: RELEVANCE, filter: { search: "climat chnage", searchMode: FUZZY }) {
+		items { id searchMatch { rank name } }
+	} }`)
+	require.Empty(t, result.Errors)
+	assert.JSONEq(t, `{"content": {"items": [{"id": "4", "searchMatch": {
+		"rank": 0.5,
+		"name": "Climate Change Explained"
+	}}]}}`, string(result.Data))
+}
+
+func TestPaginatedContentQuery_MetadataFilters(t *testing.T) {
+	var got domain.ContentListParams
+	repo := &mockContentRepository{
+		listFn: func(ctx context.Context, params domain.ContentListParams) (*domain.PaginatedContent, error) {
+			got = params
+			return &domain.PaginatedContent{Items: []*domain.Content{}}, nil
+		},
+	}
+
+	server := setupTestServer(repo, &mockYouTubeClient{})
+	defer server.Close()
+
+	result := executeGraphQL(t, server, `{ content(sortBy: VIEW_COUNT, filter: {
+		publishedAt: { from: "2025-01-01", to: "2025-07-01T00:00:00Z" }
+		createdAt: { from: "2026-01-01" }
+		channelTitle: " Chan A "
+		channelTitles: ["Chan B"]
+		tagsAny: ["go", "sql"]
+		tagsAll: ["db"]
+		addedByUserID: 7
+		minViewCount: 1000
+		minPerspectives: 2
+		withoutPerspectivesFromUserID: 9
+	}) { items { id } } }`)
+	require.Empty(t, result.Errors)
+
+	require.NotNil(t, got.Filter)
+	f := got.Filter
+	assert.Equal(t, domain.ContentSortByViewCount, got.SortBy)
+	require.NotNil(t, f.PublishedAt)
+	assert.Equal(t, time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC), *f.PublishedAt.From)
+	assert.Equal(t, time.Date(2025, 7, 1, 0, 0, 0, 0, time.UTC), *f.PublishedAt.To)
+	require.NotNil(t, f.CreatedAt)
+	assert.Equal(t, time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC), *f.CreatedAt.From)
+	assert.Nil(t, f.CreatedAt.To)
+	assert.Equal(t, []string{"Chan A", "Chan B"}, f.ChannelTitles)
+	assert.Equal(t, []string{"go", "sql"}, f.TagsAny)
+	assert.Equal(t, []string{"db"}, f.TagsAll)
+	require.NotNil(t, f.AddedByUserID)
+	assert.Equal(t, 7, *f.AddedByUserID)
+	require.NotNil(t, f.MinViewCount)
+	assert.Equal(t, int64(1000), *f.MinViewCount)
+	require.NotNil(t, f.MinPerspectives)
+	assert.Equal(t, 2, *f.MinPerspectives)
+	require.NotNil(t, f.WithoutPerspectivesFromUserID)
+	assert.Equal(t, 9, *f.WithoutPerspectivesFromUserID)
+}
+
+func TestPaginatedContentQuery_MetadataFilterValidation(t *testing.T) {
+	tooMany := `"` + strings.Repeat(`t", "`, domain.MaxFilterValues) + `t"`
+	tests := []struct {
+		name   string
+		filter string
+		want   string
+	}{
+		{name: "unparseable date", filter: `publishedAt: { from: "last week" }`, want: "invalid publishedAt"},
+		{name: "inverted published range", filter: `publishedAt: { from: "2025-02-01", to: "2025-01-01" }`, want: "publishedAt from must be before to"},
+		{name: "empty created range", filter: `createdAt: { from: "2025-01-01", to: "2025-01-01" }`, want: "createdAt from must be before to"},
+		{name: "blank channel", filter: `channelTitles: ["  "]`, want: "channelTitles values must not be blank"},
+		{name: "too many tags", filter: `tagsAny: [` + tooMany + `]`, want: "tagsAny may contain at most 20 values"},
+		{name: "blank tag", filter: `tagsAll: ["go", ""]`, want: "tagsAll values must not be blank"},
+		{name: "negative view count", filter: `minViewCount: -1`, want: "minViewCount must not be negative"},
+		{name: "negative perspectives", filter: `minPerspectives: -1`, want: "minPerspectives must not be negative"},
+		{name: "zero adder", filter: `addedByUserID: 0`, want: "addedByUserID must be a positive integer"},
+		{name: "zero reviewer", filter: `withoutPerspectivesFromUserID: 0`, want: "withoutPerspectivesFromUserID must be a positive integer"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &mockContentRepository{
+				listFn: func(ctx context.Context, params domain.ContentListParams) (*domain.PaginatedContent, error) {
+					t.Fatal("repository should not be called")
+					return nil, nil
+				},
+			}
+			server := setupTestServer(repo, &mockYouTubeClient{})
+			defer server.Close()
+
+			result := executeGraphQL(t, server, `{ content(filter: { `+tt.filter+` }) { items { id } } }`)
+
+			require.NotEmpty(t, result.Errors)
+			assert.Contains(t, result.Errors[0].Message, tt.want)
+		})
+	}
+}
+
+func TestPaginatedContentQuery_TotalCountExactness(t *testing.T) {
+	totalCount := 7
+	tests := []struct {
+		name   string
+		args   string
+		result domain.PaginatedContent
+		want   string
+	}{
+		{name: "not requested", args: `first: 5`, want: `null`},
+		{name: "exact", args: `includeTotalCount: true`, result: domain.PaginatedContent{TotalCount: &totalCount, TotalCountExact: true}, want: `true`},
+		{name: "cached", args: `includeTotalCount: true, countStrategy: CACHED`, result: domain.PaginatedContent{TotalCount: &totalCount}, want: `false`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &mockContentRepository{
+				listFn: func(ctx context.Context, params domain.ContentListParams) (*domain.PaginatedContent, error) {
+					result := tt.result
+					result.Items = []*domain.Content{}
+					return &result, nil
+				},
+			}
+			server := setupTestServer(repo, &mockYouTubeClient{})
+			defer server.Close()
+
+			result := executeGraphQL(t, server, `{ content(`+tt.args+`) { pageInfo { totalCountExact } } }`)
+
+			require.Empty(t, result.Errors)
+			assert.JSONEq(t, `{"content": {"pageInfo": {"totalCountExact": `+tt.want+`}}}`, string(result.Data))
+		})
+	}
+}
+
+func TestContentPageQuery_Success(t *testing.T) {
+	var got domain.ContentPageParams
+	start, end := "c3RhcnQ=", "ZW5k"
+	repo := &mockContentRepository{
+		listPageFn: func(ctx context.Context, params domain.ContentPageParams) (*domain.ContentPage, error) {
+			got = params
+			return &domain.ContentPage{
+				Items:       []*domain.Content{{ID: 11, Name: "Page Two Video", ContentType: domain.ContentTypeYouTube}},
+				Page:        params.Page,
+				PageSize:    params.PageSize,
+				TotalCount:  41,
+				TotalPages:  3,
+				StartCursor: &start,
+				EndCursor:   &end,
+			}, nil
+		},
+	}
+
+	server := setupTestServer(repo, &mockYouTubeClient{})
+	defer server.Close()
+
+	result := executeGraphQL(t, server, `{ contentPage(page: 2, pageSize: 20, sortBy: NAME, sortOrder: ASC, countStrategy: ESTIMATED, filter: { tagsAll: ["go"] }) {
+		items { id name } page pageSize totalCount totalPages totalCountExact hasNextPage hasPreviousPage startCursor endCursor
+	} }`)
+	require.Empty(t, result.Errors)
+	assert.JSONEq(t, `{"contentPage": {
+		"items": [{"id": "11", "name": "Page Two Video"}],
+		"page": 2, "pageSize": 20, "totalCount": 41, "totalPages": 3, "totalCountExact": false,
+		"hasNextPage": true, "hasPreviousPage": true,
+		"startCursor": "c3RhcnQ=", "endCursor": "ZW5k"
+	}}`, string(result.Data))
+
+	assert.Equal(t, domain.ContentSortByName, got.SortBy)
+	assert.Equal(t, domain.SortOrderAsc, got.SortOrder)
+	assert.Equal(t, domain.CountStrategyEstimated, got.CountStrategy)
+	require.NotNil(t, got.Filter)
+	assert.Equal(t, []string{"go"}, got.Filter.TagsAll)
+}
+
+func TestContentPageQuery_Defaults(t *testing.T) {
+	var got domain.ContentPageParams
+	repo := &mockContentRepository{
+		listPageFn: func(ctx context.Context, params domain.ContentPageParams) (*domain.ContentPage, error) {
+			got = params
+			return &domain.ContentPage{Items: []*domain.Content{}, Page: params.Page, PageSize: params.PageSize}, nil
+		},
+	}
+
+	server := setupTestServer(repo, &mockYouTubeClient{})
+	defer server.Close()
+
+	result := executeGraphQL(t, server, `{ contentPage { page pageSize totalPages hasNextPage hasPreviousPage startCursor endCursor } }`)
+	require.Empty(t, result.Errors)
+	assert.JSONEq(t, `{"contentPage": {
+		"page": 1, "pageSize": 25, "totalPages": 0,
+		"hasNextPage": false, "hasPreviousPage": false,
+		"startCursor": null, "endCursor": null
+	}}`, string(result.Data))
+
+	assert.Equal(t, domain.ContentSortByCreatedAt, got.SortBy)
+	assert.Equal(t, domain.SortOrderDesc, got.SortOrder)
+	assert.Nil(t, got.Filter)
+}
+
+func TestContentPageQuery_TooDeep(t *testing.T) {
+	server := setupTestServer(&mockContentRepository{}, &mockYouTubeClient{})
+	defer server.Close()
+
+	result := executeGraphQL(t, server, `{ contentPage(page: 500, pageSize: 50) { page } }`)
+
+	require.NotEmpty(t, result.Errors)
+	assert.Contains(t, result.Errors[0].Message, "page further with a cursor")
+}
+
+func TestContentFacetsQuery_Success(t *testing.T) {
+	var got domain.ContentFacetParams
+	repo := &mockContentRepository{
+		facetsFn: func(ctx context.Context, params domain.ContentFacetParams) ([]*domain.ContentFacetCounts, error) {
+			got = params
+			return []*domain.ContentFacetCounts{
+				{Facet: domain.ContentFacetChannel, Values: []domain.FacetValue{{Value: "Chan A", Count: 4}, {Value: "Chan B", Count: 1}}},
+				{Facet: domain.ContentFacetPublishYear, Values: []domain.FacetValue{}},
+			}, nil
+		},
+	}
+
+	server := setupTestServer(repo, &mockYouTubeClient{})
+	defer server.Close()
+
+	result := executeGraphQL(t, server, `{ contentFacets(
+		filter: { tagsAny: ["go"], publishedAt: { from: "2025-01-01" }, search: "climate" }
+		facets: [CHANNEL, PUBLISH_YEAR]
+	) { facet values { value count } } }`)
+	require.Empty(t, result.Errors)
+	assert.JSONEq(t, `{"contentFacets": [
+		{"facet": "CHANNEL", "values": [{"value": "Chan A", "count": 4}, {"value": "Chan B", "count": 1}]},
+		{"facet": "PUBLISH_YEAR", "values": []}
+	]}`, string(result.Data))
+
+	assert.Equal(t, []domain.ContentFacet{domain.ContentFacetChannel, domain.ContentFacetPublishYear}, got.Facets)
+	require.NotNil(t, got.Filter)
+	assert.Equal(t, []string{"go"}, got.Filter.TagsAny)
+	require.NotNil(t, got.Filter.PublishedAt)
+	assert.Equal(t, time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC), *got.Filter.PublishedAt.From)
+	require.NotNil(t, got.Filter.Search)
+	assert.Equal(t, "climate", *got.Filter.Search)
+}
+
+func TestContentFacetsQuery_Errors(t *testing.T) {
+	tests := []struct {
+		name    string
+		query   string
+		repoErr error
+		want    string
+	}{
+		{name: "no facets", query: `{ contentFacets(facets: []) { facet } }`, want: "at least one facet is required"},
+		{name: "invalid filter", query: `{ contentFacets(filter: { createdAt: { from: "soon" } }, facets: [TAG]) { facet } }`, want: "invalid createdAt"},
+		{name: "repository error", query: `{ contentFacets(facets: [TAG]) { facet } }`, repoErr: errors.New("timeout"), want: "failed to count content facets"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &mockContentRepository{
+				facetsFn: func(ctx context.Context, params domain.ContentFacetParams) ([]*domain.ContentFacetCounts, error) {
+					if tt.repoErr == nil {
+						t.Fatal("repository should not be called")
+					}
+					return nil, tt.repoErr
+				},
+			}
+			server := setupTestServer(repo, &mockYouTubeClient{})
+			defer server.Close()
+
+			result := executeGraphQL(t, server, tt.query)
+
+			require.NotEmpty(t, result.Errors)
+			assert.Contains(t, result.Errors[0].Message, tt.want)
+		})
+	}
+}
+
 // --- NewResolver Tests ---
 
 func TestNewResolver(t *testing.T) {
@@ -904,12 +1831,20 @@ func TestNewResolver(t *testing.T) {
 	perspectiveRepo := &mockPerspectiveRepository{}
 	contentService := services.NewContentService(repo, ytClient)
 	userService := services.NewUserService(userRepo, repo, perspectiveRepo)
-	perspectiveService := services.NewPerspectiveService(perspectiveRepo, userRepo)
+	perspectiveService := services.NewPerspectiveService(perspectiveRepo, userRepo, repo)
+
+	quotaService := &mockQuotaService{}
+	transcriptService := services.NewTranscriptService(&mockTranscriptRepository{}, repo, ytClient, &mockCaptionProvider{})
+
+	searchService := services.NewSearchService(&mockSearchRepository{})
 
-	resolver := resolvers.NewResolver(contentService, userService, perspectiveService)
+	resolver := resolvers.NewResolver(contentService, userService, perspectiveService, quotaService, transcriptService, searchService)
 
 	assert.NotNil(t, resolver)
 	assert.Equal(t, contentService, resolver.ContentService)
 	assert.Equal(t, userService, resolver.UserService)
 	assert.Equal(t, perspectiveService, resolver.PerspectiveService)
+	assert.Equal(t, quotaService, resolver.QuotaService)
+	assert.Equal(t, transcriptService, resolver.TranscriptService)
+	assert.Equal(t, searchService, resolver.SearchService)
 }
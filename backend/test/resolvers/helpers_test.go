@@ -52,30 +52,30 @@ func TestPerspectiveDomainToModel_WithCategorizedRatings(t *testing.T) {
 
 // --- Integration Tests for Helpers (via ContentByID resolver) ---
 
-func TestContentByID_ResponseParsing(t *testing.T) {
+func TestContentByID_TypedMetadata(t *testing.T) {
 	url := "https://youtube.com/watch?v=test123"
-
-	// Test with valid YouTube statistics in Response
-	responseJSON := json.RawMessage(`{
-		"items": [{
-			"statistics": {
-				"viewCount": "1000000",
-				"likeCount": "50000",
-				"commentCount": "1500"
-			}
-		}]
-	}`)
+	channel := "Test Channel"
+	description := "About the video"
+	publishedAt := time.Date(2024, 5, 1, 12, 30, 0, 0, time.FixedZone("EST", -5*3600))
+	views, likes, comments := int64(1000000), int64(50000), int64(1500)
 
 	repo := &mockContentRepository{
 		getByIDFn: func(ctx context.Context, id int) (*domain.Content, error) {
 			return &domain.Content{
-				ID:          1,
-				Name:        "Test Video",
-				URL:         &url,
-				ContentType: domain.ContentTypeYouTube,
-				Response:    responseJSON,
-				CreatedAt:   time.Now(),
-				UpdatedAt:   time.Now(),
+				ID:           1,
+				Name:         "Test Video",
+				URL:          &url,
+				ContentType:  domain.ContentTypeYouTube,
+				ChannelTitle: &channel,
+				Description:  &description,
+				PublishedAt:  &publishedAt,
+				Tags:         []string{"go", "graphql"},
+				ViewCount:    &views,
+				LikeCount:    &likes,
+				CommentCount: &comments,
+				Response:     nil, // Stored with response_storage "none"
+				CreatedAt:    time.Now(),
+				UpdatedAt:    time.Now(),
 			}, nil
 		},
 	}
@@ -83,56 +83,64 @@ func TestContentByID_ResponseParsing(t *testing.T) {
 	server := setupTestServer(repo, &mockYouTubeClient{})
 	defer server.Close()
 
-	result := executeGraphQL(t, server, `{ contentByID(id: "1") { id name viewCount likeCount commentCount } }`)
+	result := executeGraphQL(t, server, `{ contentByID(id: "1") {
+		id name viewCount likeCount commentCount channelTitle description publishedAt tags response
+	} }`)
 
 	assert.Empty(t, result.Errors)
 
 	var data struct {
 		ContentByID struct {
-			ID           string `json:"id"`
-			Name         string `json:"name"`
-			ViewCount    *int   `json:"viewCount"`
-			LikeCount    *int   `json:"likeCount"`
-			CommentCount *int   `json:"commentCount"`
+			ID           string         `json:"id"`
+			Name         string         `json:"name"`
+			ViewCount    *int           `json:"viewCount"`
+			LikeCount    *int           `json:"likeCount"`
+			CommentCount *int           `json:"commentCount"`
+			ChannelTitle *string        `json:"channelTitle"`
+			Description  *string        `json:"description"`
+			PublishedAt  *string        `json:"publishedAt"`
+			Tags         []string       `json:"tags"`
+			Response     map[string]any `json:"response"`
 		} `json:"contentByID"`
 	}
 	err := json.Unmarshal(result.Data, &data)
 	require.NoError(t, err)
 
-	assert.Equal(t, "1", data.ContentByID.ID)
-	assert.Equal(t, "Test Video", data.ContentByID.Name)
-	require.NotNil(t, data.ContentByID.ViewCount)
-	assert.Equal(t, 1000000, *data.ContentByID.ViewCount)
-	require.NotNil(t, data.ContentByID.LikeCount)
-	assert.Equal(t, 50000, *data.ContentByID.LikeCount)
-	require.NotNil(t, data.ContentByID.CommentCount)
-	assert.Equal(t, 1500, *data.ContentByID.CommentCount)
+	c := data.ContentByID
+	assert.Equal(t, "1", c.ID)
+	assert.Equal(t, "Test Video", c.Name)
+	require.NotNil(t, c.ViewCount)
+	assert.Equal(t, 1000000, *c.ViewCount)
+	require.NotNil(t, c.LikeCount)
+	assert.Equal(t, 50000, *c.LikeCount)
+	require.NotNil(t, c.CommentCount)
+	assert.Equal(t, 1500, *c.CommentCount)
+	require.NotNil(t, c.ChannelTitle)
+	assert.Equal(t, "Test Channel", *c.ChannelTitle)
+	require.NotNil(t, c.Description)
+	assert.Equal(t, "About the video", *c.Description)
+	require.NotNil(t, c.PublishedAt)
+	assert.Equal(t, "2024-05-01T17:30:00Z", *c.PublishedAt, "publishedAt is normalized to UTC")
+	assert.Equal(t, []string{"go", "graphql"}, c.Tags)
+	assert.Nil(t, c.Response)
 }
 
-func TestContentByID_InvalidStatistics(t *testing.T) {
+func TestContentByID_HiddenStatistics(t *testing.T) {
 	url := "https://youtube.com/watch?v=test123"
-
-	// Test with invalid statistics (non-numeric strings)
-	responseJSON := json.RawMessage(`{
-		"items": [{
-			"statistics": {
-				"viewCount": "not-a-number",
-				"likeCount": "50000",
-				"commentCount": "1500"
-			}
-		}]
-	}`)
+	views, comments := int64(1000000), int64(1500)
 
 	repo := &mockContentRepository{
 		getByIDFn: func(ctx context.Context, id int) (*domain.Content, error) {
 			return &domain.Content{
-				ID:          1,
-				Name:        "Test Video",
-				URL:         &url,
-				ContentType: domain.ContentTypeYouTube,
-				Response:    responseJSON,
-				CreatedAt:   time.Now(),
-				UpdatedAt:   time.Now(),
+				ID:           1,
+				Name:         "Test Video",
+				URL:          &url,
+				ContentType:  domain.ContentTypeYouTube,
+				ViewCount:    &views,
+				LikeCount:    nil, // Likes hidden by the channel
+				CommentCount: &comments,
+				CreatedAt:    time.Now(),
+				UpdatedAt:    time.Now(),
 			}, nil
 		},
 	}
@@ -140,14 +148,13 @@ func TestContentByID_InvalidStatistics(t *testing.T) {
 	server := setupTestServer(repo, &mockYouTubeClient{})
 	defer server.Close()
 
-	result := executeGraphQL(t, server, `{ contentByID(id: "1") { id name viewCount likeCount commentCount } }`)
+	result := executeGraphQL(t, server, `{ contentByID(id: "1") { id viewCount likeCount commentCount } }`)
 
 	assert.Empty(t, result.Errors)
 
 	var data struct {
 		ContentByID struct {
 			ID           string `json:"id"`
-			Name         string `json:"name"`
 			ViewCount    *int   `json:"viewCount"`
 			LikeCount    *int   `json:"likeCount"`
 			CommentCount *int   `json:"commentCount"`
@@ -156,10 +163,9 @@ func TestContentByID_InvalidStatistics(t *testing.T) {
 	err := json.Unmarshal(result.Data, &data)
 	require.NoError(t, err)
 
-	// ViewCount should be nil due to parse error, but others should succeed
-	assert.Nil(t, data.ContentByID.ViewCount)
-	require.NotNil(t, data.ContentByID.LikeCount)
-	assert.Equal(t, 50000, *data.ContentByID.LikeCount)
+	require.NotNil(t, data.ContentByID.ViewCount)
+	assert.Equal(t, 1000000, *data.ContentByID.ViewCount)
+	assert.Nil(t, data.ContentByID.LikeCount)
 	require.NotNil(t, data.ContentByID.CommentCount)
 	assert.Equal(t, 1500, *data.ContentByID.CommentCount)
 }
@@ -250,15 +256,14 @@ func TestContentByID_InvalidResponseJSON(t *testing.T) {
 	assert.Nil(t, data.ContentByID.Response) // Invalid JSON results in nil
 }
 
-func TestContentByID_NoStatisticsInResponse(t *testing.T) {
+func TestContentByID_StatisticsNotReadFromResponse(t *testing.T) {
 	url := "https://youtube.com/watch?v=test123"
 
-	// Valid JSON but no statistics field
+	// Statistics come from the typed columns; a stored response is only echoed back
 	responseJSON := json.RawMessage(`{
 		"items": [{
-			"snippet": {
-				"title": "Some Title"
-			}
+			"snippet": {"channelTitle": "Response Channel"},
+			"statistics": {"viewCount": "1000000"}
 		}]
 	}`)
 
@@ -279,84 +284,24 @@ func TestContentByID_NoStatisticsInResponse(t *testing.T) {
 	server := setupTestServer(repo, &mockYouTubeClient{})
 	defer server.Close()
 
-	result := executeGraphQL(t, server, `{ contentByID(id: "1") { id name viewCount likeCount commentCount } }`)
+	result := executeGraphQL(t, server, `{ contentByID(id: "1") { id viewCount channelTitle response } }`)
 
 	assert.Empty(t, result.Errors)
 
 	var data struct {
 		ContentByID struct {
-			ID           string `json:"id"`
-			Name         string `json:"name"`
-			ViewCount    *int   `json:"viewCount"`
-			LikeCount    *int   `json:"likeCount"`
-			CommentCount *int   `json:"commentCount"`
-		} `json:"contentByID"`
-	}
-	err := json.Unmarshal(result.Data, &data)
-	require.NoError(t, err)
-
-	// Empty stat strings default to 0
-	require.NotNil(t, data.ContentByID.ViewCount)
-	assert.Equal(t, 0, *data.ContentByID.ViewCount)
-	require.NotNil(t, data.ContentByID.LikeCount)
-	assert.Equal(t, 0, *data.ContentByID.LikeCount)
-	require.NotNil(t, data.ContentByID.CommentCount)
-	assert.Equal(t, 0, *data.ContentByID.CommentCount)
-}
-
-func TestContentByID_EmptyStatisticStrings(t *testing.T) {
-	url := "https://youtube.com/watch?v=test123"
-
-	// YouTube API sometimes returns empty strings for statistics
-	responseJSON := json.RawMessage(`{
-		"items": [{
-			"statistics": {
-				"viewCount": "",
-				"likeCount": "",
-				"commentCount": ""
-			}
-		}]
-	}`)
-
-	repo := &mockContentRepository{
-		getByIDFn: func(ctx context.Context, id int) (*domain.Content, error) {
-			return &domain.Content{
-				ID:          1,
-				Name:        "Test Video",
-				URL:         &url,
-				ContentType: domain.ContentTypeYouTube,
-				Response:    responseJSON,
-				CreatedAt:   time.Now(),
-				UpdatedAt:   time.Now(),
-			}, nil
-		},
-	}
-
-	server := setupTestServer(repo, &mockYouTubeClient{})
-	defer server.Close()
-
-	result := executeGraphQL(t, server, `{ contentByID(id: "1") { id viewCount likeCount commentCount } }`)
-
-	assert.Empty(t, result.Errors)
-
-	var data struct {
-		ContentByID struct {
-			ID           string `json:"id"`
-			ViewCount    *int   `json:"viewCount"`
-			LikeCount    *int   `json:"likeCount"`
-			CommentCount *int   `json:"commentCount"`
+			ID           string         `json:"id"`
+			ViewCount    *int           `json:"viewCount"`
+			ChannelTitle *string        `json:"channelTitle"`
+			Response     map[string]any `json:"response"`
 		} `json:"contentByID"`
 	}
 	err := json.Unmarshal(result.Data, &data)
 	require.NoError(t, err)
 
-	// Empty strings should default to 0, not cause parse errors
-	require.NotNil(t, data.ContentByID.ViewCount)
-	assert.Equal(t, 0, *data.ContentByID.ViewCount)
-	require.NotNil(t, data.ContentByID.LikeCount)
-	assert.Equal(t, 0, *data.ContentByID.LikeCount)
-	require.NotNil(t, data.ContentByID.CommentCount)
-	assert.Equal(t, 0, *data.ContentByID.CommentCount)
+	assert.Nil(t, data.ContentByID.ViewCount)
+	assert.Nil(t, data.ContentByID.ChannelTitle)
+	assert.NotNil(t, data.ContentByID.Response)
 }
 
 func TestContentByID_EmptyItemsArray(t *testing.T) {
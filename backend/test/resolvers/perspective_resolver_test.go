@@ -0,0 +1,277 @@
+package resolvers_test
+
+import (
+	"context"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/99designs/gqlgen/graphql/handler"
+	"github.com/CodeWarrior-debug/perspectize/backend/internal/adapters/graphql/generated"
+	"github.com/CodeWarrior-debug/perspectize/backend/internal/adapters/graphql/resolvers"
+	"github.com/CodeWarrior-debug/perspectize/backend/internal/core/domain"
+	"github.com/CodeWarrior-debug/perspectize/backend/internal/core/services"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// setupPerspectiveTestServer creates a test GraphQL server with a perspective
+// service whose users all exist and whose content is lengthSeconds long
+func setupPerspectiveTestServer(perspectiveRepo *mockPerspectiveRepository, lengthSeconds int) *httptest.Server {
+	units := "seconds"
+	contentRepo := &mockContentRepository{
+		getByIDFn: func(ctx context.Context, id int) (*domain.Content, error) {
+			return &domain.Content{ID: id, Length: &lengthSeconds, LengthUnits: &units}, nil
+		},
+	}
+	userRepo := &mockUserRepository{
+		getByIDFn: func(ctx context.Context, id int) (*domain.User, error) {
+			return &domain.User{ID: id}, nil
+		},
+	}
+	perspectiveService := services.NewPerspectiveService(perspectiveRepo, userRepo, contentRepo)
+	resolver := resolvers.NewResolver(nil, nil, perspectiveService, nil, nil, nil)
+	srv := handler.NewDefaultServer(generated.NewExecutableSchema(generated.Config{Resolvers: resolver}))
+	return httptest.NewServer(srv)
+}
+
+func TestCreatePerspective_Segments(t *testing.T) {
+	var saved *domain.Perspective
+	perspectiveRepo := &mockPerspectiveRepository{
+		createFn: func(ctx context.Context, p *domain.Perspective) (*domain.Perspective, error) {
+			p.ID = 12
+			saved = p
+			return p, nil
+		},
+	}
+	server := setupPerspectiveTestServer(perspectiveRepo, 600)
+	defer server.Close()
+
+	result := executeGraphQL(t, server, `mutation {
+		createPerspective(input: {
+			userID: 1, contentID: 5, parts: [3, 7],
+			segments: [
+				{start: 120, end: 180.25},
+				{start: 61.5, end: 90, note: "the key claim", quality: 8000}
+			]
+		}) {
+			parts
+			segments { start end note quality agreement }
+		}
+	}`)
+	require.Empty(t, result.Errors)
+	assert.JSONEq(t, `{"createPerspective": {
+		"parts": [3, 7],
+		"segments": [
+			{"start": 61.5, "end": 90, "note": "the key claim", "quality": 8000, "agreement": null},
+			{"start": 120, "end": 180.25, "note": null, "quality": null, "agreement": null}
+		]
+	}}`, string(result.Data))
+
+	require.NotNil(t, saved)
+	require.Len(t, saved.Segments, 2)
+	assert.Equal(t, 61500, saved.Segments[0].StartMs)
+	assert.Equal(t, 180250, saved.Segments[1].EndMs)
+	assert.Equal(t, 1, saved.Segments[1].Position)
+}
+
+func TestCreatePerspective_SegmentPastContentLength(t *testing.T) {
+	server := setupPerspectiveTestServer(&mockPerspectiveRepository{}, 60)
+	defer server.Close()
+
+	result := executeGraphQL(t, server, `mutation {
+		createPerspective(input: {userID: 1, contentID: 5, segments: [{start: 30, end: 75}]}) { id }
+	}`)
+
+	require.NotEmpty(t, result.Errors)
+	assert.Contains(t, result.Errors[0].Message, "invalid input")
+	assert.Contains(t, result.Errors[0].Message, "after the content's length of 60s")
+}
+
+func TestCreatePerspective_SegmentTimeOutOfRange(t *testing.T) {
+	perspectiveRepo := &mockPerspectiveRepository{
+		createFn: func(ctx context.Context, p *domain.Perspective) (*domain.Perspective, error) {
+			t.Fatal("repository should not be called")
+			return nil, nil
+		},
+	}
+	server := setupPerspectiveTestServer(perspectiveRepo, 600)
+	defer server.Close()
+
+	for _, segment := range []string{`{start: 0, end: 1e12}`, `{start: -3e6, end: 30}`} {
+		result := executeGraphQL(t, server, `mutation {
+			createPerspective(input: {userID: 1, contentID: 5, segments: [`+segment+`]}) { id }
+		}`)
+
+		require.NotEmpty(t, result.Errors, segment)
+		assert.Contains(t, result.Errors[0].Message, "invalid input")
+		assert.Contains(t, result.Errors[0].Message, "is out of range")
+	}
+}
+
+func TestPerspectivesQuery_SearchByRelevance(t *testing.T) {
+	perspectiveRepo := &mockPerspectiveRepository{
+		listFn: func(ctx context.Context, params domain.PerspectiveListParams) (*domain.PaginatedPerspectives, error) {
+			require.NotNil(t, params.Filter)
+			require.NotNil(t, params.Filter.Search)
+			assert.Equal(t, "misinformation", *params.Filter.Search)
+			require.NotNil(t, params.Filter.Privacy)
+			assert.Equal(t, domain.PrivacyPublic, *params.Filter.Privacy)
+			assert.Equal(t, domain.PerspectiveSortByRelevance, params.SortBy)
+
+			description := "spreads <b>misinformation</b> about vaccines"
+			return &domain.PaginatedPerspectives{
+				Items: []*domain.Perspective{{
+					ID:      5,
+					UserID:  2,
+					Privacy: domain.PrivacyPublic,
+					SearchMatch: &domain.PerspectiveSearchMatch{
+						Rank:                 0.6,
+						DescriptionHighlight: &description,
+					},
+				}},
+			}, nil
+		},
+	}
+	server := setupPerspectiveTestServer(perspectiveRepo, 600)
+	defer server.Close()
+
+	result := executeGraphQL(t, server, `{ perspectives(sortBy: RELEVANCE, filter: { search: "misinformation" }) {
+		items { id searchMatch { rank description like } }
+	} }`)
+
+	require.Empty(t, result.Errors)
+	assert.JSONEq(t, `{"perspectives": {"items": [{"id": "5", "searchMatch": {
+		"rank": 0.6,
+		"description": "spreads <b>misinformation</b> about vaccines",
+		"like": null
+	}}]}}`, string(result.Data))
+}
+
+func TestPerspectivesQuery_SearchPrivate(t *testing.T) {
+	perspectiveRepo := &mockPerspectiveRepository{
+		listFn: func(ctx context.Context, params domain.PerspectiveListParams) (*domain.PaginatedPerspectives, error) {
+			t.Fatal("repository should not be called")
+			return nil, nil
+		},
+	}
+	server := setupPerspectiveTestServer(perspectiveRepo, 600)
+	defer server.Close()
+
+	result := executeGraphQL(t, server, `{ perspectives(filter: { search: "hoax", privacy: PRIVATE }) { items { id } } }`)
+
+	require.NotEmpty(t, result.Errors)
+	assert.Contains(t, result.Errors[0].Message, "search covers public perspectives only")
+}
+
+func TestPerspectivesQuery_Filters(t *testing.T) {
+	perspectiveRepo := &mockPerspectiveRepository{
+		listFn: func(ctx context.Context, params domain.PerspectiveListParams) (*domain.PaginatedPerspectives, error) {
+			f := params.Filter
+			require.NotNil(t, f)
+			require.NotNil(t, f.Quality)
+			assert.Equal(t, 5000, *f.Quality.Min)
+			assert.Nil(t, f.Quality.Max)
+			require.NotNil(t, f.Importance)
+			assert.Equal(t, 8000, *f.Importance.Max)
+			assert.Equal(t, []string{"health", "policy"}, f.LabelsAny)
+			assert.Equal(t, []string{"vaccines"}, f.LabelsAll)
+			assert.Equal(t, "science", *f.Category)
+			assert.Equal(t, domain.ReviewStatusApproved, *f.ReviewStatus)
+			assert.Equal(t, &domain.CategorizedRatingFilter{Category: "accuracy", MinRating: 7000}, f.CategorizedRating)
+			require.NotNil(t, f.CreatedAt)
+			assert.Equal(t, time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC), *f.CreatedAt.From)
+			assert.Nil(t, f.CreatedAt.To)
+			return &domain.PaginatedPerspectives{Items: []*domain.Perspective{}}, nil
+		},
+	}
+	server := setupPerspectiveTestServer(perspectiveRepo, 600)
+	defer server.Close()
+
+	result := executeGraphQL(t, server, `{ perspectives(filter: {
+		quality: { min: 5000 }
+		importance: { max: 8000 }
+		labelsAny: ["health", "policy"]
+		labelsAll: ["vaccines"]
+		category: "science"
+		reviewStatus: APPROVED
+		categorizedRating: { category: "accuracy", minRating: 7000 }
+		createdAt: { from: "2026-01-01" }
+	}) { items { id } } }`)
+
+	require.Empty(t, result.Errors)
+}
+
+func TestPerspectivesQuery_CountStrategy(t *testing.T) {
+	var got domain.PerspectiveListParams
+	perspectiveRepo := &mockPerspectiveRepository{
+		listFn: func(ctx context.Context, params domain.PerspectiveListParams) (*domain.PaginatedPerspectives, error) {
+			got = params
+			total := 125000
+			return &domain.PaginatedPerspectives{Items: []*domain.Perspective{}, TotalCount: &total}, nil
+		},
+	}
+	server := setupPerspectiveTestServer(perspectiveRepo, 600)
+	defer server.Close()
+
+	result := executeGraphQL(t, server, `{ perspectives(includeTotalCount: true, countStrategy: ESTIMATED) {
+		totalCount pageInfo { totalCountExact }
+	} }`)
+
+	require.Empty(t, result.Errors)
+	assert.JSONEq(t, `{"perspectives": {"totalCount": 125000, "pageInfo": {"totalCountExact": false}}}`, string(result.Data))
+	assert.True(t, got.IncludeTotalCount)
+	assert.Equal(t, domain.CountStrategyEstimated, got.CountStrategy)
+}
+
+func TestPerspectivesQuery_InvalidDateRange(t *testing.T) {
+	server := setupPerspectiveTestServer(&mockPerspectiveRepository{}, 600)
+	defer server.Close()
+
+	result := executeGraphQL(t, server, `{ perspectives(filter: { updatedAt: { to: "last week" } }) { items { id } } }`)
+
+	require.NotEmpty(t, result.Errors)
+	assert.Contains(t, result.Errors[0].Message, "invalid updatedAt: invalid to date: last week")
+}
+
+func TestPerspectivesQuery_SortByCategorizedRating(t *testing.T) {
+	perspectiveRepo := &mockPerspectiveRepository{
+		listFn: func(ctx context.Context, params domain.PerspectiveListParams) (*domain.PaginatedPerspectives, error) {
+			assert.Equal(t, domain.PerspectiveSortByCategorizedRating, params.SortBy)
+			assert.Equal(t, domain.SortOrderAsc, params.SortOrder)
+			assert.Equal(t, "clarity", params.SortCategory)
+			return &domain.PaginatedPerspectives{Items: []*domain.Perspective{}}, nil
+		},
+	}
+	server := setupPerspectiveTestServer(perspectiveRepo, 600)
+	defer server.Close()
+
+	result := executeGraphQL(t, server, `{ perspectives(sortBy: CATEGORIZED_RATING, sortOrder: ASC, sortCategory: "clarity") { items { id } } }`)
+
+	require.Empty(t, result.Errors)
+}
+
+func TestPerspectivesQuery_RatingSorts(t *testing.T) {
+	for _, sortBy := range []domain.PerspectiveSortBy{
+		domain.PerspectiveSortByQuality,
+		domain.PerspectiveSortByAgreement,
+		domain.PerspectiveSortByImportance,
+		domain.PerspectiveSortByConfidence,
+		domain.PerspectiveSortByContentName,
+	} {
+		t.Run(string(sortBy), func(t *testing.T) {
+			perspectiveRepo := &mockPerspectiveRepository{
+				listFn: func(ctx context.Context, params domain.PerspectiveListParams) (*domain.PaginatedPerspectives, error) {
+					assert.Equal(t, sortBy, params.SortBy)
+					return &domain.PaginatedPerspectives{Items: []*domain.Perspective{}}, nil
+				},
+			}
+			server := setupPerspectiveTestServer(perspectiveRepo, 600)
+			defer server.Close()
+
+			result := executeGraphQL(t, server, `{ perspectives(sortBy: `+string(sortBy)+`) { items { id } } }`)
+
+			require.Empty(t, result.Errors)
+		})
+	}
+}
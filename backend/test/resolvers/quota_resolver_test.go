@@ -0,0 +1,172 @@
+package resolvers_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/99designs/gqlgen/graphql/handler"
+	"github.com/CodeWarrior-debug/perspectize/backend/internal/adapters/graphql/generated"
+	"github.com/CodeWarrior-debug/perspectize/backend/internal/adapters/graphql/resolvers"
+	"github.com/CodeWarrior-debug/perspectize/backend/internal/core/domain"
+	portservices "github.com/CodeWarrior-debug/perspectize/backend/internal/core/ports/services"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// mockQuotaService implements services.QuotaService for testing
+type mockQuotaService struct {
+	usageFn func(ctx context.Context) (*domain.YouTubeQuotaUsage, error)
+}
+
+func (m *mockQuotaService) Usage(ctx context.Context) (*domain.YouTubeQuotaUsage, error) {
+	if m.usageFn != nil {
+		return m.usageFn(ctx)
+	}
+	return &domain.YouTubeQuotaUsage{Breaker: domain.CircuitClosed}, nil
+}
+
+// setupQuotaTestServer creates a test GraphQL server with only a quota service
+func setupQuotaTestServer(quota *mockQuotaService) *httptest.Server {
+	resolver := resolvers.NewResolver(nil, nil, nil, quota, nil, nil)
+	srv := handler.NewDefaultServer(generated.NewExecutableSchema(generated.Config{Resolvers: resolver}))
+	return httptest.NewServer(srv)
+}
+
+const youtubeQuotaQuery = `{ youtubeQuota {
+	day resetsAt budget used remaining byMethod { method units } breaker breakerOpenUntil
+} }`
+
+func TestYoutubeQuota_Success(t *testing.T) {
+	pacific, err := time.LoadLocation("America/Los_Angeles")
+	require.NoError(t, err)
+	day := time.Date(2026, 10, 18, 0, 0, 0, 0, pacific)
+	openUntil := time.Date(2026, 10, 18, 20, 5, 0, 0, time.UTC)
+
+	quota := &mockQuotaService{
+		usageFn: func(ctx context.Context) (*domain.YouTubeQuotaUsage, error) {
+			return &domain.YouTubeQuotaUsage{
+				Day:      day,
+				ResetsAt: day.AddDate(0, 0, 1),
+				Budget:   100,
+				Used:     30,
+				ByMethod: []domain.QuotaMethodUsage{
+					{Method: "playlistItems.list", Units: 5},
+					{Method: "videos.list", Units: 25},
+				},
+				Breaker:          domain.CircuitOpen,
+				BreakerOpenUntil: &openUntil,
+			}, nil
+		},
+	}
+
+	server := setupQuotaTestServer(quota)
+	defer server.Close()
+
+	result := executeGraphQL(t, server, youtubeQuotaQuery)
+	require.Empty(t, result.Errors)
+
+	var data struct {
+		YoutubeQuota struct {
+			Day       string `json:"day"`
+			ResetsAt  string `json:"resetsAt"`
+			Budget    *int   `json:"budget"`
+			Used      int    `json:"used"`
+			Remaining *int   `json:"remaining"`
+			ByMethod  []struct {
+				Method string `json:"method"`
+				Units  int    `json:"units"`
+			} `json:"byMethod"`
+			Breaker          string  `json:"breaker"`
+			BreakerOpenUntil *string `json:"breakerOpenUntil"`
+		} `json:"youtubeQuota"`
+	}
+	require.NoError(t, json.Unmarshal(result.Data, &data))
+
+	q := data.YoutubeQuota
+	assert.Equal(t, "2026-10-18", q.Day)
+	assert.Equal(t, "2026-10-19T00:00:00-07:00", q.ResetsAt)
+	require.NotNil(t, q.Budget)
+	assert.Equal(t, 100, *q.Budget)
+	assert.Equal(t, 30, q.Used)
+	require.NotNil(t, q.Remaining)
+	assert.Equal(t, 70, *q.Remaining)
+	require.Len(t, q.ByMethod, 2)
+	assert.Equal(t, "videos.list", q.ByMethod[1].Method)
+	assert.Equal(t, 25, q.ByMethod[1].Units)
+	assert.Equal(t, "OPEN", q.Breaker)
+	require.NotNil(t, q.BreakerOpenUntil)
+	assert.Equal(t, "2026-10-18T20:05:00Z", *q.BreakerOpenUntil)
+}
+
+func TestYoutubeQuota_NoBudget(t *testing.T) {
+	server := setupQuotaTestServer(&mockQuotaService{})
+	defer server.Close()
+
+	result := executeGraphQL(t, server, `{ youtubeQuota { budget remaining breaker breakerOpenUntil } }`)
+	require.Empty(t, result.Errors)
+
+	var data struct {
+		YoutubeQuota struct {
+			Budget           *int    `json:"budget"`
+			Remaining        *int    `json:"remaining"`
+			Breaker          string  `json:"breaker"`
+			BreakerOpenUntil *string `json:"breakerOpenUntil"`
+		} `json:"youtubeQuota"`
+	}
+	require.NoError(t, json.Unmarshal(result.Data, &data))
+
+	assert.Nil(t, data.YoutubeQuota.Budget)
+	assert.Nil(t, data.YoutubeQuota.Remaining)
+	assert.Equal(t, "CLOSED", data.YoutubeQuota.Breaker)
+	assert.Nil(t, data.YoutubeQuota.BreakerOpenUntil)
+}
+
+func TestYoutubeQuota_Error(t *testing.T) {
+	server := setupQuotaTestServer(&mockQuotaService{
+		usageFn: func(ctx context.Context) (*domain.YouTubeQuotaUsage, error) {
+			return nil, errors.New("connection refused")
+		},
+	})
+	defer server.Close()
+
+	result := executeGraphQL(t, server, `{ youtubeQuota { used } }`)
+
+	require.NotEmpty(t, result.Errors)
+	assert.Contains(t, result.Errors[0].Message, "failed to get quota usage")
+}
+
+func TestYoutubeQuota_NotConfigured(t *testing.T) {
+	server := setupTestServer(&mockContentRepository{}, &mockYouTubeClient{})
+	defer server.Close()
+
+	result := executeGraphQL(t, server, `{ youtubeQuota { used } }`)
+
+	require.NotEmpty(t, result.Errors)
+	assert.Contains(t, result.Errors[0].Message, "quota tracking is not enabled")
+}
+
+func TestCreateContentFromYouTube_QuotaExceeded(t *testing.T) {
+	repo := &mockContentRepository{}
+	ytClient := &mockYouTubeClient{
+		extractVideoIDFn: func(url string) (string, error) {
+			return "dQw4w9WgXcQ", nil
+		},
+		getVideoMetadataFn: func(ctx context.Context, videoID string) (*portservices.VideoMetadata, error) {
+			return nil, domain.ErrQuotaExceeded
+		},
+	}
+
+	server := setupTestServer(repo, ytClient)
+	defer server.Close()
+
+	result := executeGraphQL(t, server, `mutation {
+		createContentFromYouTube(input: {url: "https://www.youtube.com/watch?v=dQw4w9WgXcQ"}) { created }
+	}`)
+
+	require.NotEmpty(t, result.Errors)
+	assert.Contains(t, result.Errors[0].Message, "YouTube quota exhausted")
+}
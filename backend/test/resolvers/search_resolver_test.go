@@ -0,0 +1,134 @@
+package resolvers_test
+
+import (
+	"context"
+	"errors"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/99designs/gqlgen/graphql/handler"
+	"github.com/CodeWarrior-debug/perspectize/backend/internal/adapters/graphql/generated"
+	"github.com/CodeWarrior-debug/perspectize/backend/internal/adapters/graphql/resolvers"
+	"github.com/CodeWarrior-debug/perspectize/backend/internal/core/domain"
+	"github.com/CodeWarrior-debug/perspectize/backend/internal/core/services"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// mockSearchRepository implements repositories.SearchRepository for testing
+type mockSearchRepository struct {
+	suggestFn func(ctx context.Context, query string, limit int) ([]*domain.SearchSuggestion, error)
+}
+
+func (m *mockSearchRepository) Suggest(ctx context.Context, query string, limit int) ([]*domain.SearchSuggestion, error) {
+	if m.suggestFn != nil {
+		return m.suggestFn(ctx, query, limit)
+	}
+	return []*domain.SearchSuggestion{}, nil
+}
+
+// setupSearchTestServer creates a test GraphQL server with user and search services
+func setupSearchTestServer(userRepo *mockUserRepository, searchRepo *mockSearchRepository) *httptest.Server {
+	contentRepo := &mockContentRepository{}
+	userService := services.NewUserService(userRepo, contentRepo, &mockPerspectiveRepository{})
+	searchService := services.NewSearchService(searchRepo)
+	resolver := resolvers.NewResolver(nil, userService, nil, nil, nil, searchService)
+	srv := handler.NewDefaultServer(generated.NewExecutableSchema(generated.Config{Resolvers: resolver}))
+	return httptest.NewServer(srv)
+}
+
+func TestSearchUsersQuery(t *testing.T) {
+	userRepo := &mockUserRepository{
+		searchFn: func(ctx context.Context, query string, limit int) ([]*domain.User, error) {
+			assert.Equal(t, "alcie", query)
+			assert.Equal(t, 3, limit)
+			return []*domain.User{
+				{ID: 1, Username: "alice", Active: true},
+				{ID: 7, Username: "alicia", Active: true},
+			}, nil
+		},
+	}
+	server := setupSearchTestServer(userRepo, &mockSearchRepository{})
+	defer server.Close()
+
+	result := executeGraphQL(t, server, `{ searchUsers(query: "alcie", first: 3) { id username } }`)
+
+	require.Empty(t, result.Errors)
+	assert.JSONEq(t, `{"searchUsers": [
+		{"id": "1", "username": "alice"},
+		{"id": "7", "username": "alicia"}
+	]}`, string(result.Data))
+}
+
+func TestSearchUsersQuery_Errors(t *testing.T) {
+	tests := []struct {
+		name     string
+		query    string
+		searchFn func(ctx context.Context, query string, limit int) ([]*domain.User, error)
+		want     string
+	}{
+		{
+			name:  "empty query",
+			query: `{ searchUsers(query: " ") { id } }`,
+			want:  "search is required",
+		},
+		{
+			name:  "limit too large",
+			query: `{ searchUsers(query: "alice", first: 500) { id } }`,
+			want:  "first must be between 1 and 100",
+		},
+		{
+			name:  "repository failure is hidden",
+			query: `{ searchUsers(query: "alice") { id } }`,
+			searchFn: func(ctx context.Context, query string, limit int) ([]*domain.User, error) {
+				return nil, errors.New("pq: extension pg_trgm is not installed")
+			},
+			want: "failed to search users",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := setupSearchTestServer(&mockUserRepository{searchFn: tt.searchFn}, &mockSearchRepository{})
+			defer server.Close()
+
+			result := executeGraphQL(t, server, tt.query)
+
+			require.NotEmpty(t, result.Errors)
+			assert.Contains(t, result.Errors[0].Message, tt.want)
+		})
+	}
+}
+
+func TestSearchSuggestionsQuery(t *testing.T) {
+	searchRepo := &mockSearchRepository{
+		suggestFn: func(ctx context.Context, query string, limit int) ([]*domain.SearchSuggestion, error) {
+			assert.Equal(t, "climat chnage", query)
+			assert.Equal(t, 5, limit)
+			return []*domain.SearchSuggestion{
+				{Text: "Climate Change Explained", Kind: domain.SearchSuggestionContent, Similarity: 0.5},
+				{Text: "Climate Channel", Kind: domain.SearchSuggestionChannel, Similarity: 0.375},
+			}, nil
+		},
+	}
+	server := setupSearchTestServer(&mockUserRepository{}, searchRepo)
+	defer server.Close()
+
+	result := executeGraphQL(t, server, `{ searchSuggestions(query: "climat chnage") { text kind similarity } }`)
+
+	require.Empty(t, result.Errors)
+	assert.JSONEq(t, `{"searchSuggestions": [
+		{"text": "Climate Change Explained", "kind": "CONTENT", "similarity": 0.5},
+		{"text": "Climate Channel", "kind": "CHANNEL", "similarity": 0.375}
+	]}`, string(result.Data))
+}
+
+func TestSearchSuggestionsQuery_InvalidLimit(t *testing.T) {
+	server := setupSearchTestServer(&mockUserRepository{}, &mockSearchRepository{})
+	defer server.Close()
+
+	result := executeGraphQL(t, server, `{ searchSuggestions(query: "climate", first: 50) { text } }`)
+
+	require.NotEmpty(t, result.Errors)
+	assert.Contains(t, result.Errors[0].Message, "first must be between 1 and 20")
+}
@@ -0,0 +1,274 @@
+package resolvers_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/99designs/gqlgen/graphql/handler"
+	"github.com/CodeWarrior-debug/perspectize/backend/internal/adapters/graphql/generated"
+	"github.com/CodeWarrior-debug/perspectize/backend/internal/adapters/graphql/resolvers"
+	"github.com/CodeWarrior-debug/perspectize/backend/internal/core/domain"
+	portservices "github.com/CodeWarrior-debug/perspectize/backend/internal/core/ports/services"
+	"github.com/CodeWarrior-debug/perspectize/backend/internal/core/services"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// mockTranscriptRepository implements repositories.TranscriptRepository for testing
+type mockTranscriptRepository struct {
+	replaceFn      func(ctx context.Context, transcript *domain.Transcript) (*domain.Transcript, error)
+	listSegmentsFn func(ctx context.Context, params domain.TranscriptRangeParams) ([]*domain.TranscriptSegment, error)
+	searchFn       func(ctx context.Context, params domain.TranscriptSearchParams) ([]*domain.TranscriptMatch, error)
+}
+
+func (m *mockTranscriptRepository) Replace(ctx context.Context, transcript *domain.Transcript) (*domain.Transcript, error) {
+	if m.replaceFn != nil {
+		return m.replaceFn(ctx, transcript)
+	}
+	return transcript, nil
+}
+
+func (m *mockTranscriptRepository) ListSegments(ctx context.Context, params domain.TranscriptRangeParams) ([]*domain.TranscriptSegment, error) {
+	if m.listSegmentsFn != nil {
+		return m.listSegmentsFn(ctx, params)
+	}
+	return []*domain.TranscriptSegment{}, nil
+}
+
+func (m *mockTranscriptRepository) Search(ctx context.Context, params domain.TranscriptSearchParams) ([]*domain.TranscriptMatch, error) {
+	if m.searchFn != nil {
+		return m.searchFn(ctx, params)
+	}
+	return []*domain.TranscriptMatch{}, nil
+}
+
+// mockCaptionProvider implements services.CaptionProvider for testing
+type mockCaptionProvider struct {
+	fetchCaptionsFn func(ctx context.Context, videoID, language string) (*portservices.Captions, error)
+}
+
+func (m *mockCaptionProvider) FetchCaptions(ctx context.Context, videoID, language string) (*portservices.Captions, error) {
+	if m.fetchCaptionsFn != nil {
+		return m.fetchCaptionsFn(ctx, videoID, language)
+	}
+	return nil, domain.ErrNotFound
+}
+
+// setupTranscriptTestServer creates a test GraphQL server with content and transcript services
+func setupTranscriptTestServer(repo *mockContentRepository, transcripts *mockTranscriptRepository, captions *mockCaptionProvider) *httptest.Server {
+	ytClient := &mockYouTubeClient{
+		extractVideoIDFn: func(url string) (string, error) {
+			return "dQw4w9WgXcQ", nil
+		},
+	}
+	contentService := services.NewContentService(repo, ytClient)
+	transcriptService := services.NewTranscriptService(transcripts, repo, ytClient, captions)
+	resolver := resolvers.NewResolver(contentService, nil, nil, nil, transcriptService, nil)
+	srv := handler.NewDefaultServer(generated.NewExecutableSchema(generated.Config{Resolvers: resolver}))
+	return httptest.NewServer(srv)
+}
+
+// transcriptContentRepo returns a content repository holding one YouTube video
+func transcriptContentRepo() *mockContentRepository {
+	url := "https://www.youtube.com/watch?v=dQw4w9WgXcQ"
+	return &mockContentRepository{
+		getByIDFn: func(ctx context.Context, id int) (*domain.Content, error) {
+			return &domain.Content{ID: id, Name: "Video", URL: &url, ContentType: domain.ContentTypeYouTube}, nil
+		},
+	}
+}
+
+func TestContentTranscript_TimeWindow(t *testing.T) {
+	var got domain.TranscriptRangeParams
+	transcripts := &mockTranscriptRepository{
+		listSegmentsFn: func(ctx context.Context, params domain.TranscriptRangeParams) ([]*domain.TranscriptSegment, error) {
+			got = params
+			return []*domain.TranscriptSegment{
+				{ContentID: 4, Position: 3, StartMs: 61500, EndMs: 64250, Text: "the part everyone quotes"},
+			}, nil
+		},
+	}
+
+	server := setupTranscriptTestServer(transcriptContentRepo(), transcripts, &mockCaptionProvider{})
+	defer server.Close()
+
+	result := executeGraphQL(t, server, `{ contentByID(id: "4") { transcript(from: 60, to: 90.5) { contentID start end text } } }`)
+	require.Empty(t, result.Errors)
+
+	var data struct {
+		ContentByID struct {
+			Transcript []struct {
+				ContentID string  `json:"contentID"`
+				Start     float64 `json:"start"`
+				End       float64 `json:"end"`
+				Text      string  `json:"text"`
+			} `json:"transcript"`
+		} `json:"contentByID"`
+	}
+	require.NoError(t, json.Unmarshal(result.Data, &data))
+
+	assert.Equal(t, 4, got.ContentID)
+	require.NotNil(t, got.FromMs)
+	require.NotNil(t, got.ToMs)
+	assert.Equal(t, 60000, *got.FromMs)
+	assert.Equal(t, 90500, *got.ToMs)
+
+	require.Len(t, data.ContentByID.Transcript, 1)
+	segment := data.ContentByID.Transcript[0]
+	assert.Equal(t, "4", segment.ContentID)
+	assert.Equal(t, 61.5, segment.Start)
+	assert.Equal(t, 64.25, segment.End)
+	assert.Equal(t, "the part everyone quotes", segment.Text)
+}
+
+func TestContentTranscript_InvalidWindow(t *testing.T) {
+	server := setupTranscriptTestServer(transcriptContentRepo(), &mockTranscriptRepository{}, &mockCaptionProvider{})
+	defer server.Close()
+
+	result := executeGraphQL(t, server, `{ contentByID(id: "4") { transcript(from: 90, to: 60) { text } } }`)
+
+	require.NotEmpty(t, result.Errors)
+	assert.Contains(t, result.Errors[0].Message, "from must be before to")
+}
+
+func TestUploadTranscript_Success(t *testing.T) {
+	updated := time.Date(2026, 10, 18, 12, 0, 0, 0, time.UTC)
+	transcripts := &mockTranscriptRepository{
+		replaceFn: func(ctx context.Context, transcript *domain.Transcript) (*domain.Transcript, error) {
+			transcript.CreatedAt, transcript.UpdatedAt = updated, updated
+			return transcript, nil
+		},
+	}
+
+	server := setupTranscriptTestServer(transcriptContentRepo(), transcripts, &mockCaptionProvider{})
+	defer server.Close()
+
+	result := executeGraphQL(t, server, `mutation {
+		uploadTranscript(input: {contentID: 4, format: SRT, data: "1\n00:00:00,000 --> 00:00:02,000\nHello\n"}) {
+			contentID language source segmentCount updatedAt
+		}
+	}`)
+	require.Empty(t, result.Errors)
+
+	var data struct {
+		UploadTranscript struct {
+			ContentID    string `json:"contentID"`
+			Language     string `json:"language"`
+			Source       string `json:"source"`
+			SegmentCount int    `json:"segmentCount"`
+			UpdatedAt    string `json:"updatedAt"`
+		} `json:"uploadTranscript"`
+	}
+	require.NoError(t, json.Unmarshal(result.Data, &data))
+
+	assert.Equal(t, "4", data.UploadTranscript.ContentID)
+	assert.Equal(t, "en", data.UploadTranscript.Language)
+	assert.Equal(t, "UPLOAD", data.UploadTranscript.Source)
+	assert.Equal(t, 1, data.UploadTranscript.SegmentCount)
+	assert.Equal(t, "2026-10-18T12:00:00Z", data.UploadTranscript.UpdatedAt)
+}
+
+func TestUploadTranscript_ParseError(t *testing.T) {
+	server := setupTranscriptTestServer(transcriptContentRepo(), &mockTranscriptRepository{}, &mockCaptionProvider{})
+	defer server.Close()
+
+	result := executeGraphQL(t, server, `mutation {
+		uploadTranscript(input: {contentID: 4, format: WEBVTT, data: "not a caption file"}) { segmentCount }
+	}`)
+
+	require.NotEmpty(t, result.Errors)
+	assert.Contains(t, result.Errors[0].Message, "must start with WEBVTT")
+}
+
+func TestFetchTranscript_Success(t *testing.T) {
+	captions := &mockCaptionProvider{
+		fetchCaptionsFn: func(ctx context.Context, videoID, language string) (*portservices.Captions, error) {
+			assert.Equal(t, "es", language)
+			return &portservices.Captions{
+				Language: language,
+				Format:   domain.CaptionFormatWebVTT,
+				Data:     "WEBVTT\n\n00:00.000 --> 00:02.000\nHola\n",
+			}, nil
+		},
+	}
+
+	server := setupTranscriptTestServer(transcriptContentRepo(), &mockTranscriptRepository{}, captions)
+	defer server.Close()
+
+	result := executeGraphQL(t, server, `mutation { fetchTranscript(contentID: "4", language: "es") { language source segmentCount } }`)
+	require.Empty(t, result.Errors)
+	assert.JSONEq(t, `{"fetchTranscript": {"language": "es", "source": "YOUTUBE", "segmentCount": 1}}`, string(result.Data))
+}
+
+func TestFetchTranscript_Errors(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want string
+	}{
+		{name: "no captions", err: domain.ErrNotFound, want: "content or captions not found"},
+		{name: "provider error", err: domain.ErrYouTubeAPI, want: "failed to fetch captions from YouTube"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			captions := &mockCaptionProvider{
+				fetchCaptionsFn: func(ctx context.Context, videoID, language string) (*portservices.Captions, error) {
+					return nil, tt.err
+				},
+			}
+			server := setupTranscriptTestServer(transcriptContentRepo(), &mockTranscriptRepository{}, captions)
+			defer server.Close()
+
+			result := executeGraphQL(t, server, `mutation { fetchTranscript(contentID: "4") { segmentCount } }`)
+
+			require.NotEmpty(t, result.Errors)
+			assert.Contains(t, result.Errors[0].Message, tt.want)
+		})
+	}
+}
+
+func TestSearchTranscripts_Success(t *testing.T) {
+	var got domain.TranscriptSearchParams
+	transcripts := &mockTranscriptRepository{
+		searchFn: func(ctx context.Context, params domain.TranscriptSearchParams) ([]*domain.TranscriptMatch, error) {
+			got = params
+			return []*domain.TranscriptMatch{{
+				Segment:  &domain.TranscriptSegment{ContentID: 9, StartMs: 12000, EndMs: 15000, Text: "a lot of misinformation here"},
+				Headline: "a lot of <b>misinformation</b> here",
+				Rank:     0.25,
+			}}, nil
+		},
+	}
+
+	server := setupTranscriptTestServer(transcriptContentRepo(), transcripts, &mockCaptionProvider{})
+	defer server.Close()
+
+	result := executeGraphQL(t, server, `{ searchTranscripts(query: "misinformation", contentID: "9", first: 5) {
+		segment { contentID start end } headline rank
+	} }`)
+	require.Empty(t, result.Errors)
+	assert.JSONEq(t, `{"searchTranscripts": [{
+		"segment": {"contentID": "9", "start": 12, "end": 15},
+		"headline": "a lot of <b>misinformation</b> here",
+		"rank": 0.25
+	}]}`, string(result.Data))
+
+	assert.Equal(t, "misinformation", got.Query)
+	require.NotNil(t, got.ContentID)
+	assert.Equal(t, 9, *got.ContentID)
+	assert.Equal(t, 5, got.Limit)
+}
+
+func TestSearchTranscripts_EmptyQuery(t *testing.T) {
+	server := setupTranscriptTestServer(transcriptContentRepo(), &mockTranscriptRepository{}, &mockCaptionProvider{})
+	defer server.Close()
+
+	result := executeGraphQL(t, server, `{ searchTranscripts(query: "  ") { rank } }`)
+
+	require.NotEmpty(t, result.Errors)
+	assert.Contains(t, result.Errors[0].Message, "search query is required")
+}
@@ -0,0 +1,104 @@
+package services_test
+
+import (
+	"testing"
+
+	"github.com/CodeWarrior-debug/perspectize/backend/internal/core/domain"
+	"github.com/CodeWarrior-debug/perspectize/backend/internal/core/services"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseCaptions_WebVTT(t *testing.T) {
+	data := "\uFEFFWEBVTT Kind: captions\r\n" +
+		"Language: en\r\n" +
+		"\r\n" +
+		"NOTE this block is ignored\r\n" +
+		"\r\n" +
+		"STYLE\r\n" +
+		"::cue { color: lime }\r\n" +
+		"\r\n" +
+		"intro\r\n" +
+		"00:01.000 --> 00:04.500 align:start position:0%\r\n" +
+		"<v Rick>Never gonna <i>give</i> you up</v>\r\n" +
+		"\r\n" +
+		"01:00:02.250 --> 01:00:05.000\r\n" +
+		"Tom &amp; Jerry\r\n" +
+		"second line\r\n" +
+		"\r\n" +
+		"00:00:05.000 --> 00:00:06.000\r\n" +
+		"<c.colorE5E5E5>   </c>\r\n"
+
+	segments, err := services.ParseCaptions(domain.CaptionFormatWebVTT, data)
+
+	require.NoError(t, err)
+	require.Len(t, segments, 2, "empty cue is dropped")
+	assert.Equal(t, &domain.TranscriptSegment{Position: 0, StartMs: 1000, EndMs: 4500, Text: "Never gonna give you up"}, segments[0])
+	assert.Equal(t, &domain.TranscriptSegment{Position: 1, StartMs: 3602250, EndMs: 3605000, Text: "Tom & Jerry second line"}, segments[1])
+}
+
+func TestParseCaptions_WebVTTRollingAutoCaptions(t *testing.T) {
+	// YouTube auto-captions repeat the previous line at the top of each cue
+	data := `WEBVTT
+
+00:00:00.000 --> 00:00:02.000
+<00:00:00.000><c>hello</c><00:00:00.500><c> world</c>
+
+00:00:02.000 --> 00:00:02.010
+hello world
+
+00:00:02.010 --> 00:00:04.000
+hello world
+how are you
+`
+
+	segments, err := services.ParseCaptions(domain.CaptionFormatWebVTT, data)
+
+	require.NoError(t, err)
+	require.Len(t, segments, 2)
+	assert.Equal(t, "hello world", segments[0].Text)
+	assert.Equal(t, "how are you", segments[1].Text)
+	assert.Equal(t, 2010, segments[1].StartMs)
+}
+
+func TestParseCaptions_SRT(t *testing.T) {
+	data := `1
+00:00:03,000 --> 00:00:05,000
+{\an8}<b>Second</b> cue
+
+2
+00:00:00,500 --> 00:00:02,000
+First cue
+`
+
+	segments, err := services.ParseCaptions(domain.CaptionFormatSRT, data)
+
+	require.NoError(t, err)
+	require.Len(t, segments, 2)
+	assert.Equal(t, &domain.TranscriptSegment{Position: 0, StartMs: 500, EndMs: 2000, Text: "First cue"}, segments[0], "cues are ordered by start time")
+	assert.Equal(t, &domain.TranscriptSegment{Position: 1, StartMs: 3000, EndMs: 5000, Text: "Second cue"}, segments[1])
+}
+
+func TestParseCaptions_Errors(t *testing.T) {
+	tests := []struct {
+		name   string
+		format domain.CaptionFormat
+		data   string
+		want   string
+	}{
+		{name: "missing WEBVTT header", format: domain.CaptionFormatWebVTT, data: "00:01.000 --> 00:02.000\nhi\n", want: "must start with WEBVTT"},
+		{name: "bad timestamp", format: domain.CaptionFormatWebVTT, data: "WEBVTT\n\n00:01 --> 00:02.000\nhi\n", want: "line 3: invalid timestamp"},
+		{name: "seconds out of range", format: domain.CaptionFormatSRT, data: "1\n00:00:61,000 --> 00:01:02,000\nhi\n", want: "invalid timestamp"},
+		{name: "ends before start", format: domain.CaptionFormatSRT, data: "1\n00:00:05,000 --> 00:00:04,000\nhi\n", want: "ends before it starts"},
+		{name: "missing timing", format: domain.CaptionFormatSRT, data: "1\nhello\n", want: "line 2: expected cue timing"},
+		{name: "unknown format", format: "ASS", data: "", want: "unknown caption format"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := services.ParseCaptions(tt.format, tt.data)
+			require.ErrorIs(t, err, domain.ErrInvalidInput)
+			assert.Contains(t, err.Error(), tt.want)
+		})
+	}
+}
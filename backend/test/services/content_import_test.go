@@ -0,0 +1,344 @@
+package services_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/CodeWarrior-debug/perspectize/backend/internal/core/domain"
+	portservices "github.com/CodeWarrior-debug/perspectize/backend/internal/core/ports/services"
+	"github.com/CodeWarrior-debug/perspectize/backend/internal/core/services"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// importRepo is a thread-safe in-memory repository for import tests
+func importRepo(existing map[string]*domain.Content) (*mockContentRepository, *[]*domain.Content) {
+	var mu sync.Mutex
+	var created []*domain.Content
+	nextID := 100
+	repo := &mockContentRepository{
+		getByURLFn: func(ctx context.Context, url string) (*domain.Content, error) {
+			if c, ok := existing[url]; ok {
+				return c, nil
+			}
+			return nil, domain.ErrNotFound
+		},
+		createFn: func(ctx context.Context, content *domain.Content) (*domain.Content, error) {
+			mu.Lock()
+			defer mu.Unlock()
+			nextID++
+			content.ID = nextID
+			created = append(created, content)
+			return content, nil
+		},
+	}
+	return repo, &created
+}
+
+func TestImportFromURLs_MixedResults(t *testing.T) {
+	existingURL := "https://www.youtube.com/watch?v=old"
+	repo, created := importRepo(map[string]*domain.Content{
+		existingURL: {ID: 7, Name: "Old"},
+	})
+	yt := &fakeYouTubeClient{videos: map[string]*portservices.VideoMetadata{
+		"new1": {Title: "New One", Duration: 60},
+		"new2": {Title: "New Two", Duration: 120},
+	}}
+
+	urls := []string{
+		"https://www.youtube.com/watch?v=new1",
+		existingURL,
+		"not a youtube link",
+		"https://www.youtube.com/watch?v=gone",
+		"  https://www.youtube.com/watch?v=new2  ",
+		"https://www.youtube.com/watch?v=new1",
+	}
+
+	svc := services.NewContentService(repo, yt)
+	results, err := svc.ImportFromURLs(context.Background(), urls)
+
+	require.NoError(t, err)
+	require.Len(t, results, len(urls))
+
+	assert.Equal(t, domain.ContentImportStatusCreated, results[0].Status)
+	assert.Equal(t, "New One", results[0].Content.Name)
+
+	assert.Equal(t, domain.ContentImportStatusExists, results[1].Status)
+	assert.Equal(t, 7, results[1].Content.ID)
+
+	assert.Equal(t, domain.ContentImportStatusInvalidURL, results[2].Status)
+	assert.Equal(t, "invalid YouTube URL", results[2].Error)
+	assert.Nil(t, results[2].Content)
+
+	assert.Equal(t, domain.ContentImportStatusProviderError, results[3].Status)
+	assert.Equal(t, "video not found", results[3].Error)
+
+	assert.Equal(t, domain.ContentImportStatusCreated, results[4].Status)
+	assert.Equal(t, "https://www.youtube.com/watch?v=new2", results[4].URL, "URLs are trimmed")
+
+	assert.Equal(t, domain.ContentImportStatusExists, results[5].Status, "repeated URL resolves to the content created earlier")
+	assert.Equal(t, results[0].Content.ID, results[5].Content.ID)
+
+	assert.Len(t, *created, 2)
+	assert.Equal(t, 1, yt.requestCount(), "all new videos fetched in one batch")
+}
+
+func TestImportFromURLs_CanonicalDedupe(t *testing.T) {
+	storedURL := "https://www.youtube.com/watch?v=old"
+	repo, created := importRepo(nil)
+	var mu sync.Mutex
+	aliases := make(map[string]int)
+	repo.getByCanonicalKeyFn = func(ctx context.Context, key string) (*domain.Content, error) {
+		if key == "youtube:old" {
+			return &domain.Content{ID: 7, URL: &storedURL}, nil
+		}
+		return nil, domain.ErrNotFound
+	}
+	repo.addAliasFn = func(ctx context.Context, contentID int, url string) error {
+		mu.Lock()
+		defer mu.Unlock()
+		aliases[url] = contentID
+		return nil
+	}
+	yt := &fakeYouTubeClient{videos: map[string]*portservices.VideoMetadata{
+		"new": {Title: "New"},
+	}}
+
+	urls := []string{
+		"https://m.youtube.com/watch?v=old",
+		"https://www.youtube.com/watch?v=new",
+		"https://youtu.be/watch?v=new",
+	}
+
+	svc := services.NewContentService(repo, yt)
+	results, err := svc.ImportFromURLs(context.Background(), urls)
+
+	require.NoError(t, err)
+	assert.Equal(t, domain.ContentImportStatusExists, results[0].Status)
+	assert.Equal(t, 7, results[0].Content.ID)
+	assert.Equal(t, domain.ContentImportStatusCreated, results[1].Status)
+	assert.Equal(t, "youtube:new", *results[1].Content.CanonicalKey)
+	assert.Equal(t, domain.ContentImportStatusExists, results[2].Status)
+	assert.Equal(t, results[1].Content.ID, results[2].Content.ID)
+
+	assert.Len(t, *created, 1)
+	assert.Equal(t, map[string]int{
+		urls[0]: 7,
+		urls[2]: results[1].Content.ID,
+	}, aliases)
+}
+
+func TestImportFromURLs_BatchesMetadataRequests(t *testing.T) {
+	urls := make([]string, 120)
+	videos := make(map[string]*portservices.VideoMetadata, len(urls))
+	for i := range urls {
+		videoID := fmt.Sprintf("vid%03d", i)
+		urls[i] = "https://www.youtube.com/watch?v=" + videoID
+		videos[videoID] = &portservices.VideoMetadata{Title: "Title " + videoID}
+	}
+	repo, created := importRepo(nil)
+	yt := &fakeYouTubeClient{videos: videos}
+
+	svc := services.NewContentService(repo, yt)
+	results, err := svc.ImportFromURLs(context.Background(), urls)
+
+	require.NoError(t, err)
+	assert.Len(t, *created, 120)
+	for _, r := range results {
+		assert.Equal(t, domain.ContentImportStatusCreated, r.Status)
+	}
+	require.Equal(t, 3, yt.requestCount())
+	for _, batch := range yt.batches {
+		assert.LessOrEqual(t, len(batch), 50)
+	}
+}
+
+func TestImportFromURLs_BoundedConcurrency(t *testing.T) {
+	var inFlight, maxInFlight atomic.Int32
+	repo := &mockContentRepository{
+		getByURLFn: func(ctx context.Context, url string) (*domain.Content, error) {
+			n := inFlight.Add(1)
+			defer inFlight.Add(-1)
+			for {
+				m := maxInFlight.Load()
+				if n <= m || maxInFlight.CompareAndSwap(m, n) {
+					break
+				}
+			}
+			time.Sleep(5 * time.Millisecond)
+			return nil, domain.ErrNotFound
+		},
+	}
+
+	urls := make([]string, 40)
+	for i := range urls {
+		urls[i] = fmt.Sprintf("https://www.youtube.com/watch?v=v%d", i)
+	}
+
+	svc := services.NewContentService(repo, &fakeYouTubeClient{})
+	_, err := svc.ImportFromURLs(context.Background(), urls)
+
+	require.NoError(t, err)
+	assert.LessOrEqual(t, maxInFlight.Load(), int32(8))
+	assert.Greater(t, maxInFlight.Load(), int32(1), "lookups should run concurrently")
+}
+
+func TestImportFromURLs_ProviderError(t *testing.T) {
+	repo, created := importRepo(nil)
+	yt := &mockYouTubeClient{
+		extractVideoIDFn: func(url string) (string, error) {
+			return url, nil
+		},
+		getVideosMetadataFn: func(ctx context.Context, videoIDs []string) (map[string]*portservices.VideoMetadata, error) {
+			return nil, fmt.Errorf("Get \"https://example.test/videos?key=secret\": connection reset")
+		},
+	}
+
+	svc := services.NewContentService(repo, yt)
+	results, err := svc.ImportFromURLs(context.Background(), []string{"a", "b"})
+
+	require.NoError(t, err)
+	for _, r := range results {
+		assert.Equal(t, domain.ContentImportStatusProviderError, r.Status)
+		assert.NotContains(t, r.Error, "secret", "transport details must not leak into results")
+	}
+	assert.Empty(t, *created)
+}
+
+func TestImportFromURLs_SaveFailure(t *testing.T) {
+	repo := &mockContentRepository{
+		createFn: func(ctx context.Context, content *domain.Content) (*domain.Content, error) {
+			return nil, fmt.Errorf("duplicate key value violates unique constraint")
+		},
+	}
+	yt := &fakeYouTubeClient{videos: map[string]*portservices.VideoMetadata{"a": {Title: "A"}}}
+
+	svc := services.NewContentService(repo, yt)
+	results, err := svc.ImportFromURLs(context.Background(), []string{"https://www.youtube.com/watch?v=a"})
+
+	require.NoError(t, err)
+	require.Len(t, results, 1)
+	assert.Equal(t, domain.ContentImportStatusFailed, results[0].Status)
+	assert.Equal(t, "failed to import content", results[0].Error)
+}
+
+func TestImportFromURLs_LosesCreateRace(t *testing.T) {
+	key := domain.CanonicalKey(domain.ContentTypeYouTube, "a")
+	winner := &domain.Content{ID: 9, Name: "A", CanonicalKey: &key}
+	yt := &fakeYouTubeClient{videos: map[string]*portservices.VideoMetadata{"a": {Title: "A"}}}
+
+	svc := services.NewContentService(racedRepo(winner), yt)
+	results, err := svc.ImportFromURLs(context.Background(), []string{"https://www.youtube.com/watch?v=a"})
+
+	require.NoError(t, err)
+	require.Len(t, results, 1)
+	assert.Equal(t, domain.ContentImportStatusExists, results[0].Status)
+	assert.Equal(t, winner, results[0].Content)
+}
+
+func TestImportFromURLs_InvalidInput(t *testing.T) {
+	svc := services.NewContentService(&mockContentRepository{}, &fakeYouTubeClient{})
+
+	_, err := svc.ImportFromURLs(context.Background(), nil)
+	assert.True(t, errors.Is(err, domain.ErrInvalidInput))
+
+	_, err = svc.ImportFromURLs(context.Background(), make([]string, services.MaxImportURLs+1))
+	assert.True(t, errors.Is(err, domain.ErrInvalidInput))
+}
+
+func TestImportFromURLs_Canceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	svc := services.NewContentService(&mockContentRepository{}, &fakeYouTubeClient{})
+	_, err := svc.ImportFromURLs(ctx, []string{"https://www.youtube.com/watch?v=a"})
+
+	require.ErrorIs(t, err, context.Canceled)
+}
+
+// --- ImportYouTubeCollection Tests ---
+
+func TestImportYouTubeCollection_Summary(t *testing.T) {
+	existingURL := "https://www.youtube.com/watch?v=old"
+	repo, created := importRepo(map[string]*domain.Content{
+		existingURL: {ID: 7, Name: "Old"},
+	})
+	yt := &fakeYouTubeClient{
+		collection: []string{"new1", "old", "gone", "new2"},
+		videos: map[string]*portservices.VideoMetadata{
+			"new1": {Title: "New One"},
+			"new2": {Title: "New Two"},
+		},
+	}
+
+	svc := services.NewContentService(repo, yt)
+	summary, err := svc.ImportYouTubeCollection(context.Background(), "https://www.youtube.com/playlist?list=PLabc", 0)
+
+	require.NoError(t, err)
+	assert.Equal(t, &domain.CollectionImportSummary{Total: 4, Created: 2, Skipped: 1, Failed: 1}, summary)
+	assert.Len(t, *created, 2)
+}
+
+func TestImportYouTubeCollection_ChunksLargeCollections(t *testing.T) {
+	ids := make([]string, 450)
+	videos := make(map[string]*portservices.VideoMetadata, len(ids))
+	for i := range ids {
+		ids[i] = fmt.Sprintf("vid%03d", i)
+		videos[ids[i]] = &portservices.VideoMetadata{Title: ids[i]}
+	}
+	repo, created := importRepo(nil)
+	yt := &fakeYouTubeClient{collection: ids, videos: videos}
+
+	svc := services.NewContentService(repo, yt)
+	summary, err := svc.ImportYouTubeCollection(context.Background(), "https://www.youtube.com/@channel", 450)
+
+	require.NoError(t, err)
+	assert.Equal(t, 450, summary.Total)
+	assert.Equal(t, 450, summary.Created)
+	assert.Len(t, *created, 450)
+	assert.Equal(t, 9, yt.requestCount(), "chunks of 200, 200 and 50 URLs fetch metadata in batches of 50")
+}
+
+func TestImportYouTubeCollection_DefaultLimit(t *testing.T) {
+	var gotLimit int
+	yt := &mockYouTubeClient{
+		listCollectionVideoIDsFn: func(ctx context.Context, collectionURL string, limit int) ([]string, error) {
+			gotLimit = limit
+			return nil, nil
+		},
+	}
+
+	svc := services.NewContentService(&mockContentRepository{}, yt)
+	summary, err := svc.ImportYouTubeCollection(context.Background(), "https://www.youtube.com/playlist?list=PLabc", 0)
+
+	require.NoError(t, err)
+	assert.Equal(t, services.DefaultCollectionImportLimit, gotLimit)
+	assert.Equal(t, 0, summary.Total)
+}
+
+func TestImportYouTubeCollection_InvalidLimit(t *testing.T) {
+	svc := services.NewContentService(&mockContentRepository{}, &fakeYouTubeClient{})
+
+	for _, limit := range []int{-1, services.MaxCollectionImportLimit + 1} {
+		_, err := svc.ImportYouTubeCollection(context.Background(), "https://www.youtube.com/playlist?list=PLabc", limit)
+		assert.True(t, errors.Is(err, domain.ErrInvalidInput), "limit %d", limit)
+	}
+}
+
+func TestImportYouTubeCollection_ListError(t *testing.T) {
+	yt := &mockYouTubeClient{
+		listCollectionVideoIDsFn: func(ctx context.Context, collectionURL string, limit int) ([]string, error) {
+			return nil, fmt.Errorf("%w: channel not found: @missing", domain.ErrNotFound)
+		},
+	}
+
+	svc := services.NewContentService(&mockContentRepository{}, yt)
+	_, err := svc.ImportYouTubeCollection(context.Background(), "https://www.youtube.com/@missing", 10)
+
+	assert.True(t, errors.Is(err, domain.ErrNotFound))
+}
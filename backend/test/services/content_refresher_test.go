@@ -0,0 +1,483 @@
+package services_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"sort"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/CodeWarrior-debug/perspectize/backend/internal/core/domain"
+	portservices "github.com/CodeWarrior-debug/perspectize/backend/internal/core/ports/services"
+	"github.com/CodeWarrior-debug/perspectize/backend/internal/core/services"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeYouTubeClient serves canned metadata keyed by video ID and records each
+// batch request it receives
+type fakeYouTubeClient struct {
+	mu       sync.Mutex
+	videos   map[string]*portservices.VideoMetadata
+	batches  [][]string
+	onCalled func(videoIDs []string)
+
+	// collection is returned by ListCollectionVideoIDs, truncated to the limit
+	collection []string
+}
+
+func (f *fakeYouTubeClient) GetVideoMetadata(ctx context.Context, videoID string) (*portservices.VideoMetadata, error) {
+	videos, err := f.GetVideosMetadata(ctx, []string{videoID})
+	if err != nil {
+		return nil, err
+	}
+	metadata, ok := videos[videoID]
+	if !ok {
+		return nil, fmt.Errorf("%w: video not found: %s", domain.ErrNotFound, videoID)
+	}
+	return metadata, nil
+}
+
+func (f *fakeYouTubeClient) GetVideosMetadata(ctx context.Context, videoIDs []string) (map[string]*portservices.VideoMetadata, error) {
+	f.mu.Lock()
+	f.batches = append(f.batches, append([]string(nil), videoIDs...))
+	f.mu.Unlock()
+
+	if f.onCalled != nil {
+		f.onCalled(videoIDs)
+	}
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	result := make(map[string]*portservices.VideoMetadata)
+	for _, id := range videoIDs {
+		if metadata, ok := f.videos[id]; ok {
+			result[id] = metadata
+		}
+	}
+	return result, nil
+}
+
+func (f *fakeYouTubeClient) ListCollectionVideoIDs(ctx context.Context, collectionURL string, limit int) ([]string, error) {
+	return f.collection[:min(limit, len(f.collection))], nil
+}
+
+// ExtractVideoID treats everything after "v=" as the video ID
+func (f *fakeYouTubeClient) ExtractVideoID(url string) (string, error) {
+	for i := 0; i+2 <= len(url); i++ {
+		if url[i:i+2] == "v=" {
+			return url[i+2:], nil
+		}
+	}
+	return "", fmt.Errorf("no video ID in %s", url)
+}
+
+func (f *fakeYouTubeClient) requestCount() int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return len(f.batches)
+}
+
+// manyStale builds n stale content items whose videos all exist in the returned map
+func manyStale(n int) ([]*domain.Content, map[string]*portservices.VideoMetadata) {
+	contents := make([]*domain.Content, n)
+	videos := make(map[string]*portservices.VideoMetadata, n)
+	for i := range n {
+		videoID := fmt.Sprintf("vid%03d", i)
+		contents[i] = staleYouTubeContent(i+1, videoID)
+		videos[videoID] = &portservices.VideoMetadata{Title: "Fresh " + videoID}
+	}
+	return contents, videos
+}
+
+func staleYouTubeContent(id int, videoID string) *domain.Content {
+	url := "https://www.youtube.com/watch?v=" + videoID
+	return &domain.Content{
+		ID:          id,
+		Name:        "stale " + videoID,
+		URL:         &url,
+		ContentType: domain.ContentTypeYouTube,
+	}
+}
+
+func TestContentRefresher_RefreshStale_UpdatesContent(t *testing.T) {
+	stale := []*domain.Content{
+		staleYouTubeContent(1, "aaa"),
+		staleYouTubeContent(2, "bbb"),
+	}
+
+	var gotBefore time.Time
+	var gotLimit int
+	var saved []*domain.Content
+	var snapshots []int
+	repo := &mockContentRepository{
+		listRefreshDueFn: func(ctx context.Context, before time.Time, limit int) ([]*domain.Content, error) {
+			gotBefore, gotLimit = before, limit
+			return stale, nil
+		},
+		updateFn: func(ctx context.Context, content *domain.Content) (*domain.Content, error) {
+			saved = append(saved, content)
+			return content, nil
+		},
+		recordStatsFn: func(ctx context.Context, snapshot *domain.ContentStatsSnapshot) error {
+			snapshots = append(snapshots, snapshot.ContentID)
+			return nil
+		},
+	}
+	yt := &fakeYouTubeClient{videos: map[string]*portservices.VideoMetadata{
+		"aaa": {Title: "Fresh A", Duration: 10, Response: json.RawMessage(`{"a":1}`)},
+		"bbb": {Title: "Fresh B", Duration: 20, Response: json.RawMessage(`{"b":2}`)},
+	}}
+
+	refresher := services.NewContentRefresher(repo, yt, services.RefresherConfig{
+		MaxAge:            2 * time.Hour,
+		BatchSize:         25,
+		RequestsPerSecond: 1000,
+	})
+	refreshed, err := refresher.RefreshStale(context.Background())
+
+	require.NoError(t, err)
+	assert.Equal(t, 2, refreshed)
+	assert.Equal(t, 25, gotLimit)
+	assert.WithinDuration(t, time.Now().Add(-2*time.Hour), gotBefore, time.Minute)
+
+	require.Len(t, saved, 2)
+	assert.Equal(t, "Fresh A", saved[0].Name)
+	require.NotNil(t, saved[0].Length)
+	assert.Equal(t, 10, *saved[0].Length)
+	assert.JSONEq(t, `{"a":1}`, string(saved[0].Response))
+	assert.Equal(t, "Fresh B", saved[1].Name)
+	assert.Equal(t, []int{1, 2}, snapshots, "each refresh records a stats snapshot")
+}
+
+func TestContentRefresher_RefreshStale_SkipsFailures(t *testing.T) {
+	badURL := "https://example.com/not-a-video"
+	stale := []*domain.Content{
+		staleYouTubeContent(1, "missing"),
+		staleYouTubeContent(2, "ok"),
+		staleYouTubeContent(3, "broken"),
+		{ID: 4, Name: "bad url", URL: &badURL, ContentType: domain.ContentTypeYouTube},
+	}
+
+	var saved, failed, unavailable []int
+	repo := &mockContentRepository{
+		listRefreshDueFn: func(ctx context.Context, before time.Time, limit int) ([]*domain.Content, error) {
+			return stale, nil
+		},
+		updateFn: func(ctx context.Context, content *domain.Content) (*domain.Content, error) {
+			if content.ID == 3 {
+				return nil, fmt.Errorf("connection reset")
+			}
+			saved = append(saved, content.ID)
+			return content, nil
+		},
+		markRefreshFailedFn: func(ctx context.Context, ids []int, at time.Time) error {
+			failed = append(failed, ids...)
+			return nil
+		},
+		markUnavailableFn: func(ctx context.Context, ids []int, at time.Time) error {
+			unavailable = append(unavailable, ids...)
+			return nil
+		},
+	}
+	yt := &fakeYouTubeClient{videos: map[string]*portservices.VideoMetadata{
+		"ok":     {Title: "OK"},
+		"broken": {Title: "Broken"},
+	}}
+
+	refresher := services.NewContentRefresher(repo, yt, services.RefresherConfig{RequestsPerSecond: 1000})
+	refreshed, err := refresher.RefreshStale(context.Background())
+
+	require.NoError(t, err)
+	assert.Equal(t, 1, refreshed)
+	assert.Equal(t, []int{2}, saved)
+	assert.Equal(t, 1, yt.requestCount(), "all videos fetched in one request")
+	assert.Equal(t, []int{1}, unavailable, "a video YouTube does not return is gone")
+	assert.ElementsMatch(t, []int{3, 4}, failed)
+}
+
+// refreshDueRepo is a content repository whose ListRefreshDue, Update and
+// Mark methods keep state like the database does
+func refreshDueRepo(rows []*domain.Content) (*mockContentRepository, map[int]*time.Time) {
+	attempted := make(map[int]*time.Time)
+	lastTried := func(c *domain.Content) time.Time {
+		if at := attempted[c.ID]; at != nil && at.After(c.UpdatedAt) {
+			return *at
+		}
+		return c.UpdatedAt
+	}
+	return &mockContentRepository{
+		listRefreshDueFn: func(ctx context.Context, before time.Time, limit int) ([]*domain.Content, error) {
+			var due []*domain.Content
+			for _, c := range rows {
+				if c.UnavailableAt == nil && lastTried(c).Before(before) {
+					due = append(due, c)
+				}
+			}
+			sort.SliceStable(due, func(i, j int) bool { return lastTried(due[i]).Before(lastTried(due[j])) })
+			return due[:min(limit, len(due))], nil
+		},
+		updateFn: func(ctx context.Context, content *domain.Content) (*domain.Content, error) {
+			content.UpdatedAt = time.Now()
+			return content, nil
+		},
+		markRefreshFailedFn: func(ctx context.Context, ids []int, at time.Time) error {
+			for _, id := range ids {
+				attempted[id] = &at
+			}
+			return nil
+		},
+		markUnavailableFn: func(ctx context.Context, ids []int, at time.Time) error {
+			for _, c := range rows {
+				if slices.Contains(ids, c.ID) {
+					c.UnavailableAt = &at
+					attempted[c.ID] = &at
+				}
+			}
+			return nil
+		},
+	}, attempted
+}
+
+func TestContentRefresher_RefreshStale_MovesPastMissingAndFailedVideos(t *testing.T) {
+	longAgo := time.Now().Add(-30 * 24 * time.Hour)
+	rows := []*domain.Content{
+		staleYouTubeContent(1, "deleted"),
+		staleYouTubeContent(2, "unsaveable"),
+		staleYouTubeContent(3, "aaa"),
+		staleYouTubeContent(4, "bbb"),
+	}
+	for i, c := range rows {
+		// The unrefreshable rows are the oldest, so they head the queue
+		c.UpdatedAt = longAgo.Add(time.Duration(i) * time.Hour)
+	}
+	repo, attempted := refreshDueRepo(rows)
+	update := repo.updateFn
+	repo.updateFn = func(ctx context.Context, content *domain.Content) (*domain.Content, error) {
+		if content.ID == 2 {
+			return nil, fmt.Errorf("%w: content named %q already exists", domain.ErrAlreadyExists, content.Name)
+		}
+		return update(ctx, content)
+	}
+	yt := &fakeYouTubeClient{videos: map[string]*portservices.VideoMetadata{
+		"unsaveable": {Title: "Fresh aaa"},
+		"aaa":        {Title: "Fresh aaa"},
+		"bbb":        {Title: "Fresh bbb"},
+	}}
+
+	refresher := services.NewContentRefresher(repo, yt, services.RefresherConfig{BatchSize: 2, RequestsPerSecond: 1000})
+
+	refreshed, err := refresher.RefreshStale(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, 0, refreshed, "the first pass only finds the two bad rows")
+	assert.NotNil(t, rows[0].UnavailableAt, "a video missing from a successful response is unavailable")
+	assert.Nil(t, rows[1].UnavailableAt, "a failed save says nothing about the video")
+	assert.NotNil(t, attempted[2])
+
+	refreshed, err = refresher.RefreshStale(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, 2, refreshed, "the healthy rows behind them are reached")
+	assert.Equal(t, "Fresh aaa", rows[2].Name)
+	assert.Equal(t, "Fresh bbb", rows[3].Name)
+
+	refreshed, err = refresher.RefreshStale(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, 0, refreshed, "nothing is due until MaxAge has passed again")
+}
+
+func TestContentRefresher_RefreshStale_ListError(t *testing.T) {
+	repo := &mockContentRepository{
+		listRefreshDueFn: func(ctx context.Context, before time.Time, limit int) ([]*domain.Content, error) {
+			return nil, fmt.Errorf("connection refused")
+		},
+	}
+
+	refresher := services.NewContentRefresher(repo, &fakeYouTubeClient{}, services.RefresherConfig{})
+	refreshed, err := refresher.RefreshStale(context.Background())
+
+	require.Error(t, err)
+	assert.Equal(t, 0, refreshed)
+	assert.Contains(t, err.Error(), "failed to list stale content")
+}
+
+func TestContentRefresher_RefreshStale_BatchesRequests(t *testing.T) {
+	stale, videos := manyStale(120)
+	// Two items share a video and should cost nothing extra
+	stale = append(stale, staleYouTubeContent(500, "vid000"))
+
+	repo := &mockContentRepository{
+		listRefreshDueFn: func(ctx context.Context, before time.Time, limit int) ([]*domain.Content, error) {
+			return stale, nil
+		},
+	}
+	yt := &fakeYouTubeClient{videos: videos}
+
+	refresher := services.NewContentRefresher(repo, yt, services.RefresherConfig{BatchSize: 200, RequestsPerSecond: 1000})
+	refreshed, err := refresher.RefreshStale(context.Background())
+
+	require.NoError(t, err)
+	assert.Equal(t, 121, refreshed)
+	require.Equal(t, 3, yt.requestCount(), "120 unique videos need ceil(120/50) requests")
+	assert.Len(t, yt.batches[0], 50)
+	assert.Len(t, yt.batches[1], 50)
+	assert.Len(t, yt.batches[2], 20)
+}
+
+func TestContentRefresher_RefreshStale_RateLimited(t *testing.T) {
+	stale, videos := manyStale(150)
+	repo := &mockContentRepository{
+		listRefreshDueFn: func(ctx context.Context, before time.Time, limit int) ([]*domain.Content, error) {
+			return stale, nil
+		},
+	}
+	yt := &fakeYouTubeClient{videos: videos}
+
+	// 20 rps => 50ms between requests; three requests need at least two gaps
+	refresher := services.NewContentRefresher(repo, yt, services.RefresherConfig{BatchSize: 150, RequestsPerSecond: 20})
+	start := time.Now()
+	refreshed, err := refresher.RefreshStale(context.Background())
+	elapsed := time.Since(start)
+
+	require.NoError(t, err)
+	assert.Equal(t, 150, refreshed)
+	assert.Equal(t, 3, yt.requestCount())
+	assert.GreaterOrEqual(t, elapsed, 90*time.Millisecond)
+}
+
+func TestContentRefresher_RefreshStale_StopsOnCancel(t *testing.T) {
+	stale, videos := manyStale(150)
+	repo := &mockContentRepository{
+		listRefreshDueFn: func(ctx context.Context, before time.Time, limit int) ([]*domain.Content, error) {
+			return stale, nil
+		},
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	yt := &fakeYouTubeClient{
+		videos: videos,
+		// Cancel as soon as the first request goes out
+		onCalled: func([]string) { cancel() },
+	}
+
+	// Slow limiter so the loop is waiting when cancellation lands
+	refresher := services.NewContentRefresher(repo, yt, services.RefresherConfig{BatchSize: 150, RequestsPerSecond: 1})
+	start := time.Now()
+	_, err := refresher.RefreshStale(ctx)
+
+	require.ErrorIs(t, err, context.Canceled)
+	assert.Equal(t, 1, yt.requestCount())
+	assert.Less(t, time.Since(start), 500*time.Millisecond)
+}
+
+func TestContentRefresher_RefreshStale_PartialResults(t *testing.T) {
+	stale := []*domain.Content{
+		staleYouTubeContent(1, "a"),
+		staleYouTubeContent(2, "b"),
+	}
+
+	var saved, failed []int
+	repo := &mockContentRepository{
+		listRefreshDueFn: func(ctx context.Context, before time.Time, limit int) ([]*domain.Content, error) {
+			return stale, nil
+		},
+		updateFn: func(ctx context.Context, content *domain.Content) (*domain.Content, error) {
+			saved = append(saved, content.ID)
+			return content, nil
+		},
+		markRefreshFailedFn: func(ctx context.Context, ids []int, at time.Time) error {
+			failed = append(failed, ids...)
+			return nil
+		},
+		markUnavailableFn: func(ctx context.Context, ids []int, at time.Time) error {
+			t.Errorf("content %v marked unavailable after a failed request", ids)
+			return nil
+		},
+	}
+	yt := &mockYouTubeClient{
+		extractVideoIDFn: func(url string) (string, error) {
+			return url[len(url)-1:], nil
+		},
+		getVideosMetadataFn: func(ctx context.Context, videoIDs []string) (map[string]*portservices.VideoMetadata, error) {
+			return map[string]*portservices.VideoMetadata{"b": {Title: "B"}}, fmt.Errorf("%w: status 500", domain.ErrYouTubeAPI)
+		},
+	}
+
+	refresher := services.NewContentRefresher(repo, yt, services.RefresherConfig{RequestsPerSecond: 1000})
+	refreshed, err := refresher.RefreshStale(context.Background())
+
+	require.NoError(t, err)
+	assert.Equal(t, 1, refreshed)
+	assert.Equal(t, []int{2}, saved)
+	assert.Equal(t, []int{1}, failed)
+}
+
+func TestContentRefresher_RefreshStale_StopsOnQuotaExceeded(t *testing.T) {
+	stale, _ := manyStale(150)
+	repo := &mockContentRepository{
+		listRefreshDueFn: func(ctx context.Context, before time.Time, limit int) ([]*domain.Content, error) {
+			return stale, nil
+		},
+	}
+	calls := 0
+	yt := &mockYouTubeClient{
+		extractVideoIDFn: func(url string) (string, error) {
+			return url[len("https://www.youtube.com/watch?v="):], nil
+		},
+		getVideosMetadataFn: func(ctx context.Context, videoIDs []string) (map[string]*portservices.VideoMetadata, error) {
+			calls++
+			return nil, fmt.Errorf("%w: daily budget of 10000 units spent", domain.ErrQuotaExceeded)
+		},
+	}
+
+	refresher := services.NewContentRefresher(repo, yt, services.RefresherConfig{BatchSize: 150, RequestsPerSecond: 1000})
+	refreshed, err := refresher.RefreshStale(context.Background())
+
+	require.NoError(t, err)
+	assert.Equal(t, 0, refreshed)
+	assert.Equal(t, 1, calls, "remaining chunks are skipped once quota is exhausted")
+}
+
+func TestContentRefresher_Run_ExitsOnCancel(t *testing.T) {
+	passes := make(chan struct{}, 10)
+	repo := &mockContentRepository{
+		listRefreshDueFn: func(ctx context.Context, before time.Time, limit int) ([]*domain.Content, error) {
+			select {
+			case passes <- struct{}{}:
+			default:
+			}
+			return []*domain.Content{}, nil
+		},
+	}
+
+	refresher := services.NewContentRefresher(repo, &fakeYouTubeClient{}, services.RefresherConfig{
+		Interval: 10 * time.Millisecond,
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		refresher.Run(ctx)
+		close(done)
+	}()
+
+	// Wait for the immediate pass plus at least one ticked pass
+	for i := 0; i < 2; i++ {
+		select {
+		case <-passes:
+		case <-time.After(time.Second):
+			t.Fatal("refresher did not run a pass")
+		}
+	}
+
+	cancel()
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("Run did not return after context cancellation")
+	}
+}
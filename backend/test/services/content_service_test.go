@@ -6,6 +6,7 @@ import (
 	"errors"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/CodeWarrior-debug/perspectize/backend/internal/core/domain"
 	portservices "github.com/CodeWarrior-debug/perspectize/backend/internal/core/ports/services"
@@ -16,10 +17,22 @@ import (
 
 // mockContentRepository implements repositories.ContentRepository for testing
 type mockContentRepository struct {
-	createFn   func(ctx context.Context, content *domain.Content) (*domain.Content, error)
-	getByIDFn  func(ctx context.Context, id int) (*domain.Content, error)
-	getByURLFn func(ctx context.Context, url string) (*domain.Content, error)
-	listFn     func(ctx context.Context, params domain.ContentListParams) (*domain.PaginatedContent, error)
+	createFn            func(ctx context.Context, content *domain.Content) (*domain.Content, error)
+	getByIDFn           func(ctx context.Context, id int) (*domain.Content, error)
+	getByURLFn          func(ctx context.Context, url string) (*domain.Content, error)
+	getByCanonicalKeyFn func(ctx context.Context, key string) (*domain.Content, error)
+	addAliasFn          func(ctx context.Context, contentID int, url string) error
+	listFn              func(ctx context.Context, params domain.ContentListParams) (*domain.PaginatedContent, error)
+	listPageFn          func(ctx context.Context, params domain.ContentPageParams) (*domain.ContentPage, error)
+	facetsFn            func(ctx context.Context, params domain.ContentFacetParams) ([]*domain.ContentFacetCounts, error)
+	updateFn            func(ctx context.Context, content *domain.Content) (*domain.Content, error)
+	deleteFn            func(ctx context.Context, id int, policy domain.ContentDeletePolicy) error
+	mergeFn             func(ctx context.Context, sourceID, targetID int) (*domain.ContentMergeResult, error)
+	listRefreshDueFn    func(ctx context.Context, before time.Time, limit int) ([]*domain.Content, error)
+	markRefreshFailedFn func(ctx context.Context, ids []int, at time.Time) error
+	markUnavailableFn   func(ctx context.Context, ids []int, at time.Time) error
+	recordStatsFn       func(ctx context.Context, snapshot *domain.ContentStatsSnapshot) error
+	listStatsHistoryFn  func(ctx context.Context, params domain.ContentStatsHistoryParams) ([]*domain.ContentStatsBucket, error)
 }
 
 func (m *mockContentRepository) Create(ctx context.Context, content *domain.Content) (*domain.Content, error) {
@@ -43,6 +56,20 @@ func (m *mockContentRepository) GetByURL(ctx context.Context, url string) (*doma
 	return nil, domain.ErrNotFound
 }
 
+func (m *mockContentRepository) GetByCanonicalKey(ctx context.Context, key string) (*domain.Content, error) {
+	if m.getByCanonicalKeyFn != nil {
+		return m.getByCanonicalKeyFn(ctx, key)
+	}
+	return nil, domain.ErrNotFound
+}
+
+func (m *mockContentRepository) AddAlias(ctx context.Context, contentID int, url string) error {
+	if m.addAliasFn != nil {
+		return m.addAliasFn(ctx, contentID, url)
+	}
+	return nil
+}
+
 func (m *mockContentRepository) List(ctx context.Context, params domain.ContentListParams) (*domain.PaginatedContent, error) {
 	if m.listFn != nil {
 		return m.listFn(ctx, params)
@@ -50,14 +77,87 @@ func (m *mockContentRepository) List(ctx context.Context, params domain.ContentL
 	return &domain.PaginatedContent{Items: []*domain.Content{}}, nil
 }
 
+func (m *mockContentRepository) ListPage(ctx context.Context, params domain.ContentPageParams) (*domain.ContentPage, error) {
+	if m.listPageFn != nil {
+		return m.listPageFn(ctx, params)
+	}
+	return &domain.ContentPage{Items: []*domain.Content{}, Page: params.Page, PageSize: params.PageSize}, nil
+}
+
+func (m *mockContentRepository) Facets(ctx context.Context, params domain.ContentFacetParams) ([]*domain.ContentFacetCounts, error) {
+	if m.facetsFn != nil {
+		return m.facetsFn(ctx, params)
+	}
+	return []*domain.ContentFacetCounts{}, nil
+}
+
+func (m *mockContentRepository) Update(ctx context.Context, content *domain.Content) (*domain.Content, error) {
+	if m.updateFn != nil {
+		return m.updateFn(ctx, content)
+	}
+	return content, nil
+}
+
+func (m *mockContentRepository) Delete(ctx context.Context, id int, policy domain.ContentDeletePolicy) error {
+	if m.deleteFn != nil {
+		return m.deleteFn(ctx, id, policy)
+	}
+	return nil
+}
+
+func (m *mockContentRepository) Merge(ctx context.Context, sourceID, targetID int) (*domain.ContentMergeResult, error) {
+	if m.mergeFn != nil {
+		return m.mergeFn(ctx, sourceID, targetID)
+	}
+	return nil, domain.ErrNotFound
+}
+
+func (m *mockContentRepository) ListRefreshDue(ctx context.Context, before time.Time, limit int) ([]*domain.Content, error) {
+	if m.listRefreshDueFn != nil {
+		return m.listRefreshDueFn(ctx, before, limit)
+	}
+	return []*domain.Content{}, nil
+}
+
+func (m *mockContentRepository) MarkRefreshFailed(ctx context.Context, ids []int, at time.Time) error {
+	if m.markRefreshFailedFn != nil {
+		return m.markRefreshFailedFn(ctx, ids, at)
+	}
+	return nil
+}
+
+func (m *mockContentRepository) MarkUnavailable(ctx context.Context, ids []int, at time.Time) error {
+	if m.markUnavailableFn != nil {
+		return m.markUnavailableFn(ctx, ids, at)
+	}
+	return nil
+}
+
 func (m *mockContentRepository) ReassignByUser(ctx context.Context, fromUserID, toUserID int) error {
 	return nil
 }
 
+func (m *mockContentRepository) RecordStatsSnapshot(ctx context.Context, snapshot *domain.ContentStatsSnapshot) error {
+	if m.recordStatsFn != nil {
+		return m.recordStatsFn(ctx, snapshot)
+	}
+	return nil
+}
+
+func (m *mockContentRepository) ListStatsHistory(ctx context.Context, params domain.ContentStatsHistoryParams) ([]*domain.ContentStatsBucket, error) {
+	if m.listStatsHistoryFn != nil {
+		return m.listStatsHistoryFn(ctx, params)
+	}
+	return []*domain.ContentStatsBucket{}, nil
+}
+
 // mockYouTubeClient implements services.YouTubeClient for testing
 type mockYouTubeClient struct {
-	getVideoMetadataFn func(ctx context.Context, videoID string) (*portservices.VideoMetadata, error)
-	extractVideoIDFn   func(url string) (string, error)
+	getVideoMetadataFn  func(ctx context.Context, videoID string) (*portservices.VideoMetadata, error)
+	getVideosMetadataFn func(ctx context.Context, videoIDs []string) (map[string]*portservices.VideoMetadata, error)
+	extractVideoIDFn    func(url string) (string, error)
+
+	listCollectionVideoIDsFn func(ctx context.Context, collectionURL string, limit int) ([]string, error)
 }
 
 func (m *mockYouTubeClient) GetVideoMetadata(ctx context.Context, videoID string) (*portservices.VideoMetadata, error) {
@@ -67,6 +167,29 @@ func (m *mockYouTubeClient) GetVideoMetadata(ctx context.Context, videoID string
 	return nil, fmt.Errorf("not implemented")
 }
 
+// GetVideosMetadata falls back to getVideoMetadataFn per ID when no batch fn is set
+func (m *mockYouTubeClient) GetVideosMetadata(ctx context.Context, videoIDs []string) (map[string]*portservices.VideoMetadata, error) {
+	if m.getVideosMetadataFn != nil {
+		return m.getVideosMetadataFn(ctx, videoIDs)
+	}
+	result := make(map[string]*portservices.VideoMetadata, len(videoIDs))
+	for _, id := range videoIDs {
+		metadata, err := m.GetVideoMetadata(ctx, id)
+		if err != nil {
+			return result, err
+		}
+		result[id] = metadata
+	}
+	return result, nil
+}
+
+func (m *mockYouTubeClient) ListCollectionVideoIDs(ctx context.Context, collectionURL string, limit int) ([]string, error) {
+	if m.listCollectionVideoIDsFn != nil {
+		return m.listCollectionVideoIDsFn(ctx, collectionURL, limit)
+	}
+	return nil, fmt.Errorf("not implemented")
+}
+
 func (m *mockYouTubeClient) ExtractVideoID(url string) (string, error) {
 	if m.extractVideoIDFn != nil {
 		return m.extractVideoIDFn(url)
@@ -199,6 +322,47 @@ func TestCreateFromYouTube_Success(t *testing.T) {
 	assert.Equal(t, "seconds", *result.LengthUnits)
 }
 
+func TestCreateFromYouTube_CopiesTypedMetadata(t *testing.T) {
+	publishedAt := time.Date(2009, 10, 25, 6, 57, 33, 0, time.UTC)
+	views, comments := int64(1000), int64(3)
+	metadata := &portservices.VideoMetadata{
+		Title:        "Test Video Title",
+		ChannelName:  "Test Channel",
+		PublishedAt:  &publishedAt,
+		Tags:         []string{"music"},
+		ViewCount:    &views,
+		CommentCount: &comments,
+	}
+
+	repo := &mockContentRepository{
+		createFn: func(ctx context.Context, content *domain.Content) (*domain.Content, error) {
+			content.ID = 1
+			return content, nil
+		},
+	}
+	ytClient := &mockYouTubeClient{
+		extractVideoIDFn: func(url string) (string, error) {
+			return "dQw4w9WgXcQ", nil
+		},
+		getVideoMetadataFn: func(ctx context.Context, videoID string) (*portservices.VideoMetadata, error) {
+			return metadata, nil
+		},
+	}
+
+	svc := services.NewContentService(repo, ytClient)
+	result, err := svc.CreateFromYouTube(context.Background(), "https://www.youtube.com/watch?v=dQw4w9WgXcQ")
+
+	require.NoError(t, err)
+	require.NotNil(t, result.ChannelTitle)
+	assert.Equal(t, "Test Channel", *result.ChannelTitle)
+	assert.Nil(t, result.Description, "empty description is stored as NULL")
+	assert.Equal(t, &publishedAt, result.PublishedAt)
+	assert.Equal(t, []string{"music"}, result.Tags)
+	assert.Equal(t, &views, result.ViewCount)
+	assert.Nil(t, result.LikeCount)
+	assert.Equal(t, &comments, result.CommentCount)
+}
+
 func TestCreateFromYouTube_AlreadyExists(t *testing.T) {
 	existingURL := "https://www.youtube.com/watch?v=dQw4w9WgXcQ"
 	existing := &domain.Content{
@@ -319,7 +483,959 @@ func TestCreateFromYouTube_GetByURLUnexpectedError(t *testing.T) {
 	assert.Contains(t, err.Error(), "failed to check existing content")
 }
 
-// --- NewContentService Tests ---
+// --- Update Tests ---
+
+func TestCreateFromYouTube_SetsCanonicalKey(t *testing.T) {
+	var gotKey string
+	repo := &mockContentRepository{
+		getByCanonicalKeyFn: func(ctx context.Context, key string) (*domain.Content, error) {
+			gotKey = key
+			return nil, domain.ErrNotFound
+		},
+	}
+	ytClient := &mockYouTubeClient{
+		extractVideoIDFn: func(url string) (string, error) {
+			return "dQw4w9WgXcQ", nil
+		},
+		getVideoMetadataFn: func(ctx context.Context, videoID string) (*portservices.VideoMetadata, error) {
+			return &portservices.VideoMetadata{Title: "Video"}, nil
+		},
+	}
+
+	svc := services.NewContentService(repo, ytClient)
+	result, err := svc.CreateFromYouTube(context.Background(), "https://youtu.be/dQw4w9WgXcQ")
+
+	require.NoError(t, err)
+	assert.Equal(t, "youtube:dQw4w9WgXcQ", gotKey)
+	require.NotNil(t, result.CanonicalKey)
+	assert.Equal(t, "youtube:dQw4w9WgXcQ", *result.CanonicalKey)
+}
+
+func TestCreateFromYouTube_SameVideoDifferentURL(t *testing.T) {
+	storedURL := "https://www.youtube.com/watch?v=dQw4w9WgXcQ"
+	var aliasContentID int
+	var aliasURL string
+	repo := &mockContentRepository{
+		getByCanonicalKeyFn: func(ctx context.Context, key string) (*domain.Content, error) {
+			return &domain.Content{ID: 4, URL: &storedURL}, nil
+		},
+		addAliasFn: func(ctx context.Context, contentID int, url string) error {
+			aliasContentID, aliasURL = contentID, url
+			return nil
+		},
+		createFn: func(ctx context.Context, content *domain.Content) (*domain.Content, error) {
+			t.Fatal("Create should not be called for a known video")
+			return nil, nil
+		},
+	}
+	ytClient := &mockYouTubeClient{
+		extractVideoIDFn: func(url string) (string, error) {
+			return "dQw4w9WgXcQ", nil
+		},
+	}
+
+	svc := services.NewContentService(repo, ytClient)
+	_, err := svc.CreateFromYouTube(context.Background(), "https://m.youtube.com/watch?v=dQw4w9WgXcQ&t=30")
+
+	require.Error(t, err)
+	assert.True(t, errors.Is(err, domain.ErrAlreadyExists))
+	assert.Equal(t, 4, aliasContentID)
+	assert.Equal(t, "https://m.youtube.com/watch?v=dQw4w9WgXcQ&t=30", aliasURL)
+}
+
+func TestCreateFromYouTube_AliasFailureIsNotFatal(t *testing.T) {
+	repo := &mockContentRepository{
+		getByCanonicalKeyFn: func(ctx context.Context, key string) (*domain.Content, error) {
+			return &domain.Content{ID: 4}, nil
+		},
+		addAliasFn: func(ctx context.Context, contentID int, url string) error {
+			return fmt.Errorf("connection refused")
+		},
+	}
+	ytClient := &mockYouTubeClient{
+		extractVideoIDFn: func(url string) (string, error) {
+			return "dQw4w9WgXcQ", nil
+		},
+	}
+
+	svc := services.NewContentService(repo, ytClient)
+	_, err := svc.CreateFromYouTube(context.Background(), "https://youtu.be/dQw4w9WgXcQ")
+
+	assert.True(t, errors.Is(err, domain.ErrAlreadyExists), "alias errors are logged, not returned")
+}
+
+func TestCreateOrGetFromYouTube_ReturnExisting(t *testing.T) {
+	url := "https://www.youtube.com/watch?v=dQw4w9WgXcQ"
+	repo := &mockContentRepository{
+		getByURLFn: func(ctx context.Context, u string) (*domain.Content, error) {
+			return &domain.Content{ID: 9, URL: &url}, nil
+		},
+		createFn: func(ctx context.Context, content *domain.Content) (*domain.Content, error) {
+			t.Fatal("Create should not be called for existing content")
+			return nil, nil
+		},
+	}
+
+	svc := services.NewContentService(repo, &mockYouTubeClient{})
+	content, created, err := svc.CreateOrGetFromYouTube(context.Background(), url, domain.IfExistsReturnExisting)
+
+	require.NoError(t, err)
+	assert.False(t, created)
+	assert.Equal(t, 9, content.ID)
+}
+
+func TestCreateOrGetFromYouTube_Refresh(t *testing.T) {
+	url := "https://www.youtube.com/watch?v=dQw4w9WgXcQ"
+	stored := &domain.Content{ID: 9, Name: "Old", URL: &url, ContentType: domain.ContentTypeYouTube}
+	var updated bool
+	repo := &mockContentRepository{
+		getByURLFn: func(ctx context.Context, u string) (*domain.Content, error) {
+			return stored, nil
+		},
+		getByIDFn: func(ctx context.Context, id int) (*domain.Content, error) {
+			return stored, nil
+		},
+		updateFn: func(ctx context.Context, content *domain.Content) (*domain.Content, error) {
+			updated = true
+			return content, nil
+		},
+	}
+	ytClient := &mockYouTubeClient{
+		extractVideoIDFn: func(url string) (string, error) {
+			return "dQw4w9WgXcQ", nil
+		},
+		getVideoMetadataFn: func(ctx context.Context, videoID string) (*portservices.VideoMetadata, error) {
+			return &portservices.VideoMetadata{Title: "New"}, nil
+		},
+	}
+
+	svc := services.NewContentService(repo, ytClient)
+	content, created, err := svc.CreateOrGetFromYouTube(context.Background(), url, domain.IfExistsRefresh)
+
+	require.NoError(t, err)
+	assert.False(t, created)
+	assert.True(t, updated)
+	assert.Equal(t, "New", content.Name)
+}
+
+func TestCreateOrGetFromYouTube_Error(t *testing.T) {
+	url := "https://www.youtube.com/watch?v=dQw4w9WgXcQ"
+	repo := &mockContentRepository{
+		getByURLFn: func(ctx context.Context, u string) (*domain.Content, error) {
+			return &domain.Content{ID: 9, URL: &url}, nil
+		},
+	}
+
+	svc := services.NewContentService(repo, &mockYouTubeClient{})
+	_, created, err := svc.CreateOrGetFromYouTube(context.Background(), url, "")
+
+	assert.False(t, created)
+	assert.True(t, errors.Is(err, domain.ErrAlreadyExists), "empty policy defaults to ERROR")
+}
+
+func TestCreateOrGetFromYouTube_Created(t *testing.T) {
+	ytClient := &mockYouTubeClient{
+		extractVideoIDFn: func(url string) (string, error) {
+			return "dQw4w9WgXcQ", nil
+		},
+		getVideoMetadataFn: func(ctx context.Context, videoID string) (*portservices.VideoMetadata, error) {
+			return &portservices.VideoMetadata{Title: "Video"}, nil
+		},
+	}
+
+	svc := services.NewContentService(&mockContentRepository{}, ytClient)
+	content, created, err := svc.CreateOrGetFromYouTube(context.Background(), "https://youtu.be/dQw4w9WgXcQ", domain.IfExistsReturnExisting)
+
+	require.NoError(t, err)
+	assert.True(t, created)
+	assert.Equal(t, "Video", content.Name)
+}
+
+// racedRepo stores winner under its canonical key as soon as Create is
+// called, as a concurrent request that inserts first would
+func racedRepo(winner *domain.Content) *mockContentRepository {
+	stored := false
+	return &mockContentRepository{
+		getByCanonicalKeyFn: func(ctx context.Context, key string) (*domain.Content, error) {
+			if stored && winner.CanonicalKey != nil && key == *winner.CanonicalKey {
+				return winner, nil
+			}
+			return nil, domain.ErrNotFound
+		},
+		createFn: func(ctx context.Context, content *domain.Content) (*domain.Content, error) {
+			stored = true
+			return nil, fmt.Errorf("%w: content for %s already exists", domain.ErrAlreadyExists, *content.CanonicalKey)
+		},
+	}
+}
+
+func TestCreateOrGetFromYouTube_LosesCreateRace(t *testing.T) {
+	key := domain.CanonicalKey(domain.ContentTypeYouTube, "dQw4w9WgXcQ")
+	winner := &domain.Content{ID: 9, Name: "Video", CanonicalKey: &key}
+	ytClient := &mockYouTubeClient{
+		extractVideoIDFn: func(url string) (string, error) {
+			return "dQw4w9WgXcQ", nil
+		},
+		getVideoMetadataFn: func(ctx context.Context, videoID string) (*portservices.VideoMetadata, error) {
+			return &portservices.VideoMetadata{Title: "Video"}, nil
+		},
+	}
+
+	svc := services.NewContentService(racedRepo(winner), ytClient)
+	content, created, err := svc.CreateOrGetFromYouTube(context.Background(), "https://youtu.be/dQw4w9WgXcQ", domain.IfExistsReturnExisting)
+	require.NoError(t, err)
+	assert.False(t, created)
+	assert.Equal(t, 9, content.ID, "the row the other request stored is returned")
+
+	svc = services.NewContentService(racedRepo(winner), ytClient)
+	_, _, err = svc.CreateOrGetFromYouTube(context.Background(), "https://youtu.be/dQw4w9WgXcQ", domain.IfExistsError)
+	assert.ErrorIs(t, err, domain.ErrAlreadyExists)
+	assert.Contains(t, err.Error(), "content 9 already exists")
+}
+
+func TestCreateOrGetFromYouTube_NameTakenByOtherVideo(t *testing.T) {
+	otherKey := domain.CanonicalKey(domain.ContentTypeYouTube, "other")
+	ytClient := &mockYouTubeClient{
+		extractVideoIDFn: func(url string) (string, error) {
+			return "dQw4w9WgXcQ", nil
+		},
+		getVideoMetadataFn: func(ctx context.Context, videoID string) (*portservices.VideoMetadata, error) {
+			return &portservices.VideoMetadata{Title: "Video"}, nil
+		},
+	}
+
+	svc := services.NewContentService(racedRepo(&domain.Content{ID: 9, CanonicalKey: &otherKey}), ytClient)
+	_, created, err := svc.CreateOrGetFromYouTube(context.Background(), "https://youtu.be/dQw4w9WgXcQ", domain.IfExistsReturnExisting)
+
+	assert.False(t, created)
+	assert.ErrorIs(t, err, domain.ErrAlreadyExists, "a clash with a different video is not that video")
+}
+
+func TestCreateOrGetFromYouTube_UnknownPolicy(t *testing.T) {
+	svc := services.NewContentService(&mockContentRepository{}, &mockYouTubeClient{})
+
+	_, _, err := svc.CreateOrGetFromYouTube(context.Background(), "https://youtu.be/dQw4w9WgXcQ", "UPSERT")
+
+	assert.True(t, errors.Is(err, domain.ErrInvalidInput))
+}
+
+func TestUpdateContent_Name(t *testing.T) {
+	url := "https://youtube.com/watch?v=abc123"
+	repo := &mockContentRepository{
+		getByIDFn: func(ctx context.Context, id int) (*domain.Content, error) {
+			return &domain.Content{ID: id, Name: "Old", URL: &url, ContentType: domain.ContentTypeYouTube}, nil
+		},
+		updateFn: func(ctx context.Context, content *domain.Content) (*domain.Content, error) {
+			return content, nil
+		},
+	}
+
+	svc := services.NewContentService(repo, &mockYouTubeClient{})
+	name := "  New Title  "
+	result, err := svc.Update(context.Background(), portservices.UpdateContentInput{ID: 1, Name: &name})
+
+	require.NoError(t, err)
+	assert.Equal(t, "New Title", result.Name)
+	assert.Equal(t, &url, result.URL)
+}
+
+func TestUpdateContent_URL(t *testing.T) {
+	oldURL := "https://youtu.be/dQw4w9WgXcQ"
+	repo := &mockContentRepository{
+		getByIDFn: func(ctx context.Context, id int) (*domain.Content, error) {
+			return &domain.Content{ID: id, Name: "Video", URL: &oldURL, ContentType: domain.ContentTypeYouTube}, nil
+		},
+	}
+	ytClient := &mockYouTubeClient{
+		extractVideoIDFn: func(url string) (string, error) {
+			return "dQw4w9WgXcQ", nil
+		},
+	}
+
+	svc := services.NewContentService(repo, ytClient)
+	newURL := "https://www.youtube.com/watch?v=dQw4w9WgXcQ"
+	result, err := svc.Update(context.Background(), portservices.UpdateContentInput{ID: 1, URL: &newURL})
+
+	require.NoError(t, err)
+	require.NotNil(t, result.URL)
+	assert.Equal(t, newURL, *result.URL)
+}
+
+func TestUpdateContent_InvalidURL(t *testing.T) {
+	repo := &mockContentRepository{
+		getByIDFn: func(ctx context.Context, id int) (*domain.Content, error) {
+			return &domain.Content{ID: id, Name: "Video", ContentType: domain.ContentTypeYouTube}, nil
+		},
+	}
+
+	svc := services.NewContentService(repo, &mockYouTubeClient{})
+	badURL := "https://example.com/video"
+	result, err := svc.Update(context.Background(), portservices.UpdateContentInput{ID: 1, URL: &badURL})
+
+	assert.Nil(t, result)
+	require.Error(t, err)
+	assert.True(t, errors.Is(err, domain.ErrInvalidURL))
+}
+
+func TestUpdateContent_URLAlreadyUsed(t *testing.T) {
+	repo := &mockContentRepository{
+		getByIDFn: func(ctx context.Context, id int) (*domain.Content, error) {
+			return &domain.Content{ID: id, Name: "Video", ContentType: domain.ContentTypeYouTube}, nil
+		},
+		getByURLFn: func(ctx context.Context, url string) (*domain.Content, error) {
+			return &domain.Content{ID: 2, URL: &url}, nil
+		},
+	}
+	ytClient := &mockYouTubeClient{
+		extractVideoIDFn: func(url string) (string, error) {
+			return "dQw4w9WgXcQ", nil
+		},
+	}
+
+	svc := services.NewContentService(repo, ytClient)
+	url := "https://www.youtube.com/watch?v=dQw4w9WgXcQ"
+	result, err := svc.Update(context.Background(), portservices.UpdateContentInput{ID: 1, URL: &url})
+
+	assert.Nil(t, result)
+	require.Error(t, err)
+	assert.True(t, errors.Is(err, domain.ErrAlreadyExists))
+}
+
+func TestUpdateContent_URLOfAnotherContentsVideo(t *testing.T) {
+	repo := &mockContentRepository{
+		getByIDFn: func(ctx context.Context, id int) (*domain.Content, error) {
+			return &domain.Content{ID: id, Name: "Video", ContentType: domain.ContentTypeYouTube}, nil
+		},
+		getByCanonicalKeyFn: func(ctx context.Context, key string) (*domain.Content, error) {
+			return &domain.Content{ID: 2}, nil
+		},
+	}
+	ytClient := &mockYouTubeClient{
+		extractVideoIDFn: func(url string) (string, error) {
+			return "dQw4w9WgXcQ", nil
+		},
+	}
+
+	svc := services.NewContentService(repo, ytClient)
+	url := "https://youtu.be/dQw4w9WgXcQ"
+	_, err := svc.Update(context.Background(), portservices.UpdateContentInput{ID: 1, URL: &url})
+
+	require.Error(t, err)
+	assert.True(t, errors.Is(err, domain.ErrAlreadyExists))
+}
+
+func TestUpdateContent_URLToAliasOfSameContent(t *testing.T) {
+	storedURL := "https://www.youtube.com/watch?v=dQw4w9WgXcQ"
+	storedKey := "youtube:dQw4w9WgXcQ"
+	current := &domain.Content{ID: 1, Name: "Video", URL: &storedURL, CanonicalKey: &storedKey, ContentType: domain.ContentTypeYouTube}
+	repo := &mockContentRepository{
+		getByIDFn: func(ctx context.Context, id int) (*domain.Content, error) {
+			return current, nil
+		},
+		getByURLFn: func(ctx context.Context, url string) (*domain.Content, error) {
+			return current, nil
+		},
+		getByCanonicalKeyFn: func(ctx context.Context, key string) (*domain.Content, error) {
+			return current, nil
+		},
+	}
+	ytClient := &mockYouTubeClient{
+		extractVideoIDFn: func(url string) (string, error) {
+			return "dQw4w9WgXcQ", nil
+		},
+	}
+
+	svc := services.NewContentService(repo, ytClient)
+	url := "https://youtu.be/dQw4w9WgXcQ"
+	result, err := svc.Update(context.Background(), portservices.UpdateContentInput{ID: 1, URL: &url})
+
+	require.NoError(t, err)
+	assert.Equal(t, url, *result.URL)
+	assert.Equal(t, storedKey, *result.CanonicalKey)
+}
+
+func TestUpdateContent_NameTaken(t *testing.T) {
+	repo := &mockContentRepository{
+		getByIDFn: func(ctx context.Context, id int) (*domain.Content, error) {
+			return &domain.Content{ID: id, Name: "Video"}, nil
+		},
+		updateFn: func(ctx context.Context, content *domain.Content) (*domain.Content, error) {
+			return nil, fmt.Errorf("%w: content named %q already exists", domain.ErrAlreadyExists, content.Name)
+		},
+	}
+
+	svc := services.NewContentService(repo, &mockYouTubeClient{})
+	name := "Other Video"
+	result, err := svc.Update(context.Background(), portservices.UpdateContentInput{ID: 1, Name: &name})
+
+	assert.Nil(t, result)
+	require.Error(t, err)
+	assert.True(t, errors.Is(err, domain.ErrAlreadyExists))
+	assert.Contains(t, err.Error(), `content named "Other Video" already exists`)
+}
+
+func TestUpdateContent_EmptyName(t *testing.T) {
+	repo := &mockContentRepository{
+		getByIDFn: func(ctx context.Context, id int) (*domain.Content, error) {
+			return &domain.Content{ID: id, Name: "Video"}, nil
+		},
+	}
+
+	svc := services.NewContentService(repo, &mockYouTubeClient{})
+	name := "   "
+	result, err := svc.Update(context.Background(), portservices.UpdateContentInput{ID: 1, Name: &name})
+
+	assert.Nil(t, result)
+	require.Error(t, err)
+	assert.True(t, errors.Is(err, domain.ErrInvalidInput))
+	assert.Contains(t, err.Error(), "name is required")
+}
+
+func TestUpdateContent_NotFound(t *testing.T) {
+	svc := services.NewContentService(&mockContentRepository{}, &mockYouTubeClient{})
+	name := "New"
+	result, err := svc.Update(context.Background(), portservices.UpdateContentInput{ID: 99, Name: &name})
+
+	assert.Nil(t, result)
+	require.Error(t, err)
+	assert.True(t, errors.Is(err, domain.ErrNotFound))
+}
+
+func TestUpdateContent_InvalidID(t *testing.T) {
+	svc := services.NewContentService(&mockContentRepository{}, &mockYouTubeClient{})
+	result, err := svc.Update(context.Background(), portservices.UpdateContentInput{ID: 0})
+
+	assert.Nil(t, result)
+	require.Error(t, err)
+	assert.True(t, errors.Is(err, domain.ErrInvalidInput))
+}
+
+// --- Delete Tests ---
+
+func TestDeleteContent_DefaultsToRestrict(t *testing.T) {
+	var gotPolicy domain.ContentDeletePolicy
+	repo := &mockContentRepository{
+		getByIDFn: func(ctx context.Context, id int) (*domain.Content, error) {
+			return &domain.Content{ID: id}, nil
+		},
+		deleteFn: func(ctx context.Context, id int, policy domain.ContentDeletePolicy) error {
+			assert.Equal(t, 7, id)
+			gotPolicy = policy
+			return nil
+		},
+	}
+
+	svc := services.NewContentService(repo, &mockYouTubeClient{})
+	err := svc.Delete(context.Background(), 7, "")
+
+	require.NoError(t, err)
+	assert.Equal(t, domain.ContentDeletePolicyRestrict, gotPolicy)
+}
+
+func TestDeleteContent_Detach(t *testing.T) {
+	var gotPolicy domain.ContentDeletePolicy
+	repo := &mockContentRepository{
+		getByIDFn: func(ctx context.Context, id int) (*domain.Content, error) {
+			return &domain.Content{ID: id}, nil
+		},
+		deleteFn: func(ctx context.Context, id int, policy domain.ContentDeletePolicy) error {
+			gotPolicy = policy
+			return nil
+		},
+	}
+
+	svc := services.NewContentService(repo, &mockYouTubeClient{})
+	err := svc.Delete(context.Background(), 7, domain.ContentDeletePolicyDetach)
+
+	require.NoError(t, err)
+	assert.Equal(t, domain.ContentDeletePolicyDetach, gotPolicy)
+}
+
+func TestDeleteContent_BlockedByPerspectives(t *testing.T) {
+	repo := &mockContentRepository{
+		getByIDFn: func(ctx context.Context, id int) (*domain.Content, error) {
+			return &domain.Content{ID: id}, nil
+		},
+		deleteFn: func(ctx context.Context, id int, policy domain.ContentDeletePolicy) error {
+			return fmt.Errorf("%w: 3 perspective(s) reference content %d", domain.ErrContentInUse, id)
+		},
+	}
+
+	svc := services.NewContentService(repo, &mockYouTubeClient{})
+	err := svc.Delete(context.Background(), 7, domain.ContentDeletePolicyRestrict)
+
+	require.Error(t, err)
+	assert.True(t, errors.Is(err, domain.ErrContentInUse))
+}
+
+func TestDeleteContent_UnknownPolicy(t *testing.T) {
+	svc := services.NewContentService(&mockContentRepository{}, &mockYouTubeClient{})
+	err := svc.Delete(context.Background(), 7, domain.ContentDeletePolicy("CASCADE"))
+
+	require.Error(t, err)
+	assert.True(t, errors.Is(err, domain.ErrInvalidInput))
+}
+
+func TestDeleteContent_NotFound(t *testing.T) {
+	svc := services.NewContentService(&mockContentRepository{}, &mockYouTubeClient{})
+	err := svc.Delete(context.Background(), 7, domain.ContentDeletePolicyRestrict)
+
+	require.Error(t, err)
+	assert.True(t, errors.Is(err, domain.ErrNotFound))
+}
+
+func TestDeleteContent_InvalidID(t *testing.T) {
+	svc := services.NewContentService(&mockContentRepository{}, &mockYouTubeClient{})
+	err := svc.Delete(context.Background(), -1, domain.ContentDeletePolicyRestrict)
+
+	require.Error(t, err)
+	assert.True(t, errors.Is(err, domain.ErrInvalidInput))
+}
+
+// --- RefreshContent Tests ---
+
+func TestMergeContent_Success(t *testing.T) {
+	var gotSource, gotTarget int
+	repo := &mockContentRepository{
+		mergeFn: func(ctx context.Context, sourceID, targetID int) (*domain.ContentMergeResult, error) {
+			gotSource, gotTarget = sourceID, targetID
+			return &domain.ContentMergeResult{Target: &domain.Content{ID: targetID}, PerspectivesMoved: 3}, nil
+		},
+	}
+
+	svc := services.NewContentService(repo, &mockYouTubeClient{})
+	result, err := svc.MergeContent(context.Background(), 8, 2)
+
+	require.NoError(t, err)
+	assert.Equal(t, 8, gotSource)
+	assert.Equal(t, 2, gotTarget)
+	assert.Equal(t, 2, result.Target.ID)
+	assert.Equal(t, 3, result.PerspectivesMoved)
+}
+
+func TestMergeContent_InvalidInput(t *testing.T) {
+	repo := &mockContentRepository{
+		mergeFn: func(ctx context.Context, sourceID, targetID int) (*domain.ContentMergeResult, error) {
+			t.Fatal("Merge should not be called")
+			return nil, nil
+		},
+	}
+	svc := services.NewContentService(repo, &mockYouTubeClient{})
+
+	for _, ids := range [][2]int{{0, 2}, {2, -1}, {4, 4}} {
+		_, err := svc.MergeContent(context.Background(), ids[0], ids[1])
+		assert.True(t, errors.Is(err, domain.ErrInvalidInput), "ids %v", ids)
+	}
+}
+
+func TestMergeContent_NotFound(t *testing.T) {
+	svc := services.NewContentService(&mockContentRepository{}, &mockYouTubeClient{})
+
+	_, err := svc.MergeContent(context.Background(), 8, 2)
+
+	assert.True(t, errors.Is(err, domain.ErrNotFound))
+}
+
+func TestRefreshContent_Success(t *testing.T) {
+	url := "https://www.youtube.com/watch?v=dQw4w9WgXcQ"
+	oldLength := 100
+	existing := &domain.Content{
+		ID:          5,
+		Name:        "Old Title",
+		URL:         &url,
+		ContentType: domain.ContentTypeYouTube,
+		Length:      &oldLength,
+	}
+
+	var saved *domain.Content
+	repo := &mockContentRepository{
+		getByIDFn: func(ctx context.Context, id int) (*domain.Content, error) {
+			return existing, nil
+		},
+		updateFn: func(ctx context.Context, content *domain.Content) (*domain.Content, error) {
+			saved = content
+			return content, nil
+		},
+	}
+	ytClient := &mockYouTubeClient{
+		extractVideoIDFn: func(url string) (string, error) {
+			return "dQw4w9WgXcQ", nil
+		},
+		getVideoMetadataFn: func(ctx context.Context, videoID string) (*portservices.VideoMetadata, error) {
+			assert.True(t, portservices.CacheBypassed(ctx), "an explicit refresh skips the cache")
+			return &portservices.VideoMetadata{
+				Title:    "New Title",
+				Duration: 212,
+				Response: json.RawMessage(`{"items":[{"statistics":{"viewCount":"42"}}]}`),
+			}, nil
+		},
+	}
+
+	svc := services.NewContentService(repo, ytClient)
+	result, err := svc.RefreshContent(context.Background(), 5)
+
+	require.NoError(t, err)
+	require.NotNil(t, saved)
+	assert.Equal(t, "New Title", result.Name)
+	require.NotNil(t, result.Length)
+	assert.Equal(t, 212, *result.Length)
+	assert.JSONEq(t, `{"items":[{"statistics":{"viewCount":"42"}}]}`, string(result.Response))
+}
+
+func TestRefreshContent_UnchangedKeepsStoredResponse(t *testing.T) {
+	url := "https://www.youtube.com/watch?v=dQw4w9WgXcQ"
+	stored := json.RawMessage(`{"items":[{"id":"dQw4w9WgXcQ","snippet":{"title":"Title"}}]}`)
+	existing := &domain.Content{
+		ID:          5,
+		Name:        "Title",
+		URL:         &url,
+		ContentType: domain.ContentTypeYouTube,
+		Response:    stored,
+	}
+
+	repo := &mockContentRepository{
+		getByIDFn: func(ctx context.Context, id int) (*domain.Content, error) {
+			return existing, nil
+		},
+		updateFn: func(ctx context.Context, content *domain.Content) (*domain.Content, error) {
+			return content, nil
+		},
+	}
+	ytClient := &mockYouTubeClient{
+		extractVideoIDFn: func(url string) (string, error) {
+			return "dQw4w9WgXcQ", nil
+		},
+		getVideoMetadataFn: func(ctx context.Context, videoID string) (*portservices.VideoMetadata, error) {
+			return &portservices.VideoMetadata{
+				Title:     "Title",
+				Duration:  212,
+				Response:  json.RawMessage(`{"items":[{"id":"dQw4w9WgXcQ"}]}`),
+				Unchanged: true,
+			}, nil
+		},
+	}
+
+	svc := services.NewContentService(repo, ytClient)
+	result, err := svc.RefreshContent(context.Background(), 5)
+
+	require.NoError(t, err)
+	assert.JSONEq(t, string(stored), string(result.Response))
+	require.NotNil(t, result.Length)
+	assert.Equal(t, 212, *result.Length)
+}
+
+func TestRefreshContent_NotFound(t *testing.T) {
+	svc := services.NewContentService(&mockContentRepository{}, &mockYouTubeClient{})
+	_, err := svc.RefreshContent(context.Background(), 5)
+
+	require.Error(t, err)
+	assert.True(t, errors.Is(err, domain.ErrNotFound))
+}
+
+func TestRefreshContent_InvalidID(t *testing.T) {
+	svc := services.NewContentService(&mockContentRepository{}, &mockYouTubeClient{})
+	_, err := svc.RefreshContent(context.Background(), 0)
+
+	require.Error(t, err)
+	assert.True(t, errors.Is(err, domain.ErrInvalidInput))
+}
+
+func TestRefreshContent_YouTubeError(t *testing.T) {
+	url := "https://www.youtube.com/watch?v=dQw4w9WgXcQ"
+	repo := &mockContentRepository{
+		getByIDFn: func(ctx context.Context, id int) (*domain.Content, error) {
+			return &domain.Content{ID: id, URL: &url, ContentType: domain.ContentTypeYouTube}, nil
+		},
+		updateFn: func(ctx context.Context, content *domain.Content) (*domain.Content, error) {
+			t.Fatal("Update should not be called when the fetch fails")
+			return nil, nil
+		},
+	}
+	ytClient := &mockYouTubeClient{
+		extractVideoIDFn: func(url string) (string, error) {
+			return "dQw4w9WgXcQ", nil
+		},
+		getVideoMetadataFn: func(ctx context.Context, videoID string) (*portservices.VideoMetadata, error) {
+			return nil, fmt.Errorf("%w: status 500", domain.ErrYouTubeAPI)
+		},
+	}
+
+	svc := services.NewContentService(repo, ytClient)
+	_, err := svc.RefreshContent(context.Background(), 5)
+
+	require.Error(t, err)
+	assert.True(t, errors.Is(err, domain.ErrYouTubeAPI))
+}
+
+// --- Stats History Tests ---
+
+func TestRefreshContent_RecordsStatsSnapshot(t *testing.T) {
+	url := "https://www.youtube.com/watch?v=dQw4w9WgXcQ"
+	views, likes := int64(1500000000), int64(17000000)
+
+	var snapshot *domain.ContentStatsSnapshot
+	repo := &mockContentRepository{
+		getByIDFn: func(ctx context.Context, id int) (*domain.Content, error) {
+			return &domain.Content{ID: id, URL: &url, ContentType: domain.ContentTypeYouTube}, nil
+		},
+		recordStatsFn: func(ctx context.Context, s *domain.ContentStatsSnapshot) error {
+			snapshot = s
+			return nil
+		},
+	}
+	ytClient := &mockYouTubeClient{
+		extractVideoIDFn: func(url string) (string, error) {
+			return "dQw4w9WgXcQ", nil
+		},
+		getVideoMetadataFn: func(ctx context.Context, videoID string) (*portservices.VideoMetadata, error) {
+			return &portservices.VideoMetadata{Title: "T", ViewCount: &views, LikeCount: &likes}, nil
+		},
+	}
+
+	svc := services.NewContentService(repo, ytClient)
+	_, err := svc.RefreshContent(context.Background(), 9)
+
+	require.NoError(t, err)
+	require.NotNil(t, snapshot)
+	assert.Equal(t, 9, snapshot.ContentID)
+	assert.Equal(t, &views, snapshot.ViewCount)
+	assert.Equal(t, &likes, snapshot.LikeCount)
+	assert.Nil(t, snapshot.CommentCount, "hidden counts stay nil")
+	assert.WithinDuration(t, time.Now(), snapshot.CapturedAt, time.Minute)
+}
+
+func TestCreateFromYouTube_SnapshotFailureIsNotFatal(t *testing.T) {
+	repo := &mockContentRepository{
+		getByURLFn: func(ctx context.Context, url string) (*domain.Content, error) {
+			return nil, domain.ErrNotFound
+		},
+		createFn: func(ctx context.Context, content *domain.Content) (*domain.Content, error) {
+			content.ID = 1
+			return content, nil
+		},
+		recordStatsFn: func(ctx context.Context, s *domain.ContentStatsSnapshot) error {
+			assert.Equal(t, 1, s.ContentID)
+			return fmt.Errorf("table missing")
+		},
+	}
+	ytClient := &mockYouTubeClient{
+		extractVideoIDFn: func(url string) (string, error) {
+			return "dQw4w9WgXcQ", nil
+		},
+		getVideoMetadataFn: func(ctx context.Context, videoID string) (*portservices.VideoMetadata, error) {
+			return &portservices.VideoMetadata{Title: "T"}, nil
+		},
+	}
+
+	svc := services.NewContentService(repo, ytClient)
+	result, err := svc.CreateFromYouTube(context.Background(), "https://www.youtube.com/watch?v=dQw4w9WgXcQ")
+
+	require.NoError(t, err)
+	assert.Equal(t, 1, result.ID)
+}
+
+func TestStatsHistory_DefaultsToDaily(t *testing.T) {
+	var got domain.ContentStatsHistoryParams
+	repo := &mockContentRepository{
+		listStatsHistoryFn: func(ctx context.Context, params domain.ContentStatsHistoryParams) ([]*domain.ContentStatsBucket, error) {
+			got = params
+			return []*domain.ContentStatsBucket{{Samples: 2}}, nil
+		},
+	}
+
+	svc := services.NewContentService(repo, &mockYouTubeClient{})
+	buckets, err := svc.StatsHistory(context.Background(), domain.ContentStatsHistoryParams{ContentID: 3})
+
+	require.NoError(t, err)
+	assert.Len(t, buckets, 1)
+	assert.Equal(t, 3, got.ContentID)
+	assert.Equal(t, domain.StatsGranularityDay, got.Granularity)
+}
+
+func TestStatsHistory_InvalidParams(t *testing.T) {
+	from := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2026, 2, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name   string
+		params domain.ContentStatsHistoryParams
+	}{
+		{"invalid id", domain.ContentStatsHistoryParams{ContentID: 0}},
+		{"unknown granularity", domain.ContentStatsHistoryParams{ContentID: 1, Granularity: "MONTH"}},
+		{"from after to", domain.ContentStatsHistoryParams{ContentID: 1, From: &from, To: &to}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := services.NewContentService(&mockContentRepository{}, &mockYouTubeClient{})
+			_, err := svc.StatsHistory(context.Background(), tt.params)
+
+			require.Error(t, err)
+			assert.True(t, errors.Is(err, domain.ErrInvalidInput))
+		})
+	}
+}
+
+// --- NewContentService Tests ---
+
+func TestContentPage_DefaultsPageSize(t *testing.T) {
+	var got domain.ContentPageParams
+	repo := &mockContentRepository{
+		listPageFn: func(ctx context.Context, params domain.ContentPageParams) (*domain.ContentPage, error) {
+			got = params
+			return &domain.ContentPage{Items: []*domain.Content{}, Page: params.Page, PageSize: params.PageSize}, nil
+		},
+	}
+	svc := services.NewContentService(repo, &mockYouTubeClient{})
+
+	_, err := svc.ContentPage(context.Background(), domain.ContentPageParams{
+		Page:   2,
+		Filter: &domain.ContentFilter{ChannelTitles: []string{" Chan A "}},
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, 2, got.Page)
+	assert.Equal(t, domain.DefaultPageSize, got.PageSize)
+	require.NotNil(t, got.Filter)
+	assert.Equal(t, []string{"Chan A"}, got.Filter.ChannelTitles)
+}
+
+func TestContentPage_InvalidParams(t *testing.T) {
+	tests := []struct {
+		name   string
+		params domain.ContentPageParams
+		want   string
+	}{
+		{name: "page zero", params: domain.ContentPageParams{Page: 0}, want: "page must be at least 1"},
+		{name: "page size too large", params: domain.ContentPageParams{Page: 1, PageSize: domain.MaxPageSize + 1}, want: "pageSize must be between 1 and 100"},
+		{name: "negative page size", params: domain.ContentPageParams{Page: 1, PageSize: -1}, want: "pageSize must be between 1 and 100"},
+		{name: "too deep", params: domain.ContentPageParams{Page: 101, PageSize: 100}, want: "pages start at most 10000 items in"},
+		{name: "relevance without search", params: domain.ContentPageParams{Page: 1, SortBy: domain.ContentSortByRelevance}, want: "RELEVANCE sort requires a search"},
+		{name: "unknown count strategy", params: domain.ContentPageParams{Page: 1, CountStrategy: "GUESS"}, want: `unknown count strategy "GUESS"`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &mockContentRepository{
+				listPageFn: func(ctx context.Context, params domain.ContentPageParams) (*domain.ContentPage, error) {
+					t.Fatal("repository should not be called")
+					return nil, nil
+				},
+			}
+			svc := services.NewContentService(repo, &mockYouTubeClient{})
+
+			_, err := svc.ContentPage(context.Background(), tt.params)
+
+			require.Error(t, err)
+			assert.ErrorIs(t, err, domain.ErrInvalidInput)
+			assert.Contains(t, err.Error(), tt.want)
+		})
+	}
+}
+
+func TestListContent_CountStrategy(t *testing.T) {
+	var got domain.ContentListParams
+	repo := &mockContentRepository{
+		listFn: func(ctx context.Context, params domain.ContentListParams) (*domain.PaginatedContent, error) {
+			got = params
+			return &domain.PaginatedContent{Items: []*domain.Content{}}, nil
+		},
+	}
+	svc := services.NewContentService(repo, &mockYouTubeClient{})
+
+	_, err := svc.ListContent(context.Background(), domain.ContentListParams{IncludeTotalCount: true, CountStrategy: domain.CountStrategyCached})
+	require.NoError(t, err)
+	assert.Equal(t, domain.CountStrategyCached, got.CountStrategy)
+
+	_, err = svc.ListContent(context.Background(), domain.ContentListParams{CountStrategy: "GUESS"})
+	require.Error(t, err)
+	assert.ErrorIs(t, err, domain.ErrInvalidInput)
+}
+
+func TestContentPage_LastAllowedPage(t *testing.T) {
+	svc := services.NewContentService(&mockContentRepository{}, &mockYouTubeClient{})
+
+	_, err := svc.ContentPage(context.Background(), domain.ContentPageParams{Page: 100, PageSize: 100})
+
+	assert.NoError(t, err)
+}
+
+func TestContentFacets_DeduplicatesAndNormalizes(t *testing.T) {
+	var got domain.ContentFacetParams
+	repo := &mockContentRepository{
+		facetsFn: func(ctx context.Context, params domain.ContentFacetParams) ([]*domain.ContentFacetCounts, error) {
+			got = params
+			return []*domain.ContentFacetCounts{{Facet: domain.ContentFacetTag, Values: []domain.FacetValue{{Value: "go", Count: 3}}}}, nil
+		},
+	}
+	svc := services.NewContentService(repo, &mockYouTubeClient{})
+
+	counts, err := svc.ContentFacets(context.Background(), domain.ContentFacetParams{
+		Filter: &domain.ContentFilter{TagsAny: []string{" go "}},
+		Facets: []domain.ContentFacet{domain.ContentFacetTag, domain.ContentFacetChannel, domain.ContentFacetTag},
+	})
+	require.NoError(t, err)
+	require.Len(t, counts, 1)
+
+	assert.Equal(t, []domain.ContentFacet{domain.ContentFacetTag, domain.ContentFacetChannel}, got.Facets)
+	require.NotNil(t, got.Filter)
+	assert.Equal(t, []string{"go"}, got.Filter.TagsAny)
+}
+
+func TestContentFacets_InvalidParams(t *testing.T) {
+	badSearch := "-- !!"
+	minViews := int64(-5)
+	tests := []struct {
+		name   string
+		params domain.ContentFacetParams
+		want   string
+	}{
+		{name: "no facets", params: domain.ContentFacetParams{}, want: "at least one facet is required"},
+		{name: "unknown facet", params: domain.ContentFacetParams{Facets: []domain.ContentFacet{"COLOR"}}, want: `unknown facet "COLOR"`},
+		{
+			name:   "invalid filter",
+			params: domain.ContentFacetParams{Facets: []domain.ContentFacet{domain.ContentFacetTag}, Filter: &domain.ContentFilter{MinViewCount: &minViews}},
+			want:   "minViewCount must not be negative",
+		},
+		{
+			name:   "invalid search",
+			params: domain.ContentFacetParams{Facets: []domain.ContentFacet{domain.ContentFacetTag}, Filter: &domain.ContentFilter{Search: &badSearch}},
+			want:   "search must contain at least one word",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &mockContentRepository{
+				facetsFn: func(ctx context.Context, params domain.ContentFacetParams) ([]*domain.ContentFacetCounts, error) {
+					t.Fatal("repository should not be called")
+					return nil, nil
+				},
+			}
+			svc := services.NewContentService(repo, &mockYouTubeClient{})
+
+			_, err := svc.ContentFacets(context.Background(), tt.params)
+
+			require.Error(t, err)
+			assert.ErrorIs(t, err, domain.ErrInvalidInput)
+			assert.Contains(t, err.Error(), tt.want)
+		})
+	}
+}
+
+func TestContentFacets_RepositoryError(t *testing.T) {
+	repo := &mockContentRepository{
+		facetsFn: func(ctx context.Context, params domain.ContentFacetParams) ([]*domain.ContentFacetCounts, error) {
+			return nil, errors.New("connection reset")
+		},
+	}
+	svc := services.NewContentService(repo, &mockYouTubeClient{})
+
+	_, err := svc.ContentFacets(context.Background(), domain.ContentFacetParams{Facets: []domain.ContentFacet{domain.ContentFacetChannel}})
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "failed to count content facets")
+}
 
 func TestNewContentService(t *testing.T) {
 	repo := &mockContentRepository{}
@@ -4,7 +4,9 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/CodeWarrior-debug/perspectize/backend/internal/core/domain"
 	portservices "github.com/CodeWarrior-debug/perspectize/backend/internal/core/ports/services"
@@ -90,6 +92,10 @@ func (m *mockUserRepoForPerspective) ListAll(ctx context.Context) ([]*domain.Use
 	return []*domain.User{}, nil
 }
 
+func (m *mockUserRepoForPerspective) Search(ctx context.Context, query string, limit int) ([]*domain.User, error) {
+	return []*domain.User{}, nil
+}
+
 func (m *mockUserRepoForPerspective) Update(ctx context.Context, user *domain.User) (*domain.User, error) {
 	return user, nil
 }
@@ -109,7 +115,7 @@ func TestPerspectiveCreate_Success(t *testing.T) {
 	}
 	userRepo := &mockUserRepoForPerspective{}
 
-	svc := services.NewPerspectiveService(perspectiveRepo, userRepo)
+	svc := services.NewPerspectiveService(perspectiveRepo, userRepo, &mockContentRepository{})
 	input := portservices.CreatePerspectiveInput{
 		UserID: 1,
 	}
@@ -131,7 +137,7 @@ func TestPerspectiveCreate_WithRatings(t *testing.T) {
 	}
 	userRepo := &mockUserRepoForPerspective{}
 
-	svc := services.NewPerspectiveService(perspectiveRepo, userRepo)
+	svc := services.NewPerspectiveService(perspectiveRepo, userRepo, &mockContentRepository{})
 	quality := 8000
 	agreement := 5000
 	input := portservices.CreatePerspectiveInput{
@@ -155,7 +161,7 @@ func TestPerspectiveCreate_UserNotFound(t *testing.T) {
 		},
 	}
 
-	svc := services.NewPerspectiveService(perspectiveRepo, userRepo)
+	svc := services.NewPerspectiveService(perspectiveRepo, userRepo, &mockContentRepository{})
 	input := portservices.CreatePerspectiveInput{
 		UserID: 999,
 	}
@@ -171,7 +177,7 @@ func TestPerspectiveCreate_InvalidUserID(t *testing.T) {
 	perspectiveRepo := &mockPerspectiveRepository{}
 	userRepo := &mockUserRepoForPerspective{}
 
-	svc := services.NewPerspectiveService(perspectiveRepo, userRepo)
+	svc := services.NewPerspectiveService(perspectiveRepo, userRepo, &mockContentRepository{})
 	input := portservices.CreatePerspectiveInput{
 		UserID: 0,
 	}
@@ -187,7 +193,7 @@ func TestPerspectiveCreate_RatingTooHigh(t *testing.T) {
 	perspectiveRepo := &mockPerspectiveRepository{}
 	userRepo := &mockUserRepoForPerspective{}
 
-	svc := services.NewPerspectiveService(perspectiveRepo, userRepo)
+	svc := services.NewPerspectiveService(perspectiveRepo, userRepo, &mockContentRepository{})
 	quality := 10001
 	input := portservices.CreatePerspectiveInput{
 		UserID:  1,
@@ -205,7 +211,7 @@ func TestPerspectiveCreate_RatingNegative(t *testing.T) {
 	perspectiveRepo := &mockPerspectiveRepository{}
 	userRepo := &mockUserRepoForPerspective{}
 
-	svc := services.NewPerspectiveService(perspectiveRepo, userRepo)
+	svc := services.NewPerspectiveService(perspectiveRepo, userRepo, &mockContentRepository{})
 	agreement := -1
 	input := portservices.CreatePerspectiveInput{
 		UserID:    1,
@@ -227,7 +233,7 @@ func TestPerspectiveCreate_RepositoryError(t *testing.T) {
 	}
 	userRepo := &mockUserRepoForPerspective{}
 
-	svc := services.NewPerspectiveService(perspectiveRepo, userRepo)
+	svc := services.NewPerspectiveService(perspectiveRepo, userRepo, &mockContentRepository{})
 	input := portservices.CreatePerspectiveInput{
 		UserID: 1,
 	}
@@ -255,7 +261,7 @@ func TestPerspectiveGetByID_Success(t *testing.T) {
 	}
 	userRepo := &mockUserRepoForPerspective{}
 
-	svc := services.NewPerspectiveService(perspectiveRepo, userRepo)
+	svc := services.NewPerspectiveService(perspectiveRepo, userRepo, &mockContentRepository{})
 	result, err := svc.GetByID(context.Background(), 1)
 
 	require.NoError(t, err)
@@ -270,7 +276,7 @@ func TestPerspectiveGetByID_NotFound(t *testing.T) {
 	}
 	userRepo := &mockUserRepoForPerspective{}
 
-	svc := services.NewPerspectiveService(perspectiveRepo, userRepo)
+	svc := services.NewPerspectiveService(perspectiveRepo, userRepo, &mockContentRepository{})
 	result, err := svc.GetByID(context.Background(), 999)
 
 	assert.Nil(t, result)
@@ -282,7 +288,7 @@ func TestPerspectiveGetByID_InvalidID(t *testing.T) {
 	perspectiveRepo := &mockPerspectiveRepository{}
 	userRepo := &mockUserRepoForPerspective{}
 
-	svc := services.NewPerspectiveService(perspectiveRepo, userRepo)
+	svc := services.NewPerspectiveService(perspectiveRepo, userRepo, &mockContentRepository{})
 	result, err := svc.GetByID(context.Background(), 0)
 
 	assert.Nil(t, result)
@@ -303,7 +309,7 @@ func TestPerspectiveDelete_Success(t *testing.T) {
 	}
 	userRepo := &mockUserRepoForPerspective{}
 
-	svc := services.NewPerspectiveService(perspectiveRepo, userRepo)
+	svc := services.NewPerspectiveService(perspectiveRepo, userRepo, &mockContentRepository{})
 	err := svc.Delete(context.Background(), 1)
 
 	require.NoError(t, err)
@@ -317,7 +323,7 @@ func TestPerspectiveDelete_NotFound(t *testing.T) {
 	}
 	userRepo := &mockUserRepoForPerspective{}
 
-	svc := services.NewPerspectiveService(perspectiveRepo, userRepo)
+	svc := services.NewPerspectiveService(perspectiveRepo, userRepo, &mockContentRepository{})
 	err := svc.Delete(context.Background(), 999)
 
 	require.Error(t, err)
@@ -328,7 +334,7 @@ func TestPerspectiveDelete_InvalidID(t *testing.T) {
 	perspectiveRepo := &mockPerspectiveRepository{}
 	userRepo := &mockUserRepoForPerspective{}
 
-	svc := services.NewPerspectiveService(perspectiveRepo, userRepo)
+	svc := services.NewPerspectiveService(perspectiveRepo, userRepo, &mockContentRepository{})
 	err := svc.Delete(context.Background(), 0)
 
 	require.Error(t, err)
@@ -354,7 +360,7 @@ func TestPerspectiveList_Success(t *testing.T) {
 	}
 	userRepo := &mockUserRepoForPerspective{}
 
-	svc := services.NewPerspectiveService(perspectiveRepo, userRepo)
+	svc := services.NewPerspectiveService(perspectiveRepo, userRepo, &mockContentRepository{})
 	result, err := svc.ListPerspectives(context.Background(), domain.PerspectiveListParams{})
 
 	require.NoError(t, err)
@@ -365,7 +371,7 @@ func TestPerspectiveList_InvalidFirst(t *testing.T) {
 	perspectiveRepo := &mockPerspectiveRepository{}
 	userRepo := &mockUserRepoForPerspective{}
 
-	svc := services.NewPerspectiveService(perspectiveRepo, userRepo)
+	svc := services.NewPerspectiveService(perspectiveRepo, userRepo, &mockContentRepository{})
 	first := 0
 	result, err := svc.ListPerspectives(context.Background(), domain.PerspectiveListParams{First: &first})
 
@@ -378,7 +384,7 @@ func TestPerspectiveList_FirstTooLarge(t *testing.T) {
 	perspectiveRepo := &mockPerspectiveRepository{}
 	userRepo := &mockUserRepoForPerspective{}
 
-	svc := services.NewPerspectiveService(perspectiveRepo, userRepo)
+	svc := services.NewPerspectiveService(perspectiveRepo, userRepo, &mockContentRepository{})
 	first := 101
 	result, err := svc.ListPerspectives(context.Background(), domain.PerspectiveListParams{First: &first})
 
@@ -387,13 +393,371 @@ func TestPerspectiveList_FirstTooLarge(t *testing.T) {
 	assert.True(t, errors.Is(err, domain.ErrInvalidInput))
 }
 
+func TestPerspectiveList_UnknownCountStrategy(t *testing.T) {
+	svc := services.NewPerspectiveService(&mockPerspectiveRepository{}, &mockUserRepoForPerspective{}, &mockContentRepository{})
+
+	result, err := svc.ListPerspectives(context.Background(), domain.PerspectiveListParams{CountStrategy: "GUESS"})
+
+	assert.Nil(t, result)
+	require.Error(t, err)
+	assert.True(t, errors.Is(err, domain.ErrInvalidInput))
+	assert.Contains(t, err.Error(), `unknown count strategy "GUESS"`)
+}
+
+func TestPerspectiveList_SearchIsLimitedToPublic(t *testing.T) {
+	var got domain.PerspectiveListParams
+	perspectiveRepo := &mockPerspectiveRepository{
+		listFn: func(ctx context.Context, params domain.PerspectiveListParams) (*domain.PaginatedPerspectives, error) {
+			got = params
+			return &domain.PaginatedPerspectives{}, nil
+		},
+	}
+
+	svc := services.NewPerspectiveService(perspectiveRepo, &mockUserRepoForPerspective{}, &mockContentRepository{})
+	search := "misinformation"
+	userID := 3
+	filter := &domain.PerspectiveFilter{UserID: &userID, Search: &search}
+	_, err := svc.ListPerspectives(context.Background(), domain.PerspectiveListParams{
+		SortBy: domain.PerspectiveSortByRelevance,
+		Filter: filter,
+	})
+
+	require.NoError(t, err)
+	require.NotNil(t, got.Filter)
+	require.NotNil(t, got.Filter.Privacy)
+	assert.Equal(t, domain.PrivacyPublic, *got.Filter.Privacy)
+	assert.Equal(t, &userID, got.Filter.UserID)
+	assert.Nil(t, filter.Privacy, "caller's filter should not be modified")
+}
+
+func TestPerspectiveList_SearchValidation(t *testing.T) {
+	private := domain.PrivacyPrivate
+	hoax, punctuation := "hoax", "-- !!"
+	tests := []struct {
+		name   string
+		params domain.PerspectiveListParams
+		want   string
+	}{
+		{
+			name:   "private perspectives",
+			params: domain.PerspectiveListParams{Filter: &domain.PerspectiveFilter{Search: &hoax, Privacy: &private}},
+			want:   "search covers public perspectives only",
+		},
+		{
+			name:   "no words",
+			params: domain.PerspectiveListParams{Filter: &domain.PerspectiveFilter{Search: &punctuation}},
+			want:   "search must contain at least one word",
+		},
+		{
+			name:   "relevance without search",
+			params: domain.PerspectiveListParams{SortBy: domain.PerspectiveSortByRelevance},
+			want:   "RELEVANCE sort requires a search",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			perspectiveRepo := &mockPerspectiveRepository{
+				listFn: func(ctx context.Context, params domain.PerspectiveListParams) (*domain.PaginatedPerspectives, error) {
+					t.Fatal("repository should not be called")
+					return nil, nil
+				},
+			}
+
+			svc := services.NewPerspectiveService(perspectiveRepo, &mockUserRepoForPerspective{}, &mockContentRepository{})
+			result, err := svc.ListPerspectives(context.Background(), tt.params)
+
+			assert.Nil(t, result)
+			require.Error(t, err)
+			assert.True(t, errors.Is(err, domain.ErrInvalidInput))
+			assert.Contains(t, err.Error(), tt.want)
+		})
+	}
+}
+
+func TestPerspectiveList_NormalizesFilter(t *testing.T) {
+	var got domain.PerspectiveListParams
+	perspectiveRepo := &mockPerspectiveRepository{
+		listFn: func(ctx context.Context, params domain.PerspectiveListParams) (*domain.PaginatedPerspectives, error) {
+			got = params
+			return &domain.PaginatedPerspectives{}, nil
+		},
+	}
+
+	svc := services.NewPerspectiveService(perspectiveRepo, &mockUserRepoForPerspective{}, &mockContentRepository{})
+	category := "  Science "
+	minQuality, maxQuality := 5000, 9000
+	_, err := svc.ListPerspectives(context.Background(), domain.PerspectiveListParams{
+		Filter: &domain.PerspectiveFilter{
+			Quality:           &domain.IntRange{Min: &minQuality, Max: &maxQuality},
+			LabelsAny:         []string{" health", "policy "},
+			Category:          &category,
+			CategorizedRating: &domain.CategorizedRatingFilter{Category: " accuracy ", MinRating: 7000},
+		},
+	})
+
+	require.NoError(t, err)
+	require.NotNil(t, got.Filter)
+	assert.Equal(t, []string{"health", "policy"}, got.Filter.LabelsAny)
+	assert.Equal(t, "Science", *got.Filter.Category)
+	assert.Equal(t, &domain.CategorizedRatingFilter{Category: "accuracy", MinRating: 7000}, got.Filter.CategorizedRating)
+	assert.Equal(t, &domain.IntRange{Min: &minQuality, Max: &maxQuality}, got.Filter.Quality)
+	assert.Nil(t, got.Filter.Privacy, "privacy is only forced for searches")
+}
+
+func TestPerspectiveList_FilterValidation(t *testing.T) {
+	low, high, tooHigh := 100, 9000, 10001
+	blank := "  "
+	unknown := domain.ReviewStatus("ARCHIVED")
+	jan := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	feb := time.Date(2026, 2, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name   string
+		filter domain.PerspectiveFilter
+		want   string
+	}{
+		{"inverted rating range", domain.PerspectiveFilter{Agreement: &domain.IntRange{Min: &high, Max: &low}}, "agreement min must not exceed max"},
+		{"rating out of range", domain.PerspectiveFilter{Confidence: &domain.IntRange{Max: &tooHigh}}, "confidence range must be between 0 and 10000"},
+		{"blank label", domain.PerspectiveFilter{LabelsAll: []string{"health", ""}}, "labelsAll values must not be blank"},
+		{"blank category", domain.PerspectiveFilter{Category: &blank}, "category must not be blank"},
+		{"unknown review status", domain.PerspectiveFilter{ReviewStatus: &unknown}, "unknown review status"},
+		{"categorized rating without category", domain.PerspectiveFilter{CategorizedRating: &domain.CategorizedRatingFilter{MinRating: 10}}, "categorized rating category is required"},
+		{"categorized rating out of range", domain.PerspectiveFilter{CategorizedRating: &domain.CategorizedRatingFilter{Category: "accuracy", MinRating: tooHigh}}, "minRating must be between 0 and 10000"},
+		{"inverted date range", domain.PerspectiveFilter{UpdatedAt: &domain.TimeRange{From: &feb, To: &jan}}, "updatedAt from must be before to"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			perspectiveRepo := &mockPerspectiveRepository{
+				listFn: func(ctx context.Context, params domain.PerspectiveListParams) (*domain.PaginatedPerspectives, error) {
+					t.Fatal("repository should not be called")
+					return nil, nil
+				},
+			}
+
+			svc := services.NewPerspectiveService(perspectiveRepo, &mockUserRepoForPerspective{}, &mockContentRepository{})
+			filter := tt.filter
+			result, err := svc.ListPerspectives(context.Background(), domain.PerspectiveListParams{Filter: &filter})
+
+			assert.Nil(t, result)
+			require.Error(t, err)
+			assert.True(t, errors.Is(err, domain.ErrInvalidInput))
+			assert.Contains(t, err.Error(), tt.want)
+		})
+	}
+}
+
+func TestPerspectiveList_SortCategory(t *testing.T) {
+	var got domain.PerspectiveListParams
+	perspectiveRepo := &mockPerspectiveRepository{
+		listFn: func(ctx context.Context, params domain.PerspectiveListParams) (*domain.PaginatedPerspectives, error) {
+			got = params
+			return &domain.PaginatedPerspectives{}, nil
+		},
+	}
+
+	svc := services.NewPerspectiveService(perspectiveRepo, &mockUserRepoForPerspective{}, &mockContentRepository{})
+	_, err := svc.ListPerspectives(context.Background(), domain.PerspectiveListParams{
+		SortBy:       domain.PerspectiveSortByCategorizedRating,
+		SortCategory: " clarity ",
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, "clarity", got.SortCategory)
+}
+
+func TestPerspectiveList_SortCategoryValidation(t *testing.T) {
+	tests := []struct {
+		name   string
+		params domain.PerspectiveListParams
+		want   string
+	}{
+		{
+			name:   "categorized rating without category",
+			params: domain.PerspectiveListParams{SortBy: domain.PerspectiveSortByCategorizedRating, SortCategory: "  "},
+			want:   "CATEGORIZED_RATING sort requires a sort category",
+		},
+		{
+			name:   "category with another sort",
+			params: domain.PerspectiveListParams{SortBy: domain.PerspectiveSortByQuality, SortCategory: "clarity"},
+			want:   "sort category applies only to CATEGORIZED_RATING sort",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			perspectiveRepo := &mockPerspectiveRepository{
+				listFn: func(ctx context.Context, params domain.PerspectiveListParams) (*domain.PaginatedPerspectives, error) {
+					t.Fatal("repository should not be called")
+					return nil, nil
+				},
+			}
+
+			svc := services.NewPerspectiveService(perspectiveRepo, &mockUserRepoForPerspective{}, &mockContentRepository{})
+			result, err := svc.ListPerspectives(context.Background(), tt.params)
+
+			assert.Nil(t, result)
+			require.Error(t, err)
+			assert.True(t, errors.Is(err, domain.ErrInvalidInput))
+			assert.Contains(t, err.Error(), tt.want)
+		})
+	}
+}
+
+// --- Segment Tests ---
+
+// contentWithLength returns a content repository whose items are lengthSeconds long
+func contentWithLength(lengthSeconds int) *mockContentRepository {
+	units := "seconds"
+	return &mockContentRepository{
+		getByIDFn: func(ctx context.Context, id int) (*domain.Content, error) {
+			return &domain.Content{ID: id, Length: &lengthSeconds, LengthUnits: &units}, nil
+		},
+	}
+}
+
+func TestPerspectiveCreate_SegmentsSortedAndPositioned(t *testing.T) {
+	svc := services.NewPerspectiveService(&mockPerspectiveRepository{}, &mockUserRepoForPerspective{}, contentWithLength(600))
+
+	note := "the key claim"
+	quality := 8000
+	contentID := 5
+	result, err := svc.Create(context.Background(), portservices.CreatePerspectiveInput{
+		UserID:    1,
+		ContentID: &contentID,
+		Segments: []domain.PerspectiveSegment{
+			{StartMs: 300000, EndMs: 600000},
+			{StartMs: 61500, EndMs: 90000, Note: &note, Quality: &quality},
+		},
+	})
+
+	require.NoError(t, err)
+	require.Len(t, result.Segments, 2)
+	assert.Equal(t, domain.PerspectiveSegment{Position: 0, StartMs: 61500, EndMs: 90000, Note: &note, Quality: &quality}, result.Segments[0])
+	assert.Equal(t, domain.PerspectiveSegment{Position: 1, StartMs: 300000, EndMs: 600000}, result.Segments[1])
+}
+
+func TestPerspectiveCreate_InvalidSegments(t *testing.T) {
+	contentID := 5
+	badRating := 10001
+	longNote := strings.Repeat("a", domain.MaxSegmentNoteLength+1)
+	longMultibyteNote := strings.Repeat("é", domain.MaxSegmentNoteLength+1)
+
+	tests := []struct {
+		name      string
+		contentID *int
+		segments  []domain.PerspectiveSegment
+		wantErr   error
+		wantMsg   string
+	}{
+		{name: "no content", segments: []domain.PerspectiveSegment{{StartMs: 0, EndMs: 1000}}, wantErr: domain.ErrInvalidInput, wantMsg: "require a content_id"},
+		{name: "negative start", contentID: &contentID, segments: []domain.PerspectiveSegment{{StartMs: -1, EndMs: 1000}}, wantErr: domain.ErrInvalidInput, wantMsg: "starts before 0"},
+		{name: "empty range", contentID: &contentID, segments: []domain.PerspectiveSegment{{StartMs: 5000, EndMs: 5000}}, wantErr: domain.ErrInvalidInput, wantMsg: "must end after it starts"},
+		{name: "past content length", contentID: &contentID, segments: []domain.PerspectiveSegment{{StartMs: 0, EndMs: 600001}}, wantErr: domain.ErrInvalidInput, wantMsg: "after the content's length of 600s"},
+		{name: "note too long", contentID: &contentID, segments: []domain.PerspectiveSegment{{StartMs: 0, EndMs: 1000, Note: &longNote}}, wantErr: domain.ErrInvalidInput, wantMsg: "note exceeds"},
+		{name: "multibyte note too long", contentID: &contentID, segments: []domain.PerspectiveSegment{{StartMs: 0, EndMs: 1000, Note: &longMultibyteNote}}, wantErr: domain.ErrInvalidInput, wantMsg: "note exceeds"},
+		{name: "bad rating", contentID: &contentID, segments: []domain.PerspectiveSegment{{StartMs: 0, EndMs: 1000, Agreement: &badRating}}, wantErr: domain.ErrInvalidRating, wantMsg: "segment 0 agreement 10001"},
+		{name: "too many", contentID: &contentID, segments: make([]domain.PerspectiveSegment, domain.MaxPerspectiveSegments+1), wantErr: domain.ErrInvalidInput, wantMsg: "at most 100 segments"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := services.NewPerspectiveService(&mockPerspectiveRepository{}, &mockUserRepoForPerspective{}, contentWithLength(600))
+
+			_, err := svc.Create(context.Background(), portservices.CreatePerspectiveInput{
+				UserID:    1,
+				ContentID: tt.contentID,
+				Segments:  tt.segments,
+			})
+
+			require.ErrorIs(t, err, tt.wantErr)
+			assert.Contains(t, err.Error(), tt.wantMsg)
+		})
+	}
+}
+
+func TestPerspectiveCreate_SegmentNoteLimitCountsCharacters(t *testing.T) {
+	svc := services.NewPerspectiveService(&mockPerspectiveRepository{}, &mockUserRepoForPerspective{}, contentWithLength(600))
+
+	// 2000 characters but 4000 bytes
+	note := strings.Repeat("é", domain.MaxSegmentNoteLength)
+	contentID := 5
+	result, err := svc.Create(context.Background(), portservices.CreatePerspectiveInput{
+		UserID:    1,
+		ContentID: &contentID,
+		Segments:  []domain.PerspectiveSegment{{StartMs: 0, EndMs: 1000, Note: &note}},
+	})
+
+	require.NoError(t, err)
+	require.Len(t, result.Segments, 1)
+	assert.Equal(t, note, *result.Segments[0].Note)
+}
+
+func TestPerspectiveCreate_SegmentsContentNotFound(t *testing.T) {
+	svc := services.NewPerspectiveService(&mockPerspectiveRepository{}, &mockUserRepoForPerspective{}, &mockContentRepository{
+		getByIDFn: func(ctx context.Context, id int) (*domain.Content, error) {
+			return nil, domain.ErrNotFound
+		},
+	})
+
+	contentID := 99
+	_, err := svc.Create(context.Background(), portservices.CreatePerspectiveInput{
+		UserID:    1,
+		ContentID: &contentID,
+		Segments:  []domain.PerspectiveSegment{{StartMs: 0, EndMs: 1000}},
+	})
+
+	require.ErrorIs(t, err, domain.ErrNotFound)
+	assert.Contains(t, err.Error(), "content with id 99 not found")
+}
+
+func TestPerspectiveUpdate_Segments(t *testing.T) {
+	contentID := 5
+	existing := func() *domain.Perspective {
+		return &domain.Perspective{
+			ID:        1,
+			UserID:    1,
+			ContentID: &contentID,
+			Segments:  []domain.PerspectiveSegment{{Position: 0, StartMs: 0, EndMs: 500000}},
+		}
+	}
+	perspectiveRepo := &mockPerspectiveRepository{
+		getByIDFn: func(ctx context.Context, id int) (*domain.Perspective, error) {
+			return existing(), nil
+		},
+	}
+
+	t.Run("nil keeps segments", func(t *testing.T) {
+		svc := services.NewPerspectiveService(perspectiveRepo, &mockUserRepoForPerspective{}, contentWithLength(600))
+		result, err := svc.Update(context.Background(), portservices.UpdatePerspectiveInput{ID: 1})
+		require.NoError(t, err)
+		assert.Equal(t, existing().Segments, result.Segments)
+	})
+
+	t.Run("empty clears segments", func(t *testing.T) {
+		svc := services.NewPerspectiveService(perspectiveRepo, &mockUserRepoForPerspective{}, contentWithLength(600))
+		result, err := svc.Update(context.Background(), portservices.UpdatePerspectiveInput{ID: 1, Segments: []domain.PerspectiveSegment{}})
+		require.NoError(t, err)
+		assert.Empty(t, result.Segments)
+	})
+
+	t.Run("changing content revalidates stored segments", func(t *testing.T) {
+		shorter := 7
+		svc := services.NewPerspectiveService(perspectiveRepo, &mockUserRepoForPerspective{}, contentWithLength(300))
+		_, err := svc.Update(context.Background(), portservices.UpdatePerspectiveInput{ID: 1, ContentID: &shorter})
+		require.ErrorIs(t, err, domain.ErrInvalidInput)
+		assert.Contains(t, err.Error(), "after the content's length of 300s")
+	})
+}
+
 // --- NewPerspectiveService Tests ---
 
 func TestNewPerspectiveService(t *testing.T) {
 	perspectiveRepo := &mockPerspectiveRepository{}
 	userRepo := &mockUserRepoForPerspective{}
 
-	svc := services.NewPerspectiveService(perspectiveRepo, userRepo)
+	svc := services.NewPerspectiveService(perspectiveRepo, userRepo, &mockContentRepository{})
 
 	assert.NotNil(t, svc)
 }
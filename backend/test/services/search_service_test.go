@@ -0,0 +1,87 @@
+package services_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/CodeWarrior-debug/perspectize/backend/internal/core/domain"
+	"github.com/CodeWarrior-debug/perspectize/backend/internal/core/services"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// mockSearchRepository implements repositories.SearchRepository for testing
+type mockSearchRepository struct {
+	suggestFn func(ctx context.Context, query string, limit int) ([]*domain.SearchSuggestion, error)
+}
+
+func (m *mockSearchRepository) Suggest(ctx context.Context, query string, limit int) ([]*domain.SearchSuggestion, error) {
+	if m.suggestFn != nil {
+		return m.suggestFn(ctx, query, limit)
+	}
+	return []*domain.SearchSuggestion{}, nil
+}
+
+func TestSuggest_Success(t *testing.T) {
+	expected := []*domain.SearchSuggestion{
+		{Text: "Climate Change Explained", Kind: domain.SearchSuggestionContent, Similarity: 0.62},
+		{Text: "climatelab", Kind: domain.SearchSuggestionUser, Similarity: 0.41},
+	}
+	repo := &mockSearchRepository{
+		suggestFn: func(ctx context.Context, query string, limit int) ([]*domain.SearchSuggestion, error) {
+			assert.Equal(t, "climat chnage", query)
+			assert.Equal(t, 5, limit, "limit should default to 5")
+			return expected, nil
+		},
+	}
+
+	svc := services.NewSearchService(repo)
+	result, err := svc.Suggest(context.Background(), " climat chnage ", 0)
+
+	require.NoError(t, err)
+	assert.Equal(t, expected, result)
+}
+
+func TestSuggest_InvalidInput(t *testing.T) {
+	tests := []struct {
+		name  string
+		query string
+		limit int
+	}{
+		{"empty query", "", 5},
+		{"negative limit", "climate", -1},
+		{"limit too large", "climate", 21},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &mockSearchRepository{
+				suggestFn: func(ctx context.Context, query string, limit int) ([]*domain.SearchSuggestion, error) {
+					t.Fatal("repository should not be called")
+					return nil, nil
+				},
+			}
+
+			svc := services.NewSearchService(repo)
+			result, err := svc.Suggest(context.Background(), tt.query, tt.limit)
+
+			assert.Nil(t, result)
+			require.Error(t, err)
+			assert.True(t, errors.Is(err, domain.ErrInvalidInput))
+		})
+	}
+}
+
+func TestSuggest_RepositoryError(t *testing.T) {
+	repo := &mockSearchRepository{
+		suggestFn: func(ctx context.Context, query string, limit int) ([]*domain.SearchSuggestion, error) {
+			return nil, errors.New("connection refused")
+		},
+	}
+
+	svc := services.NewSearchService(repo)
+	_, err := svc.Suggest(context.Background(), "climate", 5)
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "failed to suggest search terms")
+}
@@ -0,0 +1,228 @@
+package services_test
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/CodeWarrior-debug/perspectize/backend/internal/core/domain"
+	portservices "github.com/CodeWarrior-debug/perspectize/backend/internal/core/ports/services"
+	"github.com/CodeWarrior-debug/perspectize/backend/internal/core/services"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// mockTranscriptRepository implements repositories.TranscriptRepository for testing
+type mockTranscriptRepository struct {
+	replaceFn      func(ctx context.Context, transcript *domain.Transcript) (*domain.Transcript, error)
+	listSegmentsFn func(ctx context.Context, params domain.TranscriptRangeParams) ([]*domain.TranscriptSegment, error)
+	searchFn       func(ctx context.Context, params domain.TranscriptSearchParams) ([]*domain.TranscriptMatch, error)
+}
+
+func (m *mockTranscriptRepository) Replace(ctx context.Context, transcript *domain.Transcript) (*domain.Transcript, error) {
+	if m.replaceFn != nil {
+		return m.replaceFn(ctx, transcript)
+	}
+	return transcript, nil
+}
+
+func (m *mockTranscriptRepository) ListSegments(ctx context.Context, params domain.TranscriptRangeParams) ([]*domain.TranscriptSegment, error) {
+	if m.listSegmentsFn != nil {
+		return m.listSegmentsFn(ctx, params)
+	}
+	return []*domain.TranscriptSegment{}, nil
+}
+
+func (m *mockTranscriptRepository) Search(ctx context.Context, params domain.TranscriptSearchParams) ([]*domain.TranscriptMatch, error) {
+	if m.searchFn != nil {
+		return m.searchFn(ctx, params)
+	}
+	return []*domain.TranscriptMatch{}, nil
+}
+
+// mockCaptionProvider implements services.CaptionProvider for testing
+type mockCaptionProvider struct {
+	fetchCaptionsFn func(ctx context.Context, videoID, language string) (*portservices.Captions, error)
+}
+
+func (m *mockCaptionProvider) FetchCaptions(ctx context.Context, videoID, language string) (*portservices.Captions, error) {
+	if m.fetchCaptionsFn != nil {
+		return m.fetchCaptionsFn(ctx, videoID, language)
+	}
+	return nil, domain.ErrNotFound
+}
+
+const sampleVTT = "WEBVTT\n\n00:00:01.000 --> 00:00:03.000\nfirst\n\n00:00:03.000 --> 00:00:05.000\nsecond\n"
+
+// youtubeContentRepo returns a content repository holding one YouTube video
+func youtubeContentRepo() *mockContentRepository {
+	url := "https://www.youtube.com/watch?v=dQw4w9WgXcQ"
+	return &mockContentRepository{
+		getByIDFn: func(ctx context.Context, id int) (*domain.Content, error) {
+			return &domain.Content{ID: id, Name: "Video", URL: &url, ContentType: domain.ContentTypeYouTube}, nil
+		},
+	}
+}
+
+func extractingYouTubeClient() *mockYouTubeClient {
+	return &mockYouTubeClient{
+		extractVideoIDFn: func(url string) (string, error) {
+			return "dQw4w9WgXcQ", nil
+		},
+	}
+}
+
+func TestTranscriptFetchFromYouTube_Success(t *testing.T) {
+	var saved *domain.Transcript
+	repo := &mockTranscriptRepository{
+		replaceFn: func(ctx context.Context, transcript *domain.Transcript) (*domain.Transcript, error) {
+			saved = transcript
+			return transcript, nil
+		},
+	}
+	captions := &mockCaptionProvider{
+		fetchCaptionsFn: func(ctx context.Context, videoID, language string) (*portservices.Captions, error) {
+			assert.Equal(t, "dQw4w9WgXcQ", videoID)
+			assert.Equal(t, "pt-BR", language)
+			return &portservices.Captions{Language: language, Format: domain.CaptionFormatWebVTT, Data: sampleVTT}, nil
+		},
+	}
+
+	svc := services.NewTranscriptService(repo, youtubeContentRepo(), extractingYouTubeClient(), captions)
+	transcript, err := svc.FetchFromYouTube(context.Background(), 7, "pt-BR")
+
+	require.NoError(t, err)
+	require.NotNil(t, saved)
+	assert.Equal(t, 7, transcript.ContentID)
+	assert.Equal(t, "pt-BR", transcript.Language)
+	assert.Equal(t, domain.TranscriptSourceYouTube, transcript.Source)
+	assert.Equal(t, 2, transcript.SegmentCount)
+	require.Len(t, saved.Segments, 2)
+	assert.Equal(t, 7, saved.Segments[1].ContentID)
+	assert.Equal(t, "second", saved.Segments[1].Text)
+}
+
+func TestTranscriptFetchFromYouTube_DefaultsToEnglish(t *testing.T) {
+	captions := &mockCaptionProvider{
+		fetchCaptionsFn: func(ctx context.Context, videoID, language string) (*portservices.Captions, error) {
+			assert.Equal(t, "en", language)
+			return &portservices.Captions{Format: domain.CaptionFormatWebVTT, Data: sampleVTT}, nil
+		},
+	}
+
+	svc := services.NewTranscriptService(&mockTranscriptRepository{}, youtubeContentRepo(), extractingYouTubeClient(), captions)
+	transcript, err := svc.FetchFromYouTube(context.Background(), 7, "")
+
+	require.NoError(t, err)
+	assert.Equal(t, "en", transcript.Language)
+}
+
+func TestTranscriptFetchFromYouTube_NoCaptions(t *testing.T) {
+	svc := services.NewTranscriptService(&mockTranscriptRepository{}, youtubeContentRepo(), extractingYouTubeClient(), &mockCaptionProvider{})
+	_, err := svc.FetchFromYouTube(context.Background(), 7, "en")
+
+	require.ErrorIs(t, err, domain.ErrNotFound)
+}
+
+func TestTranscriptFetchFromYouTube_UnparseableCaptions(t *testing.T) {
+	captions := &mockCaptionProvider{
+		fetchCaptionsFn: func(ctx context.Context, videoID, language string) (*portservices.Captions, error) {
+			return &portservices.Captions{Format: domain.CaptionFormatWebVTT, Data: "<html>consent page</html>"}, nil
+		},
+	}
+
+	svc := services.NewTranscriptService(&mockTranscriptRepository{}, youtubeContentRepo(), extractingYouTubeClient(), captions)
+	_, err := svc.FetchFromYouTube(context.Background(), 7, "en")
+
+	require.ErrorIs(t, err, domain.ErrYouTubeAPI)
+	assert.False(t, errors.Is(err, domain.ErrInvalidInput), "bad provider data is not the caller's fault")
+}
+
+func TestTranscriptUpload_Success(t *testing.T) {
+	svc := services.NewTranscriptService(&mockTranscriptRepository{}, youtubeContentRepo(), &mockYouTubeClient{}, &mockCaptionProvider{})
+	transcript, err := svc.Upload(context.Background(), portservices.UploadTranscriptInput{
+		ContentID: 3,
+		Language:  "de",
+		Format:    domain.CaptionFormatSRT,
+		Data:      "1\n00:00:00,000 --> 00:00:01,000\nHallo\n",
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, domain.TranscriptSourceUpload, transcript.Source)
+	assert.Equal(t, "de", transcript.Language)
+	assert.Equal(t, 1, transcript.SegmentCount)
+}
+
+func TestTranscriptUpload_InvalidInput(t *testing.T) {
+	valid := portservices.UploadTranscriptInput{ContentID: 3, Format: domain.CaptionFormatWebVTT, Data: sampleVTT}
+	tests := []struct {
+		name   string
+		modify func(in *portservices.UploadTranscriptInput)
+	}{
+		{name: "bad language", modify: func(in *portservices.UploadTranscriptInput) { in.Language = "english!" }},
+		{name: "unknown format", modify: func(in *portservices.UploadTranscriptInput) { in.Format = "SSA" }},
+		{name: "invalid content id", modify: func(in *portservices.UploadTranscriptInput) { in.ContentID = 0 }},
+		{name: "no cues", modify: func(in *portservices.UploadTranscriptInput) { in.Data = "WEBVTT\n" }},
+		{name: "too large", modify: func(in *portservices.UploadTranscriptInput) { in.Data = "WEBVTT\n" + strings.Repeat("x", 5<<20) }},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			input := valid
+			tt.modify(&input)
+			svc := services.NewTranscriptService(&mockTranscriptRepository{}, youtubeContentRepo(), &mockYouTubeClient{}, &mockCaptionProvider{})
+			_, err := svc.Upload(context.Background(), input)
+			require.ErrorIs(t, err, domain.ErrInvalidInput)
+		})
+	}
+}
+
+func TestTranscriptUpload_ContentNotFound(t *testing.T) {
+	svc := services.NewTranscriptService(&mockTranscriptRepository{}, &mockContentRepository{}, &mockYouTubeClient{}, &mockCaptionProvider{})
+	_, err := svc.Upload(context.Background(), portservices.UploadTranscriptInput{ContentID: 3, Format: domain.CaptionFormatWebVTT, Data: sampleVTT})
+
+	require.ErrorIs(t, err, domain.ErrNotFound)
+}
+
+func TestTranscriptSegments_Validation(t *testing.T) {
+	ms := func(v int) *int { return &v }
+	tests := []struct {
+		name   string
+		params domain.TranscriptRangeParams
+	}{
+		{name: "invalid content id", params: domain.TranscriptRangeParams{ContentID: 0}},
+		{name: "negative from", params: domain.TranscriptRangeParams{ContentID: 1, FromMs: ms(-1)}},
+		{name: "empty window", params: domain.TranscriptRangeParams{ContentID: 1, FromMs: ms(5000), ToMs: ms(5000)}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := services.NewTranscriptService(&mockTranscriptRepository{}, &mockContentRepository{}, &mockYouTubeClient{}, &mockCaptionProvider{})
+			_, err := svc.Segments(context.Background(), tt.params)
+			require.ErrorIs(t, err, domain.ErrInvalidInput)
+		})
+	}
+}
+
+func TestTranscriptSearch_DefaultsAndLimits(t *testing.T) {
+	var got domain.TranscriptSearchParams
+	repo := &mockTranscriptRepository{
+		searchFn: func(ctx context.Context, params domain.TranscriptSearchParams) ([]*domain.TranscriptMatch, error) {
+			got = params
+			return []*domain.TranscriptMatch{}, nil
+		},
+	}
+	svc := services.NewTranscriptService(repo, &mockContentRepository{}, &mockYouTubeClient{}, &mockCaptionProvider{})
+
+	_, err := svc.Search(context.Background(), domain.TranscriptSearchParams{Query: "  misinformation  "})
+	require.NoError(t, err)
+	assert.Equal(t, "misinformation", got.Query)
+	assert.Equal(t, 20, got.Limit)
+
+	_, err = svc.Search(context.Background(), domain.TranscriptSearchParams{Query: " "})
+	require.ErrorIs(t, err, domain.ErrInvalidInput)
+
+	_, err = svc.Search(context.Background(), domain.TranscriptSearchParams{Query: "x", Limit: 101})
+	require.ErrorIs(t, err, domain.ErrInvalidInput)
+}
@@ -4,7 +4,9 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/CodeWarrior-debug/perspectize/backend/internal/core/domain"
 	portservices "github.com/CodeWarrior-debug/perspectize/backend/internal/core/ports/services"
@@ -20,6 +22,7 @@ type mockUserRepository struct {
 	getByUsernameFn func(ctx context.Context, username string) (*domain.User, error)
 	getByEmailFn    func(ctx context.Context, email string) (*domain.User, error)
 	listAllFn       func(ctx context.Context) ([]*domain.User, error)
+	searchFn        func(ctx context.Context, query string, limit int) ([]*domain.User, error)
 	updateFn        func(ctx context.Context, user *domain.User) (*domain.User, error)
 	deleteFn        func(ctx context.Context, id int) error
 }
@@ -60,6 +63,13 @@ func (m *mockUserRepository) ListAll(ctx context.Context) ([]*domain.User, error
 	return []*domain.User{}, nil
 }
 
+func (m *mockUserRepository) Search(ctx context.Context, query string, limit int) ([]*domain.User, error) {
+	if m.searchFn != nil {
+		return m.searchFn(ctx, query, limit)
+	}
+	return []*domain.User{}, nil
+}
+
 func (m *mockUserRepository) Update(ctx context.Context, user *domain.User) (*domain.User, error) {
 	if m.updateFn != nil {
 		return m.updateFn(ctx, user)
@@ -88,9 +98,45 @@ func (m *mockContentRepoForUser) GetByID(ctx context.Context, id int) (*domain.C
 func (m *mockContentRepoForUser) GetByURL(ctx context.Context, url string) (*domain.Content, error) {
 	return nil, domain.ErrNotFound
 }
+func (m *mockContentRepoForUser) GetByCanonicalKey(ctx context.Context, key string) (*domain.Content, error) {
+	return nil, domain.ErrNotFound
+}
+func (m *mockContentRepoForUser) AddAlias(ctx context.Context, contentID int, url string) error {
+	return nil
+}
 func (m *mockContentRepoForUser) List(ctx context.Context, params domain.ContentListParams) (*domain.PaginatedContent, error) {
 	return &domain.PaginatedContent{Items: []*domain.Content{}}, nil
 }
+func (m *mockContentRepoForUser) ListPage(ctx context.Context, params domain.ContentPageParams) (*domain.ContentPage, error) {
+	return &domain.ContentPage{Items: []*domain.Content{}}, nil
+}
+func (m *mockContentRepoForUser) Facets(ctx context.Context, params domain.ContentFacetParams) ([]*domain.ContentFacetCounts, error) {
+	return []*domain.ContentFacetCounts{}, nil
+}
+func (m *mockContentRepoForUser) Update(ctx context.Context, content *domain.Content) (*domain.Content, error) {
+	return content, nil
+}
+func (m *mockContentRepoForUser) Delete(ctx context.Context, id int, policy domain.ContentDeletePolicy) error {
+	return nil
+}
+func (m *mockContentRepoForUser) Merge(ctx context.Context, sourceID, targetID int) (*domain.ContentMergeResult, error) {
+	return nil, domain.ErrNotFound
+}
+func (m *mockContentRepoForUser) ListRefreshDue(ctx context.Context, before time.Time, limit int) ([]*domain.Content, error) {
+	return []*domain.Content{}, nil
+}
+func (m *mockContentRepoForUser) MarkRefreshFailed(ctx context.Context, ids []int, at time.Time) error {
+	return nil
+}
+func (m *mockContentRepoForUser) MarkUnavailable(ctx context.Context, ids []int, at time.Time) error {
+	return nil
+}
+func (m *mockContentRepoForUser) RecordStatsSnapshot(ctx context.Context, snapshot *domain.ContentStatsSnapshot) error {
+	return nil
+}
+func (m *mockContentRepoForUser) ListStatsHistory(ctx context.Context, params domain.ContentStatsHistoryParams) ([]*domain.ContentStatsBucket, error) {
+	return []*domain.ContentStatsBucket{}, nil
+}
 func (m *mockContentRepoForUser) ReassignByUser(ctx context.Context, fromUserID, toUserID int) error {
 	if m.reassignByUserFn != nil {
 		return m.reassignByUserFn(ctx, fromUserID, toUserID)
@@ -434,6 +480,69 @@ func TestGetByUsername_Whitespace(t *testing.T) {
 	assert.Contains(t, err.Error(), "username is required")
 }
 
+// --- Search Tests ---
+
+func TestUserSearch_Success(t *testing.T) {
+	expected := []*domain.User{{ID: 1, Username: "alice"}}
+	repo := &mockUserRepository{
+		searchFn: func(ctx context.Context, query string, limit int) ([]*domain.User, error) {
+			assert.Equal(t, "alcie", query)
+			assert.Equal(t, 10, limit, "limit should default to 10")
+			return expected, nil
+		},
+	}
+
+	svc := newTestUserService(repo)
+	result, err := svc.Search(context.Background(), "  alcie ", 0)
+
+	require.NoError(t, err)
+	assert.Equal(t, expected, result)
+}
+
+func TestUserSearch_InvalidInput(t *testing.T) {
+	tests := []struct {
+		name  string
+		query string
+		limit int
+	}{
+		{"empty query", "   ", 10},
+		{"query too long", strings.Repeat("a", domain.MaxFuzzyQueryLength+1), 10},
+		{"negative limit", "alice", -1},
+		{"limit too large", "alice", 101},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &mockUserRepository{
+				searchFn: func(ctx context.Context, query string, limit int) ([]*domain.User, error) {
+					t.Fatal("repository should not be called")
+					return nil, nil
+				},
+			}
+
+			svc := newTestUserService(repo)
+			result, err := svc.Search(context.Background(), tt.query, tt.limit)
+
+			assert.Nil(t, result)
+			require.Error(t, err)
+			assert.True(t, errors.Is(err, domain.ErrInvalidInput))
+		})
+	}
+}
+
+func TestUserSearch_RepositoryError(t *testing.T) {
+	repo := &mockUserRepository{
+		searchFn: func(ctx context.Context, query string, limit int) ([]*domain.User, error) {
+			return nil, errors.New("connection refused")
+		},
+	}
+
+	svc := newTestUserService(repo)
+	_, err := svc.Search(context.Background(), "alice", 5)
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "failed to search users")
+}
+
 // --- NewUserService Tests ---
 
 func TestNewUserService(t *testing.T) {
@@ -0,0 +1,383 @@
+package youtube_test
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/CodeWarrior-debug/perspectize/backend/internal/adapters/youtube"
+	"github.com/CodeWarrior-debug/perspectize/backend/internal/core/domain"
+	"github.com/CodeWarrior-debug/perspectize/backend/internal/core/ports/services"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// cachedRequest is what the cache server saw of one request
+type cachedRequest struct {
+	ids         string
+	parts       string
+	ifNoneMatch string
+}
+
+// cacheServer serves videos.list from in-memory view counts, honoring the
+// part parameter and If-None-Match like the real API
+type cacheServer struct {
+	*httptest.Server
+
+	mu       sync.Mutex
+	views    map[string]string // Video ID -> viewCount; absent videos are not returned
+	requests []cachedRequest
+}
+
+func newCacheServer(t *testing.T, views map[string]string) *cacheServer {
+	t.Helper()
+	s := &cacheServer{views: views}
+	s.Server = httptest.NewServer(http.HandlerFunc(s.handle))
+	t.Cleanup(s.Close)
+	return s
+}
+
+func (s *cacheServer) handle(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	query := r.URL.Query()
+	s.requests = append(s.requests, cachedRequest{
+		ids:         query.Get("id"),
+		parts:       query.Get("part"),
+		ifNoneMatch: r.Header.Get("If-None-Match"),
+	})
+
+	parts := strings.Split(query.Get("part"), ",")
+	var items []map[string]any
+	for _, id := range strings.Split(query.Get("id"), ",") {
+		views, ok := s.views[id]
+		if !ok {
+			continue
+		}
+		item := map[string]any{"kind": "youtube#video", "id": id}
+		for _, part := range parts {
+			switch part {
+			case youtube.PartSnippet:
+				item[part] = map[string]any{"title": "Video " + id, "channelTitle": "Channel", "description": "About " + id}
+			case youtube.PartStatistics:
+				item[part] = map[string]any{"viewCount": views}
+			case youtube.PartContentDetails:
+				item[part] = map[string]any{"duration": "PT1M"}
+			}
+		}
+		// Like the real API, an item's etag changes with its parts' content
+		itemBody, _ := json.Marshal(item)
+		item["etag"] = fmt.Sprintf("%x", sha256.Sum256(itemBody))
+		items = append(items, item)
+	}
+
+	body, _ := json.Marshal(map[string]any{"items": items})
+	etag := fmt.Sprintf(`"%x"`, sha256.Sum256(body))
+	if r.Header.Get("If-None-Match") == etag {
+		w.WriteHeader(http.StatusNotModified)
+		return
+	}
+	w.Header().Set("ETag", etag)
+	w.Header().Set("Content-Type", "application/json")
+	w.Write(body)
+}
+
+func (s *cacheServer) setViews(id, views string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.views[id] = views
+}
+
+func (s *cacheServer) seen() []cachedRequest {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]cachedRequest(nil), s.requests...)
+}
+
+// fakeCacheStore implements repositories.YouTubeCacheRepository in memory
+type fakeCacheStore struct {
+	mu      sync.Mutex
+	entries map[string]*domain.YouTubeVideoCacheEntry
+}
+
+func newFakeCacheStore() *fakeCacheStore {
+	return &fakeCacheStore{entries: make(map[string]*domain.YouTubeVideoCacheEntry)}
+}
+
+func (s *fakeCacheStore) GetVideos(ctx context.Context, videoIDs []string) (map[string]*domain.YouTubeVideoCacheEntry, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	out := make(map[string]*domain.YouTubeVideoCacheEntry)
+	for _, id := range videoIDs {
+		if entry, ok := s.entries[id]; ok {
+			out[id] = entry
+		}
+	}
+	return out, nil
+}
+
+func (s *fakeCacheStore) PutVideo(ctx context.Context, entry *domain.YouTubeVideoCacheEntry) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.entries[entry.VideoID] = entry
+	return nil
+}
+
+func (s *fakeCacheStore) DeleteVideo(ctx context.Context, videoID string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.entries, videoID)
+	return nil
+}
+
+func newCachingClient(server *cacheServer, clock *fakeClock, opts ...youtube.CacheOption) *youtube.CachingClient {
+	client := youtube.NewClient("test-key", youtube.WithBaseURL(server.URL), noRetry)
+	opts = append(opts, youtube.WithCacheClock(clock.Now))
+	return youtube.NewCachingClient(client, youtube.CacheConfig{}, opts...)
+}
+
+func TestCachingClient_Interface(t *testing.T) {
+	var _ services.YouTubeClient = youtube.NewCachingClient(youtube.NewClient("test-key"), youtube.CacheConfig{})
+}
+
+func TestCachingClient_ServesFreshVideosFromCache(t *testing.T) {
+	server := newCacheServer(t, map[string]string{"vid1": "10"})
+	client := newCachingClient(server, &fakeClock{now: quotaTestStart})
+
+	first, err := client.GetVideoMetadata(context.Background(), "vid1")
+	require.NoError(t, err)
+	second, err := client.GetVideoMetadata(context.Background(), "vid1")
+	require.NoError(t, err)
+
+	assert.Equal(t, first, second)
+	assert.Equal(t, "Video vid1", second.Title)
+	assert.Equal(t, 60, second.Duration)
+	require.NotNil(t, second.ViewCount)
+	assert.Equal(t, int64(10), *second.ViewCount)
+	assert.False(t, second.Unchanged)
+	assert.Equal(t, []cachedRequest{{ids: "vid1", parts: "snippet,statistics,contentDetails"}}, server.seen())
+}
+
+func TestCachingClient_BypassCacheFetchesFreshVideos(t *testing.T) {
+	server := newCacheServer(t, map[string]string{"vid1": "10"})
+	client := newCachingClient(server, &fakeClock{now: quotaTestStart})
+
+	_, err := client.GetVideoMetadata(context.Background(), "vid1")
+	require.NoError(t, err)
+
+	server.setViews("vid1", "11")
+	bypassed, err := client.GetVideoMetadata(services.BypassCache(context.Background()), "vid1")
+	require.NoError(t, err)
+	assert.Equal(t, int64(11), *bypassed.ViewCount)
+
+	requests := server.seen()
+	require.Len(t, requests, 2)
+	assert.Equal(t, "snippet,statistics,contentDetails", requests[1].parts)
+	assert.NotEmpty(t, requests[1].ifNoneMatch, "the cached copy is still revalidated")
+
+	// The fetched copy replaces the cached one
+	cached, err := client.GetVideoMetadata(context.Background(), "vid1")
+	require.NoError(t, err)
+	assert.Equal(t, int64(11), *cached.ViewCount)
+	assert.Len(t, server.seen(), 2)
+}
+
+func TestCachingClient_RefetchesOnlyStaleParts(t *testing.T) {
+	server := newCacheServer(t, map[string]string{"vid1": "10"})
+	clock := &fakeClock{now: quotaTestStart}
+	client := newCachingClient(server, clock)
+
+	_, err := client.GetVideoMetadata(context.Background(), "vid1")
+	require.NoError(t, err)
+
+	// Statistics expire after an hour; snippet and contentDetails are still fresh
+	server.setViews("vid1", "25")
+	clock.Advance(2 * time.Hour)
+	metadata, err := client.GetVideoMetadata(context.Background(), "vid1")
+	require.NoError(t, err)
+
+	requests := server.seen()
+	require.Len(t, requests, 2)
+	assert.Equal(t, "statistics", requests[1].parts)
+	assert.Empty(t, requests[1].ifNoneMatch, "the ETag covered different parts")
+
+	assert.Equal(t, "Video vid1", metadata.Title, "cached snippet is kept")
+	assert.Equal(t, "About vid1", metadata.Description)
+	assert.Equal(t, 60, metadata.Duration)
+	require.NotNil(t, metadata.ViewCount)
+	assert.Equal(t, int64(25), *metadata.ViewCount)
+	assert.False(t, metadata.Unchanged)
+}
+
+func TestCachingClient_NotModifiedKeepsCachedCopy(t *testing.T) {
+	server := newCacheServer(t, map[string]string{"vid1": "10"})
+	clock := &fakeClock{now: quotaTestStart}
+	client := newCachingClient(server, clock, youtube.WithCacheStore(newFakeCacheStore()))
+
+	first, err := client.GetVideoMetadata(context.Background(), "vid1")
+	require.NoError(t, err)
+
+	// Everything has expired, so the same parts are requested conditionally
+	clock.Advance(8 * 24 * time.Hour)
+	second, err := client.GetVideoMetadata(context.Background(), "vid1")
+	require.NoError(t, err)
+
+	requests := server.seen()
+	require.Len(t, requests, 2)
+	assert.NotEmpty(t, requests[1].ifNoneMatch)
+	assert.True(t, second.Unchanged)
+	assert.Equal(t, first.Title, second.Title)
+	assert.JSONEq(t, string(first.Response), string(second.Response))
+
+	// The 304 revalidated every part
+	_, err = client.GetVideoMetadata(context.Background(), "vid1")
+	require.NoError(t, err)
+	assert.Len(t, server.seen(), 2)
+
+	// A changed video comes back in full
+	server.setViews("vid1", "11")
+	clock.Advance(8 * 24 * time.Hour)
+	third, err := client.GetVideoMetadata(context.Background(), "vid1")
+	require.NoError(t, err)
+	assert.False(t, third.Unchanged)
+	assert.Equal(t, int64(11), *third.ViewCount)
+}
+
+func TestCachingClient_BatchesMissesAndEvictsMissingVideos(t *testing.T) {
+	server := newCacheServer(t, map[string]string{"vid1": "1", "vid2": "2", "vid3": "3"})
+	store := newFakeCacheStore()
+	client := newCachingClient(server, &fakeClock{now: quotaTestStart}, youtube.WithCacheStore(store))
+
+	_, err := client.GetVideoMetadata(context.Background(), "vid1")
+	require.NoError(t, err)
+
+	videos, err := client.GetVideosMetadata(context.Background(), []string{"vid1", "vid2", "vid3", "gone"})
+	require.NoError(t, err)
+
+	assert.Len(t, videos, 3)
+	assert.NotContains(t, videos, "gone")
+	requests := server.seen()
+	require.Len(t, requests, 2)
+	assert.Equal(t, "vid2,vid3,gone", requests[1].ids, "cached vid1 is not requested again")
+	assert.Empty(t, requests[1].ifNoneMatch)
+	assert.Len(t, store.entries, 3)
+
+	_, err = client.GetVideoMetadata(context.Background(), "gone")
+	require.ErrorIs(t, err, domain.ErrNotFound)
+}
+
+func TestCachingClient_BatchedRefreshReportsUnchangedVideos(t *testing.T) {
+	server := newCacheServer(t, map[string]string{"vid1": "1", "vid2": "2", "vid3": "3"})
+	clock := &fakeClock{now: quotaTestStart}
+	store := newFakeCacheStore()
+	client := newCachingClient(server, clock, youtube.WithCacheStore(store))
+
+	ids := []string{"vid1", "vid2", "vid3"}
+	_, err := client.GetVideosMetadata(context.Background(), ids)
+	require.NoError(t, err)
+
+	// The cached etags covered every part, so a statistics refresh cannot match them
+	clock.Advance(2 * time.Hour)
+	videos, err := client.GetVideosMetadata(context.Background(), ids)
+	require.NoError(t, err)
+	for _, id := range ids {
+		assert.False(t, videos[id].Unchanged, id)
+	}
+
+	// Statistics go stale again for all three; only vid2's have moved
+	server.setViews("vid2", "20")
+	clock.Advance(2 * time.Hour)
+	videos, err = client.GetVideosMetadata(context.Background(), ids)
+	require.NoError(t, err)
+
+	requests := server.seen()
+	require.Len(t, requests, 3, "stale videos are refreshed in one request")
+	assert.Equal(t, cachedRequest{ids: "vid1,vid2,vid3", parts: "statistics"}, requests[2])
+	assert.True(t, videos["vid1"].Unchanged)
+	assert.False(t, videos["vid2"].Unchanged)
+	assert.Equal(t, int64(20), *videos["vid2"].ViewCount)
+	assert.True(t, videos["vid3"].Unchanged)
+
+	// The next batch compares against the etags just stored, also after a restart
+	server.setViews("vid1", "10")
+	clock.Advance(2 * time.Hour)
+	restarted := newCachingClient(server, clock, youtube.WithCacheStore(store))
+	videos, err = restarted.GetVideosMetadata(context.Background(), ids)
+	require.NoError(t, err)
+	assert.False(t, videos["vid1"].Unchanged)
+	assert.True(t, videos["vid2"].Unchanged)
+	assert.True(t, videos["vid3"].Unchanged)
+}
+
+func TestCachingClient_PersistentStoreSurvivesRestart(t *testing.T) {
+	server := newCacheServer(t, map[string]string{"vid1": "10"})
+	clock := &fakeClock{now: quotaTestStart}
+	store := newFakeCacheStore()
+
+	_, err := newCachingClient(server, clock, youtube.WithCacheStore(store)).GetVideoMetadata(context.Background(), "vid1")
+	require.NoError(t, err)
+
+	restarted := newCachingClient(server, clock, youtube.WithCacheStore(store))
+	metadata, err := restarted.GetVideoMetadata(context.Background(), "vid1")
+
+	require.NoError(t, err)
+	assert.Equal(t, "Video vid1", metadata.Title)
+	assert.Len(t, server.seen(), 1)
+}
+
+func TestCachingClient_CustomTTLs(t *testing.T) {
+	server := newCacheServer(t, map[string]string{"vid1": "10"})
+	clock := &fakeClock{now: quotaTestStart}
+	client := youtube.NewCachingClient(
+		youtube.NewClient("test-key", youtube.WithBaseURL(server.URL), noRetry),
+		youtube.CacheConfig{TTLs: map[string]time.Duration{youtube.PartStatistics: 24 * time.Hour}},
+		youtube.WithCacheClock(clock.Now),
+	)
+
+	_, err := client.GetVideoMetadata(context.Background(), "vid1")
+	require.NoError(t, err)
+	clock.Advance(2 * time.Hour)
+	_, err = client.GetVideoMetadata(context.Background(), "vid1")
+	require.NoError(t, err)
+
+	assert.Len(t, server.seen(), 1)
+}
+
+func TestCachingClient_ErrorsAreNotCached(t *testing.T) {
+	var calls int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer server.Close()
+
+	client := youtube.NewCachingClient(youtube.NewClient("test-key", youtube.WithBaseURL(server.URL), noRetry), youtube.CacheConfig{})
+	for range 2 {
+		_, err := client.GetVideoMetadata(context.Background(), "vid1")
+		require.ErrorIs(t, err, domain.ErrYouTubeAPI)
+	}
+	assert.Equal(t, 2, calls)
+}
+
+func TestCachingClient_EvictsLeastRecentlyUsed(t *testing.T) {
+	server := newCacheServer(t, map[string]string{"vid1": "1", "vid2": "2"})
+	client := youtube.NewCachingClient(
+		youtube.NewClient("test-key", youtube.WithBaseURL(server.URL), noRetry),
+		youtube.CacheConfig{Capacity: 1},
+	)
+
+	for _, id := range []string{"vid1", "vid2", "vid2", "vid1"} {
+		_, err := client.GetVideoMetadata(context.Background(), id)
+		require.NoError(t, err)
+	}
+
+	assert.Len(t, server.seen(), 3, "vid1 was evicted when vid2 was cached")
+}
@@ -0,0 +1,78 @@
+package youtube_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/CodeWarrior-debug/perspectize/backend/internal/adapters/youtube"
+	"github.com/CodeWarrior-debug/perspectize/backend/internal/core/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFetchCaptions_Success(t *testing.T) {
+	var query url.Values
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		query = r.URL.Query()
+		w.Header().Set("Content-Type", "text/vtt")
+		w.Write([]byte("WEBVTT\n\n00:00.000 --> 00:02.000\nHello\n"))
+	}))
+	defer server.Close()
+
+	client := youtube.NewClient("test-key", youtube.WithTimedTextURL(server.URL))
+	captions, err := client.FetchCaptions(context.Background(), "dQw4w9WgXcQ", "de")
+
+	require.NoError(t, err)
+	assert.Equal(t, "dQw4w9WgXcQ", query.Get("v"))
+	assert.Equal(t, "de", query.Get("lang"))
+	assert.Equal(t, "vtt", query.Get("fmt"))
+	assert.Empty(t, query.Get("key"), "the timedtext endpoint takes no API key")
+
+	assert.Equal(t, "de", captions.Language)
+	assert.Equal(t, domain.CaptionFormatWebVTT, captions.Format)
+	assert.Contains(t, captions.Data, "Hello")
+}
+
+func TestFetchCaptions_Errors(t *testing.T) {
+	tests := []struct {
+		name   string
+		status int
+		body   string
+		want   error
+	}{
+		{name: "no track", status: http.StatusOK, body: "", want: domain.ErrNotFound},
+		{name: "404", status: http.StatusNotFound, body: "", want: domain.ErrNotFound},
+		{name: "server error", status: http.StatusInternalServerError, body: `{}`, want: domain.ErrYouTubeAPI},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(tt.status)
+				w.Write([]byte(tt.body))
+			}))
+			defer server.Close()
+
+			client := youtube.NewClient("test-key", youtube.WithTimedTextURL(server.URL))
+			_, err := client.FetchCaptions(context.Background(), "dQw4w9WgXcQ", "en")
+
+			require.ErrorIs(t, err, tt.want)
+		})
+	}
+}
+
+func TestFetchCaptions_ResponseSizeCap(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte("WEBVTT\n\n00:00.000 --> 00:02.000\nA caption longer than the cap\n"))
+	}))
+	defer server.Close()
+
+	client := youtube.NewClient("test-key", youtube.WithTimedTextURL(server.URL), youtube.WithMaxResponseBytes(16))
+	_, err := client.FetchCaptions(context.Background(), "dQw4w9WgXcQ", "en")
+
+	require.ErrorIs(t, err, domain.ErrYouTubeAPI)
+	assert.Contains(t, err.Error(), "larger than 16 bytes")
+}
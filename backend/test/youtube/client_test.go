@@ -2,9 +2,16 @@ package youtube_test
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	"net/http"
 	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync/atomic"
 	"testing"
+	"time"
 
 	"github.com/CodeWarrior-debug/perspectize/backend/internal/adapters/youtube"
 	"github.com/CodeWarrior-debug/perspectize/backend/internal/core/domain"
@@ -23,7 +30,16 @@ func TestNewClient(t *testing.T) {
 // --- GetVideoMetadata Tests ---
 
 func TestGetVideoMetadata_Success(t *testing.T) {
-	t.Skip("Requires refactoring Client to accept baseURL for testability")
+	server := createMockServer(validVideoResponse(), http.StatusOK)
+	defer server.Close()
+
+	client := youtube.NewClient("test-key", youtube.WithBaseURL(server.URL))
+	result, err := client.GetVideoMetadata(context.Background(), "dQw4w9WgXcQ")
+
+	require.NoError(t, err)
+	assert.Equal(t, "dQw4w9WgXcQ", result.VideoID)
+	assert.Equal(t, "Test Video", result.Title)
+	assert.Equal(t, 330, result.Duration)
 }
 
 func TestGetVideoMetadata_Success_WithMockServer(t *testing.T) {
@@ -41,38 +57,53 @@ func TestGetVideoMetadata_Success_WithMockServer(t *testing.T) {
 				},
 				"statistics": {
 					"viewCount": "5000000",
-					"likeCount": "100000",
-					"commentCount": "2000"
+					"likeCount": "100000"
 				}
 			}
 		]
 	}`
 
+	var gotQuery url.Values
 	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotQuery = r.URL.Query()
 		w.Header().Set("Content-Type", "application/json")
 		w.WriteHeader(http.StatusOK)
 		w.Write([]byte(mockResponse))
 	}))
 	defer server.Close()
 
-	// Note: This test requires the ability to inject a custom HTTP client or baseURL
-	// For now, we'll document the expected behavior
-	t.Skip("Requires Client refactoring to support custom baseURL for testing")
+	client := youtube.NewClient("test-key", youtube.WithBaseURL(server.URL))
+	result, err := client.GetVideoMetadata(context.Background(), "dQw4w9WgXcQ")
+
+	require.NoError(t, err)
+	assert.Equal(t, "dQw4w9WgXcQ", gotQuery.Get("id"))
+	assert.Equal(t, "Amazing Video", result.Title)
+	assert.Equal(t, "Description here", result.Description)
+	assert.Equal(t, "Cool Channel", result.ChannelName)
+	assert.Equal(t, 600, result.Duration)
+	require.NotNil(t, result.ViewCount)
+	assert.Equal(t, int64(5000000), *result.ViewCount)
+	require.NotNil(t, result.LikeCount)
+	assert.Equal(t, int64(100000), *result.LikeCount)
+	assert.Nil(t, result.CommentCount, "hidden comment count should be nil")
+
+	var stored youtube.YouTubeAPIResponse
+	require.NoError(t, json.Unmarshal(result.Response, &stored))
+	require.Len(t, stored.Items, 1)
+	assert.Equal(t, "dQw4w9WgXcQ", stored.Items[0].ID)
 }
 
 func TestGetVideoMetadata_VideoNotFound(t *testing.T) {
-	mockResponse := `{
-		"items": []
-	}`
-
-	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		w.Header().Set("Content-Type", "application/json")
-		w.WriteHeader(http.StatusOK)
-		w.Write([]byte(mockResponse))
-	}))
+	server := createMockServer(`{"items": []}`, http.StatusOK)
 	defer server.Close()
 
-	t.Skip("Requires Client refactoring to support custom baseURL for testing")
+	client := youtube.NewClient("test-key", youtube.WithBaseURL(server.URL))
+	result, err := client.GetVideoMetadata(context.Background(), "missing")
+
+	assert.Nil(t, result)
+	require.Error(t, err)
+	assert.True(t, errors.Is(err, domain.ErrNotFound))
+	assert.Contains(t, err.Error(), "video not found")
 }
 
 func TestGetVideoMetadata_APIError(t *testing.T) {
@@ -83,41 +114,41 @@ func TestGetVideoMetadata_APIError(t *testing.T) {
 		}
 	}`
 
-	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		w.Header().Set("Content-Type", "application/json")
-		w.WriteHeader(http.StatusForbidden)
-		w.Write([]byte(mockResponse))
-	}))
+	server := createMockServer(mockResponse, http.StatusForbidden)
 	defer server.Close()
 
-	t.Skip("Requires Client refactoring to support custom baseURL for testing")
+	client := youtube.NewClient("test-key", youtube.WithBaseURL(server.URL))
+	result, err := client.GetVideoMetadata(context.Background(), "dQw4w9WgXcQ")
+
+	assert.Nil(t, result)
+	require.Error(t, err)
+	assert.True(t, errors.Is(err, domain.ErrYouTubeAPI))
+	assert.Contains(t, err.Error(), "status 403")
 }
 
 func TestGetVideoMetadata_InvalidJSON(t *testing.T) {
-	mockResponse := `{invalid json}`
-
-	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		w.Header().Set("Content-Type", "application/json")
-		w.WriteHeader(http.StatusOK)
-		w.Write([]byte(mockResponse))
-	}))
+	server := createMockServer(`{invalid json}`, http.StatusOK)
 	defer server.Close()
 
-	t.Skip("Requires Client refactoring to support custom baseURL for testing")
+	client := youtube.NewClient("test-key", youtube.WithBaseURL(server.URL))
+	result, err := client.GetVideoMetadata(context.Background(), "dQw4w9WgXcQ")
+
+	assert.Nil(t, result)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "failed to parse")
 }
 
 func TestGetVideoMetadata_NetworkError(t *testing.T) {
-	// Create a client with an invalid baseURL to simulate network error
-	client := youtube.NewClient("test-key")
+	// A closed server gives a base URL that refuses connections
+	server := httptest.NewServer(http.NotFoundHandler())
+	server.Close()
 
-	ctx := context.Background()
-	result, err := client.GetVideoMetadata(ctx, "dQw4w9WgXcQ")
+	client := youtube.NewClient("test-key", youtube.WithBaseURL(server.URL), noRetry)
+	result, err := client.GetVideoMetadata(context.Background(), "dQw4w9WgXcQ")
 
 	assert.Nil(t, result)
 	require.Error(t, err)
-	// Will fail to connect to googleapis.com without internet or with invalid URL
-	// This is an integration test that requires actual network
-	t.Skip("Network test - requires actual YouTube API access")
+	assert.Contains(t, err.Error(), "failed to fetch video metadata")
 }
 
 func TestGetVideoMetadata_ContextCancellation(t *testing.T) {
@@ -127,7 +158,15 @@ func TestGetVideoMetadata_ContextCancellation(t *testing.T) {
 	}))
 	defer server.Close()
 
-	t.Skip("Requires Client refactoring to support custom baseURL for testing")
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	client := youtube.NewClient("test-key", youtube.WithBaseURL(server.URL))
+	result, err := client.GetVideoMetadata(ctx, "dQw4w9WgXcQ")
+
+	assert.Nil(t, result)
+	require.Error(t, err)
+	assert.True(t, errors.Is(err, context.DeadlineExceeded))
 }
 
 func TestGetVideoMetadata_InvalidDuration(t *testing.T) {
@@ -152,14 +191,15 @@ func TestGetVideoMetadata_InvalidDuration(t *testing.T) {
 		]
 	}`
 
-	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		w.Header().Set("Content-Type", "application/json")
-		w.WriteHeader(http.StatusOK)
-		w.Write([]byte(mockResponse))
-	}))
+	server := createMockServer(mockResponse, http.StatusOK)
 	defer server.Close()
 
-	t.Skip("Requires Client refactoring to support custom baseURL for testing")
+	client := youtube.NewClient("test-key", youtube.WithBaseURL(server.URL))
+	result, err := client.GetVideoMetadata(context.Background(), "dQw4w9WgXcQ")
+
+	require.NoError(t, err, "an unparseable duration should not fail the fetch")
+	assert.Equal(t, 0, result.Duration)
+	assert.Equal(t, "Test Video", result.Title)
 }
 
 // --- Integration-style tests that can work with the current implementation ---
@@ -229,7 +269,14 @@ func TestGetVideoMetadata_ErrorTypes(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			t.Skip("Requires Client refactoring to support custom baseURL for testing")
+			server := createMockServer(tt.responseBody, tt.statusCode)
+			defer server.Close()
+
+			client := youtube.NewClient("test-key", youtube.WithBaseURL(server.URL), noRetry)
+			_, err := client.GetVideoMetadata(context.Background(), "dQw4w9WgXcQ")
+
+			require.Error(t, err)
+			assert.True(t, errors.Is(err, tt.expectedError))
 		})
 	}
 }
@@ -288,19 +335,28 @@ func TestGetVideoMetadata_TableDriven(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			t.Skip("Requires Client refactoring to support dependency injection")
-			// When implemented, the test would look like:
-			// server := createMockServer(tt.mockResponse, tt.mockStatusCode)
-			// defer server.Close()
-			// client := youtube.NewClientWithBaseURL("test-key", server.URL)
-			// result, err := client.GetVideoMetadata(context.Background(), tt.videoID)
-			// ... assertions ...
+			server := createMockServer(tt.mockResponse, tt.mockStatusCode)
+			defer server.Close()
+
+			client := youtube.NewClient("test-key", youtube.WithBaseURL(server.URL))
+			result, err := client.GetVideoMetadata(context.Background(), tt.videoID)
+
+			if tt.wantError {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.errorContains)
+				return
+			}
+			require.NoError(t, err)
+			tt.validate(t, result)
 		})
 	}
 }
 
 // --- Helper functions ---
 
+// noRetry makes failures surface on the first attempt
+var noRetry = youtube.WithRetry(youtube.RetryPolicy{MaxAttempts: 1})
+
 func validVideoResponse() string {
 	return `{
 		"items": [
@@ -335,47 +391,8 @@ func createMockServer(response string, statusCode int) *httptest.Server {
 // --- Documentation Test ---
 
 func TestYouTubeClient_Interface(t *testing.T) {
-	// Verify that youtube.Client implements the YouTubeClient interface
-	// This is a compile-time check
-	client := youtube.NewClient("test-key")
-
-	// Verify it has the expected method
-	ctx := context.Background()
-	_, err := client.GetVideoMetadata(ctx, "test")
-
-	// We expect an error since we're not making a real API call
-	// but this verifies the method signature exists
-	assert.Error(t, err)
-}
-
-// --- Recommendation for future improvement ---
-
-// TODO: Refactor youtube.Client to support dependency injection for testing
-// Suggested changes:
-// 1. Add NewClientWithHTTPClient(apiKey string, httpClient *http.Client) constructor
-// 2. Or add NewClientWithBaseURL(apiKey string, baseURL string) for testing
-// 3. This would allow us to inject httptest.Server for comprehensive unit tests
-//
-// Example usage after refactoring:
-//   server := httptest.NewServer(mockHandler)
-//   client := youtube.NewClientWithBaseURL("key", server.URL)
-//   result, err := client.GetVideoMetadata(ctx, "videoID")
-//
-// Until then, most of these tests are skipped and the client is tested
-// through integration tests in the service layer.
-
-func TestClientRefactoringNeeded(t *testing.T) {
-	t.Log("The youtube.Client needs refactoring to support testability")
-	t.Log("Current implementation hard-codes baseURL and httpClient")
-	t.Log("Suggested approach: Add NewClientWithHTTPClient or NewClientWithBaseURL")
-	t.Log("This would allow comprehensive unit testing with httptest.Server")
-
-	// For now, verify the basic structure works
-	client := youtube.NewClient("test-api-key")
-	assert.NotNil(t, client, "Client should be created")
-
-	// The actual HTTP functionality is tested via integration tests
-	// in the service layer (test/services/content_service_test.go)
+	// Compile-time check that youtube.Client implements the YouTubeClient port
+	var _ services.YouTubeClient = youtube.NewClient("test-key")
 }
 
 // --- Error message validation ---
@@ -463,3 +480,263 @@ func TestNewClient_APIKey(t *testing.T) {
 		})
 	}
 }
+
+// --- GetVideosMetadata Tests ---
+
+// batchServer answers videos requests for any ID in known and counts requests
+func batchServer(t *testing.T, known map[string]bool, requests *atomic.Int32) *httptest.Server {
+	t.Helper()
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		ids := strings.Split(r.URL.Query().Get("id"), ",")
+		assert.LessOrEqual(t, len(ids), 50, "videos endpoint accepts at most 50 IDs")
+
+		items := make([]map[string]any, 0, len(ids))
+		for _, id := range ids {
+			if !known[id] {
+				continue
+			}
+			items = append(items, map[string]any{
+				"id":             id,
+				"snippet":        map[string]any{"title": "Title " + id},
+				"contentDetails": map[string]any{"duration": "PT1M"},
+				"statistics":     map[string]any{"viewCount": "7"},
+			})
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(map[string]any{"items": items})
+	}))
+}
+
+func TestGetVideosMetadata_ChunksRequests(t *testing.T) {
+	ids := make([]string, 120)
+	known := make(map[string]bool, len(ids))
+	for i := range ids {
+		ids[i] = "v" + strconv.Itoa(i)
+		known[ids[i]] = true
+	}
+
+	var requests atomic.Int32
+	server := batchServer(t, known, &requests)
+	defer server.Close()
+
+	client := youtube.NewClient("test-key", youtube.WithBaseURL(server.URL))
+	result, err := client.GetVideosMetadata(context.Background(), ids)
+
+	require.NoError(t, err)
+	assert.Equal(t, int32(3), requests.Load(), "120 IDs need three requests of up to 50")
+	assert.Len(t, result, 120)
+	assert.Equal(t, "Title v42", result["v42"].Title)
+	assert.Equal(t, "v42", result["v42"].VideoID)
+	require.NotNil(t, result["v42"].ViewCount)
+	assert.Equal(t, int64(7), *result["v42"].ViewCount)
+}
+
+func TestGetVideosMetadata_MissingAndDuplicateIDs(t *testing.T) {
+	var requests atomic.Int32
+	server := batchServer(t, map[string]bool{"a": true, "c": true}, &requests)
+	defer server.Close()
+
+	client := youtube.NewClient("test-key", youtube.WithBaseURL(server.URL))
+	result, err := client.GetVideosMetadata(context.Background(), []string{"a", "b", "a", "", "c"})
+
+	require.NoError(t, err)
+	assert.Equal(t, int32(1), requests.Load())
+	assert.Len(t, result, 2)
+	assert.Contains(t, result, "a")
+	assert.Contains(t, result, "c")
+	assert.NotContains(t, result, "b", "IDs YouTube does not return are absent")
+}
+
+func TestGetVideosMetadata_Empty(t *testing.T) {
+	var requests atomic.Int32
+	server := batchServer(t, nil, &requests)
+	defer server.Close()
+
+	client := youtube.NewClient("test-key", youtube.WithBaseURL(server.URL))
+	result, err := client.GetVideosMetadata(context.Background(), nil)
+
+	require.NoError(t, err)
+	assert.Empty(t, result)
+	assert.Equal(t, int32(0), requests.Load())
+}
+
+func TestGetVideosMetadata_PartialFailure(t *testing.T) {
+	var requests atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// Second chunk fails; first and third succeed
+		if requests.Add(1) == 2 {
+			w.WriteHeader(http.StatusInternalServerError)
+			w.Write([]byte(`{"error": "backend error"}`))
+			return
+		}
+		ids := strings.Split(r.URL.Query().Get("id"), ",")
+		items := make([]map[string]any, len(ids))
+		for i, id := range ids {
+			items[i] = map[string]any{"id": id, "snippet": map[string]any{"title": id}}
+		}
+		json.NewEncoder(w).Encode(map[string]any{"items": items})
+	}))
+	defer server.Close()
+
+	ids := make([]string, 101)
+	for i := range ids {
+		ids[i] = "v" + strconv.Itoa(i)
+	}
+
+	client := youtube.NewClient("test-key", youtube.WithBaseURL(server.URL), noRetry)
+	result, err := client.GetVideosMetadata(context.Background(), ids)
+
+	require.Error(t, err)
+	assert.True(t, errors.Is(err, domain.ErrYouTubeAPI))
+	assert.Equal(t, int32(3), requests.Load())
+	assert.Len(t, result, 51, "first and last chunks still returned")
+	assert.Contains(t, result, "v0")
+	assert.Contains(t, result, "v100")
+	assert.NotContains(t, result, "v50")
+}
+
+func TestNewClient_WithHTTPClient(t *testing.T) {
+	var requests atomic.Int32
+	server := batchServer(t, map[string]bool{"a": true}, &requests)
+	defer server.Close()
+
+	client := youtube.NewClient("test-key",
+		youtube.WithBaseURL(server.URL+"/"),
+		youtube.WithHTTPClient(&http.Client{Timeout: time.Second}),
+	)
+	_, err := client.GetVideoMetadata(context.Background(), "a")
+
+	require.NoError(t, err)
+	assert.Equal(t, int32(1), requests.Load())
+}
+
+// --- ListCollectionVideoIDs Tests ---
+
+// playlistServer pages through videoIDs pageSize at a time on playlistItems
+// and resolves any channel to the uploads playlist "UUuploads"
+func playlistServer(t *testing.T, videoIDs []string, pageSize int, requests *atomic.Int32) *httptest.Server {
+	t.Helper()
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		q := r.URL.Query()
+		w.Header().Set("Content-Type", "application/json")
+
+		switch r.URL.Path {
+		case "/channels":
+			if q.Get("forHandle") == "@missing" {
+				w.Write([]byte(`{"items": []}`))
+				return
+			}
+			w.Write([]byte(`{"items": [{"id": "UCx", "contentDetails": {"relatedPlaylists": {"uploads": "UUuploads"}}}]}`))
+		case "/playlistItems":
+			start, _ := strconv.Atoi(q.Get("pageToken"))
+			maxResults, _ := strconv.Atoi(q.Get("maxResults"))
+			end := min(start+min(pageSize, maxResults), len(videoIDs))
+
+			type item struct {
+				ContentDetails struct {
+					VideoID string `json:"videoId"`
+				} `json:"contentDetails"`
+			}
+			resp := struct {
+				NextPageToken string `json:"nextPageToken,omitempty"`
+				Items         []item `json:"items"`
+			}{}
+			for _, id := range videoIDs[start:end] {
+				var it item
+				it.ContentDetails.VideoID = id
+				resp.Items = append(resp.Items, it)
+			}
+			if end < len(videoIDs) {
+				resp.NextPageToken = strconv.Itoa(end)
+			}
+			json.NewEncoder(w).Encode(resp)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+}
+
+func sequentialIDs(n int) []string {
+	ids := make([]string, n)
+	for i := range ids {
+		ids[i] = "v" + strconv.Itoa(i)
+	}
+	return ids
+}
+
+func TestListCollectionVideoIDs_PagesPlaylist(t *testing.T) {
+	var requests atomic.Int32
+	server := playlistServer(t, sequentialIDs(120), 50, &requests)
+	defer server.Close()
+
+	client := youtube.NewClient("test-key", youtube.WithBaseURL(server.URL))
+	ids, err := client.ListCollectionVideoIDs(context.Background(), "https://www.youtube.com/playlist?list=PLabc", 500)
+
+	require.NoError(t, err)
+	assert.Equal(t, sequentialIDs(120), ids)
+	assert.Equal(t, int32(3), requests.Load())
+}
+
+func TestListCollectionVideoIDs_StopsAtLimit(t *testing.T) {
+	var requests atomic.Int32
+	server := playlistServer(t, sequentialIDs(120), 50, &requests)
+	defer server.Close()
+
+	client := youtube.NewClient("test-key", youtube.WithBaseURL(server.URL))
+	ids, err := client.ListCollectionVideoIDs(context.Background(), "https://www.youtube.com/playlist?list=PLabc", 60)
+
+	require.NoError(t, err)
+	assert.Equal(t, sequentialIDs(60), ids)
+	assert.Equal(t, int32(2), requests.Load(), "second page requests only the remaining 10")
+}
+
+func TestListCollectionVideoIDs_ResolvesChannels(t *testing.T) {
+	for _, channelURL := range []string{
+		"https://www.youtube.com/@GoogleDevelopers",
+		"https://www.youtube.com/channel/UCuAXFkgsw1L7xaCfnd5JJOw",
+	} {
+		var requests atomic.Int32
+		server := playlistServer(t, sequentialIDs(3), 50, &requests)
+
+		client := youtube.NewClient("test-key", youtube.WithBaseURL(server.URL))
+		ids, err := client.ListCollectionVideoIDs(context.Background(), channelURL, 10)
+
+		require.NoError(t, err, channelURL)
+		assert.Equal(t, sequentialIDs(3), ids)
+		assert.Equal(t, int32(2), requests.Load(), "one channels lookup plus one page")
+		server.Close()
+	}
+}
+
+func TestListCollectionVideoIDs_ChannelNotFound(t *testing.T) {
+	var requests atomic.Int32
+	server := playlistServer(t, nil, 50, &requests)
+	defer server.Close()
+
+	client := youtube.NewClient("test-key", youtube.WithBaseURL(server.URL))
+	_, err := client.ListCollectionVideoIDs(context.Background(), "https://www.youtube.com/@missing", 10)
+
+	require.Error(t, err)
+	assert.True(t, errors.Is(err, domain.ErrNotFound))
+}
+
+func TestListCollectionVideoIDs_InvalidURL(t *testing.T) {
+	client := youtube.NewClient("test-key")
+	_, err := client.ListCollectionVideoIDs(context.Background(), "https://www.youtube.com/watch?v=dQw4w9WgXcQ", 10)
+
+	require.Error(t, err)
+	assert.True(t, errors.Is(err, domain.ErrInvalidURL))
+}
+
+func TestListCollectionVideoIDs_APIError(t *testing.T) {
+	server := createMockServer(`{"error": {"code": 403}}`, http.StatusForbidden)
+	defer server.Close()
+
+	client := youtube.NewClient("test-key", youtube.WithBaseURL(server.URL))
+	_, err := client.ListCollectionVideoIDs(context.Background(), "https://www.youtube.com/playlist?list=PLabc", 10)
+
+	require.Error(t, err)
+	assert.True(t, errors.Is(err, domain.ErrYouTubeAPI))
+}
@@ -191,3 +191,68 @@ func TestParseISO8601Duration_LargeValues(t *testing.T) {
 	require.NoError(t, err)
 	assert.Equal(t, 39599, seconds) // 10*3600 + 59*60 + 59
 }
+
+// --- ParseCollectionURL Tests ---
+
+func TestParseCollectionURL_Supported(t *testing.T) {
+	tests := []struct {
+		name string
+		url  string
+		want youtube.Collection
+	}{
+		{
+			name: "playlist page",
+			url:  "https://www.youtube.com/playlist?list=PLrAXtmErZgOeiKm4sgNOknGvNjby9efdf",
+			want: youtube.Collection{Kind: youtube.CollectionPlaylist, ID: "PLrAXtmErZgOeiKm4sgNOknGvNjby9efdf"},
+		},
+		{
+			name: "watch URL with list takes the playlist",
+			url:  "https://www.youtube.com/watch?v=dQw4w9WgXcQ&list=PLabc_123-x",
+			want: youtube.Collection{Kind: youtube.CollectionPlaylist, ID: "PLabc_123-x"},
+		},
+		{
+			name: "channel ID",
+			url:  "https://www.youtube.com/channel/UCuAXFkgsw1L7xaCfnd5JJOw",
+			want: youtube.Collection{Kind: youtube.CollectionChannelID, ID: "UCuAXFkgsw1L7xaCfnd5JJOw"},
+		},
+		{
+			name: "channel ID with tab",
+			url:  "https://youtube.com/channel/UCuAXFkgsw1L7xaCfnd5JJOw/videos",
+			want: youtube.Collection{Kind: youtube.CollectionChannelID, ID: "UCuAXFkgsw1L7xaCfnd5JJOw"},
+		},
+		{
+			name: "handle",
+			url:  "https://www.youtube.com/@GoogleDevelopers",
+			want: youtube.Collection{Kind: youtube.CollectionChannelHandle, ID: "@GoogleDevelopers"},
+		},
+		{
+			name: "mobile handle without scheme",
+			url:  "m.youtube.com/@go.dev/videos",
+			want: youtube.Collection{Kind: youtube.CollectionChannelHandle, ID: "@go.dev"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := youtube.ParseCollectionURL(tt.url)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestParseCollectionURL_Unsupported(t *testing.T) {
+	urls := []string{
+		"",
+		"https://www.youtube.com/watch?v=dQw4w9WgXcQ",
+		"https://youtu.be/dQw4w9WgXcQ",
+		"https://www.youtube.com/channel/not-a-channel",
+		"https://www.youtube.com/@x",
+		"https://www.example.com/playlist?list=PLabc",
+	}
+
+	for _, u := range urls {
+		_, err := youtube.ParseCollectionURL(u)
+		assert.Error(t, err, u)
+	}
+}
@@ -0,0 +1,343 @@
+package youtube_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/CodeWarrior-debug/perspectize/backend/internal/adapters/youtube"
+	"github.com/CodeWarrior-debug/perspectize/backend/internal/core/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeQuotaStore implements repositories.QuotaUsageRepository in memory
+type fakeQuotaStore struct {
+	mu    sync.Mutex
+	usage map[string]map[string]int // day -> method -> units
+}
+
+func newFakeQuotaStore() *fakeQuotaStore {
+	return &fakeQuotaStore{usage: make(map[string]map[string]int)}
+}
+
+func (s *fakeQuotaStore) AddUsage(ctx context.Context, day time.Time, method string, units int) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	key := day.Format(time.DateOnly)
+	if s.usage[key] == nil {
+		s.usage[key] = make(map[string]int)
+	}
+	s.usage[key][method] += units
+	return nil
+}
+
+func (s *fakeQuotaStore) GetUsage(ctx context.Context, day time.Time) (map[string]int, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	out := make(map[string]int)
+	for method, units := range s.usage[day.Format(time.DateOnly)] {
+		out[method] = units
+	}
+	return out, nil
+}
+
+// fakeClock is a settable time source
+type fakeClock struct {
+	mu  sync.Mutex
+	now time.Time
+}
+
+func (c *fakeClock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.now
+}
+
+func (c *fakeClock) Advance(d time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.now = c.now.Add(d)
+}
+
+// statusServer responds with the status returned by next and counts requests
+func statusServer(t *testing.T, requests *atomic.Int32, next func() (int, string)) *httptest.Server {
+	t.Helper()
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		status, body := next()
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(status)
+		w.Write([]byte(body))
+	}))
+}
+
+// noon Pacific on 2026-10-18, well inside one quota day
+var quotaTestStart = time.Date(2026, 10, 18, 19, 0, 0, 0, time.UTC)
+
+func TestQuota_ChargesAndPersistsUsage(t *testing.T) {
+	var requests atomic.Int32
+	server := statusServer(t, &requests, func() (int, string) { return http.StatusOK, validVideoResponse() })
+	defer server.Close()
+
+	store := newFakeQuotaStore()
+	clock := &fakeClock{now: quotaTestStart}
+	quota := youtube.NewQuota(store, youtube.QuotaConfig{DailyBudget: 100}, youtube.WithQuotaClock(clock.Now))
+	client := youtube.NewClient("test-key", youtube.WithBaseURL(server.URL), youtube.WithQuota(quota), noRetry)
+
+	for range 3 {
+		_, err := client.GetVideoMetadata(context.Background(), "dQw4w9WgXcQ")
+		require.NoError(t, err)
+	}
+
+	usage, err := quota.Usage(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, "2026-10-18", usage.Day.Format(time.DateOnly))
+	assert.Equal(t, 3, usage.Used)
+	assert.Equal(t, 97, usage.Remaining())
+	assert.Equal(t, []domain.QuotaMethodUsage{{Method: "videos.list", Units: 3}}, usage.ByMethod)
+	assert.Equal(t, domain.CircuitClosed, usage.Breaker)
+	assert.Equal(t, 3, store.usage["2026-10-18"]["videos.list"])
+}
+
+func TestQuota_BudgetSurvivesRestart(t *testing.T) {
+	var requests atomic.Int32
+	server := statusServer(t, &requests, func() (int, string) { return http.StatusOK, validVideoResponse() })
+	defer server.Close()
+
+	store := newFakeQuotaStore()
+	store.usage["2026-10-18"] = map[string]int{"videos.list": 9, "playlistItems.list": 1}
+	clock := &fakeClock{now: quotaTestStart}
+	quota := youtube.NewQuota(store, youtube.QuotaConfig{DailyBudget: 10}, youtube.WithQuotaClock(clock.Now))
+	client := youtube.NewClient("test-key", youtube.WithBaseURL(server.URL), youtube.WithQuota(quota), noRetry)
+
+	_, err := client.GetVideoMetadata(context.Background(), "dQw4w9WgXcQ")
+
+	require.ErrorIs(t, err, domain.ErrQuotaExceeded)
+	assert.Equal(t, int32(0), requests.Load(), "exhausted budget should fail before sending")
+
+	// The next quota day starts at midnight Pacific with a fresh budget
+	clock.Advance(13 * time.Hour)
+	_, err = client.GetVideoMetadata(context.Background(), "dQw4w9WgXcQ")
+	require.NoError(t, err)
+	assert.Equal(t, 1, store.usage["2026-10-19"]["videos.list"])
+}
+
+// slowQuotaStore is a fakeQuotaStore whose GetUsage blocks, once armed,
+// until gate is closed
+type slowQuotaStore struct {
+	*fakeQuotaStore
+	armed   atomic.Bool
+	entered chan struct{}
+	gate    chan struct{}
+}
+
+func (s *slowQuotaStore) GetUsage(ctx context.Context, day time.Time) (map[string]int, error) {
+	if s.armed.Load() {
+		close(s.entered)
+		<-s.gate
+	}
+	return s.fakeQuotaStore.GetUsage(ctx, day)
+}
+
+func TestQuota_SlowStoreDoesNotBlockInFlightCalls(t *testing.T) {
+	var requests atomic.Int32
+	release := make(chan struct{})
+	server := statusServer(t, &requests, func() (int, string) {
+		<-release
+		return http.StatusOK, validVideoResponse()
+	})
+	defer server.Close()
+
+	store := &slowQuotaStore{fakeQuotaStore: newFakeQuotaStore(), entered: make(chan struct{}), gate: make(chan struct{})}
+	clock := &fakeClock{now: quotaTestStart}
+	quota := youtube.NewQuota(store, youtube.QuotaConfig{DailyBudget: 100}, youtube.WithQuotaClock(clock.Now))
+	client := youtube.NewClient("test-key", youtube.WithBaseURL(server.URL), youtube.WithQuota(quota), noRetry)
+
+	inFlight := make(chan error, 1)
+	go func() {
+		_, err := client.GetVideoMetadata(context.Background(), "dQw4w9WgXcQ")
+		inFlight <- err
+	}()
+	require.Eventually(t, func() bool { return requests.Load() == 1 }, time.Second, time.Millisecond)
+
+	// A call on the next quota day stalls loading that day's spend
+	clock.Advance(13 * time.Hour)
+	store.armed.Store(true)
+	nextDay := make(chan error, 1)
+	go func() {
+		_, err := client.GetVideoMetadata(context.Background(), "dQw4w9WgXcQ")
+		nextDay <- err
+	}()
+	<-store.entered
+
+	// The in-flight call still records its outcome and returns
+	close(release)
+	select {
+	case err := <-inFlight:
+		require.NoError(t, err)
+	case <-time.After(2 * time.Second):
+		t.Fatal("in-flight call blocked on the quota store")
+	}
+
+	close(store.gate)
+	require.NoError(t, <-nextDay)
+	assert.Equal(t, 1, store.usage["2026-10-19"]["videos.list"])
+}
+
+// failingQuotaStore is a fakeQuotaStore whose GetUsage fails while failing is set
+type failingQuotaStore struct {
+	*fakeQuotaStore
+	failing atomic.Bool
+}
+
+func (s *failingQuotaStore) GetUsage(ctx context.Context, day time.Time) (map[string]int, error) {
+	if s.failing.Load() {
+		return nil, errors.New("connection refused")
+	}
+	return s.fakeQuotaStore.GetUsage(ctx, day)
+}
+
+func TestQuota_UsageLoadFailureFailsClosedAndRetries(t *testing.T) {
+	var requests atomic.Int32
+	server := statusServer(t, &requests, func() (int, string) { return http.StatusOK, validVideoResponse() })
+	defer server.Close()
+
+	store := &failingQuotaStore{fakeQuotaStore: newFakeQuotaStore()}
+	store.usage["2026-10-18"] = map[string]int{"videos.list": 10}
+	store.failing.Store(true)
+	clock := &fakeClock{now: quotaTestStart}
+	quota := youtube.NewQuota(store, youtube.QuotaConfig{DailyBudget: 10}, youtube.WithQuotaClock(clock.Now))
+	client := youtube.NewClient("test-key", youtube.WithBaseURL(server.URL), youtube.WithQuota(quota), noRetry)
+
+	_, err := client.GetVideoMetadata(context.Background(), "dQw4w9WgXcQ")
+	require.ErrorIs(t, err, domain.ErrQuotaExceeded)
+	_, err = quota.Usage(context.Background())
+	require.Error(t, err)
+	assert.Equal(t, int32(0), requests.Load(), "unknown spend should fail before sending")
+
+	// Once the store recovers the persisted spend is loaded, not a fresh budget
+	store.failing.Store(false)
+	_, err = client.GetVideoMetadata(context.Background(), "dQw4w9WgXcQ")
+	require.ErrorIs(t, err, domain.ErrQuotaExceeded)
+	assert.Equal(t, int32(0), requests.Load())
+	usage, err := quota.Usage(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, 10, usage.Used)
+}
+
+func TestQuota_BreakerOpensAfterRepeatedFailures(t *testing.T) {
+	var requests atomic.Int32
+	var healthy atomic.Bool
+	server := statusServer(t, &requests, func() (int, string) {
+		if healthy.Load() {
+			return http.StatusOK, validVideoResponse()
+		}
+		return http.StatusServiceUnavailable, `{"error": {"code": 503}}`
+	})
+	defer server.Close()
+
+	clock := &fakeClock{now: quotaTestStart}
+	quota := youtube.NewQuota(newFakeQuotaStore(), youtube.QuotaConfig{
+		FailureThreshold: 3,
+		Cooldown:         time.Minute,
+	}, youtube.WithQuotaClock(clock.Now))
+	client := youtube.NewClient("test-key", youtube.WithBaseURL(server.URL), youtube.WithQuota(quota), noRetry)
+
+	for range 3 {
+		_, err := client.GetVideoMetadata(context.Background(), "dQw4w9WgXcQ")
+		require.ErrorIs(t, err, domain.ErrYouTubeAPI)
+	}
+
+	_, err := client.GetVideoMetadata(context.Background(), "dQw4w9WgXcQ")
+	require.ErrorIs(t, err, domain.ErrQuotaExceeded)
+	assert.Equal(t, int32(3), requests.Load(), "open breaker should fail fast")
+
+	usage, err := quota.Usage(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, domain.CircuitOpen, usage.Breaker)
+	require.NotNil(t, usage.BreakerOpenUntil)
+	assert.Equal(t, quotaTestStart.Add(time.Minute), *usage.BreakerOpenUntil)
+
+	// After the cooldown a probe goes through and closes the breaker on success
+	clock.Advance(time.Minute)
+	healthy.Store(true)
+	_, err = client.GetVideoMetadata(context.Background(), "dQw4w9WgXcQ")
+	require.NoError(t, err)
+
+	usage, err = quota.Usage(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, domain.CircuitClosed, usage.Breaker)
+	assert.Nil(t, usage.BreakerOpenUntil)
+}
+
+func TestQuota_FailedProbeReopensBreaker(t *testing.T) {
+	var requests atomic.Int32
+	server := statusServer(t, &requests, func() (int, string) { return http.StatusInternalServerError, `{}` })
+	defer server.Close()
+
+	clock := &fakeClock{now: quotaTestStart}
+	quota := youtube.NewQuota(newFakeQuotaStore(), youtube.QuotaConfig{
+		FailureThreshold: 1,
+		Cooldown:         time.Minute,
+	}, youtube.WithQuotaClock(clock.Now))
+	client := youtube.NewClient("test-key", youtube.WithBaseURL(server.URL), youtube.WithQuota(quota), noRetry)
+
+	_, err := client.GetVideoMetadata(context.Background(), "dQw4w9WgXcQ")
+	require.ErrorIs(t, err, domain.ErrYouTubeAPI)
+
+	clock.Advance(time.Minute)
+	_, err = client.GetVideoMetadata(context.Background(), "dQw4w9WgXcQ")
+	require.ErrorIs(t, err, domain.ErrYouTubeAPI, "half-open probe reaches the API")
+
+	_, err = client.GetVideoMetadata(context.Background(), "dQw4w9WgXcQ")
+	require.ErrorIs(t, err, domain.ErrQuotaExceeded)
+	assert.Equal(t, int32(2), requests.Load())
+}
+
+func TestQuota_QuotaExceededResponseOpensUntilReset(t *testing.T) {
+	var requests atomic.Int32
+	server := statusServer(t, &requests, func() (int, string) {
+		return http.StatusForbidden, `{"error": {"code": 403, "errors": [{"reason": "quotaExceeded"}]}}`
+	})
+	defer server.Close()
+
+	clock := &fakeClock{now: quotaTestStart}
+	quota := youtube.NewQuota(newFakeQuotaStore(), youtube.QuotaConfig{}, youtube.WithQuotaClock(clock.Now))
+	client := youtube.NewClient("test-key", youtube.WithBaseURL(server.URL), youtube.WithQuota(quota), noRetry)
+
+	_, err := client.GetVideoMetadata(context.Background(), "dQw4w9WgXcQ")
+	require.ErrorIs(t, err, domain.ErrQuotaExceeded)
+
+	usage, err := quota.Usage(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, domain.CircuitOpen, usage.Breaker)
+	require.NotNil(t, usage.BreakerOpenUntil)
+	assert.True(t, usage.BreakerOpenUntil.Equal(usage.ResetsAt), "breaker stays open until the quota resets")
+
+	clock.Advance(time.Hour)
+	_, err = client.GetVideoMetadata(context.Background(), "dQw4w9WgXcQ")
+	require.ErrorIs(t, err, domain.ErrQuotaExceeded)
+	assert.Equal(t, int32(1), requests.Load())
+}
+
+func TestQuota_NotFoundDoesNotTripBreaker(t *testing.T) {
+	var requests atomic.Int32
+	server := statusServer(t, &requests, func() (int, string) { return http.StatusNotFound, `{}` })
+	defer server.Close()
+
+	clock := &fakeClock{now: quotaTestStart}
+	quota := youtube.NewQuota(newFakeQuotaStore(), youtube.QuotaConfig{FailureThreshold: 1}, youtube.WithQuotaClock(clock.Now))
+	client := youtube.NewClient("test-key", youtube.WithBaseURL(server.URL), youtube.WithQuota(quota), noRetry)
+
+	for range 2 {
+		_, err := client.GetVideoMetadata(context.Background(), "dQw4w9WgXcQ")
+		require.ErrorIs(t, err, domain.ErrYouTubeAPI)
+	}
+	assert.Equal(t, int32(2), requests.Load())
+}
@@ -0,0 +1,147 @@
+package youtube_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/CodeWarrior-debug/perspectize/backend/internal/adapters/youtube"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const fullSnippetResponse = `{
+	"items": [
+		{
+			"kind": "youtube#video",
+			"id": "dQw4w9WgXcQ",
+			"snippet": {
+				"publishedAt": "2009-10-25T06:57:33Z",
+				"title": "Test Video",
+				"description": "A long description",
+				"channelTitle": "Test Channel",
+				"tags": ["music", "80s"],
+				"thumbnails": {"default": {"url": "https://i.ytimg.com/vi/dQw4w9WgXcQ/default.jpg"}},
+				"localized": {"title": "Test Video", "description": "A long description"}
+			},
+			"contentDetails": {"duration": "PT3M33S"},
+			"statistics": {"viewCount": "1000", "likeCount": "10", "commentCount": "1"}
+		}
+	]
+}`
+
+// storedSnippet returns the snippet object from a stored response
+func storedSnippet(t *testing.T, response json.RawMessage) map[string]any {
+	t.Helper()
+	var stored struct {
+		Items []struct {
+			Snippet map[string]any `json:"snippet"`
+		} `json:"items"`
+	}
+	require.NoError(t, json.Unmarshal(response, &stored))
+	require.Len(t, stored.Items, 1)
+	return stored.Items[0].Snippet
+}
+
+func TestGetVideoMetadata_PublishedAtAndTags(t *testing.T) {
+	server := createMockServer(fullSnippetResponse, http.StatusOK)
+	defer server.Close()
+
+	client := youtube.NewClient("test-key", youtube.WithBaseURL(server.URL))
+	result, err := client.GetVideoMetadata(context.Background(), "dQw4w9WgXcQ")
+
+	require.NoError(t, err)
+	require.NotNil(t, result.PublishedAt)
+	assert.True(t, result.PublishedAt.Equal(time.Date(2009, 10, 25, 6, 57, 33, 0, time.UTC)))
+	assert.Equal(t, []string{"music", "80s"}, result.Tags)
+	assert.Equal(t, "A long description", result.Description)
+}
+
+func TestGetVideoMetadata_InvalidPublishedAt(t *testing.T) {
+	server := createMockServer(`{"items": [{"id": "dQw4w9WgXcQ", "snippet": {"publishedAt": "yesterday"}}]}`, http.StatusOK)
+	defer server.Close()
+
+	client := youtube.NewClient("test-key", youtube.WithBaseURL(server.URL))
+	result, err := client.GetVideoMetadata(context.Background(), "dQw4w9WgXcQ")
+
+	require.NoError(t, err)
+	assert.Nil(t, result.PublishedAt)
+}
+
+func TestGetVideoMetadata_ResponseTrimmedByDefault(t *testing.T) {
+	server := createMockServer(fullSnippetResponse, http.StatusOK)
+	defer server.Close()
+
+	client := youtube.NewClient("test-key", youtube.WithBaseURL(server.URL))
+	result, err := client.GetVideoMetadata(context.Background(), "dQw4w9WgXcQ")
+	require.NoError(t, err)
+
+	snippet := storedSnippet(t, result.Response)
+	assert.Equal(t, "Test Video", snippet["title"])
+	assert.Equal(t, "Test Channel", snippet["channelTitle"])
+	assert.Equal(t, "2009-10-25T06:57:33Z", snippet["publishedAt"])
+	for _, field := range []string{"thumbnails", "localized", "description", "tags"} {
+		assert.NotContains(t, snippet, field)
+	}
+
+	var stored youtube.YouTubeAPIResponse
+	require.NoError(t, json.Unmarshal(result.Response, &stored))
+	require.Len(t, stored.Items, 1)
+	assert.Equal(t, "dQw4w9WgXcQ", stored.Items[0].ID)
+	assert.Equal(t, "1000", stored.Items[0].Statistics.ViewCount)
+}
+
+func TestGetVideoMetadata_ResponseModes(t *testing.T) {
+	server := createMockServer(fullSnippetResponse, http.StatusOK)
+	defer server.Close()
+
+	t.Run("full keeps every field", func(t *testing.T) {
+		client := youtube.NewClient("test-key", youtube.WithBaseURL(server.URL), youtube.WithResponseMode(youtube.ResponseModeFull))
+		result, err := client.GetVideoMetadata(context.Background(), "dQw4w9WgXcQ")
+		require.NoError(t, err)
+
+		snippet := storedSnippet(t, result.Response)
+		for _, field := range []string{"thumbnails", "localized", "description", "tags"} {
+			assert.Contains(t, snippet, field)
+		}
+	})
+
+	t.Run("none stores nothing", func(t *testing.T) {
+		client := youtube.NewClient("test-key", youtube.WithBaseURL(server.URL), youtube.WithResponseMode(youtube.ResponseModeNone))
+		result, err := client.GetVideoMetadata(context.Background(), "dQw4w9WgXcQ")
+		require.NoError(t, err)
+
+		assert.Nil(t, result.Response)
+		assert.Equal(t, "Test Video", result.Title, "metadata is still extracted")
+		require.NotNil(t, result.ViewCount)
+		assert.Equal(t, int64(1000), *result.ViewCount)
+	})
+}
+
+func TestParseResponseMode(t *testing.T) {
+	tests := []struct {
+		input   string
+		want    youtube.ResponseMode
+		wantErr bool
+	}{
+		{input: "", want: youtube.ResponseModeTrimmed},
+		{input: "full", want: youtube.ResponseModeFull},
+		{input: " Trimmed ", want: youtube.ResponseModeTrimmed},
+		{input: "NONE", want: youtube.ResponseModeNone},
+		{input: "compressed", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			got, err := youtube.ParseResponseMode(tt.input)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
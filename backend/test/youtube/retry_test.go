@@ -0,0 +1,319 @@
+package youtube_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+	"unicode/utf8"
+
+	"github.com/CodeWarrior-debug/perspectize/backend/internal/adapters/youtube"
+	"github.com/CodeWarrior-debug/perspectize/backend/internal/core/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fastRetry retries quickly so tests do not wait on real backoff
+var fastRetry = youtube.WithRetry(youtube.RetryPolicy{
+	MaxAttempts: 3,
+	BaseDelay:   time.Millisecond,
+	MaxDelay:    5 * time.Millisecond,
+})
+
+// sequenceServer answers the nth request with responses[n], repeating the last
+func sequenceServer(t *testing.T, requests *atomic.Int32, responses ...func(w http.ResponseWriter)) *httptest.Server {
+	t.Helper()
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := int(requests.Add(1)) - 1
+		responses[min(n, len(responses)-1)](w)
+	}))
+}
+
+func respond(status int, body string) func(w http.ResponseWriter) {
+	return func(w http.ResponseWriter) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(status)
+		w.Write([]byte(body))
+	}
+}
+
+func googleError(status int, reason, message string) func(w http.ResponseWriter) {
+	return respond(status, fmt.Sprintf(`{"error": {"code": %d, "message": %q, "errors": [{"reason": %q}]}}`, status, message, reason))
+}
+
+func TestGet_RetriesTemporaryFailures(t *testing.T) {
+	tests := []struct {
+		name     string
+		failure  func(w http.ResponseWriter)
+		attempts int32
+	}{
+		{name: "503", failure: respond(http.StatusServiceUnavailable, `{}`), attempts: 2},
+		{name: "429", failure: respond(http.StatusTooManyRequests, `{}`), attempts: 2},
+		{name: "rate limit 403", failure: googleError(http.StatusForbidden, youtube.ReasonRateLimitExceeded, "slow down"), attempts: 2},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var requests atomic.Int32
+			server := sequenceServer(t, &requests, tt.failure, respond(http.StatusOK, validVideoResponse()))
+			defer server.Close()
+
+			client := youtube.NewClient("test-key", youtube.WithBaseURL(server.URL), fastRetry)
+			result, err := client.GetVideoMetadata(context.Background(), "dQw4w9WgXcQ")
+
+			require.NoError(t, err)
+			assert.Equal(t, "Test Video", result.Title)
+			assert.Equal(t, tt.attempts, requests.Load())
+		})
+	}
+}
+
+func TestGet_GivesUpAfterMaxAttempts(t *testing.T) {
+	var requests atomic.Int32
+	server := sequenceServer(t, &requests, respond(http.StatusBadGateway, `<html>bad gateway</html>`))
+	defer server.Close()
+
+	client := youtube.NewClient("test-key", youtube.WithBaseURL(server.URL), fastRetry)
+	_, err := client.GetVideoMetadata(context.Background(), "dQw4w9WgXcQ")
+
+	var apiErr *youtube.APIError
+	require.ErrorAs(t, err, &apiErr)
+	assert.Equal(t, http.StatusBadGateway, apiErr.StatusCode)
+	assert.Equal(t, "<html>bad gateway</html>", apiErr.Message)
+	assert.Equal(t, int32(3), requests.Load())
+}
+
+func TestGet_DoesNotRetryPermanentFailures(t *testing.T) {
+	tests := []struct {
+		name    string
+		failure func(w http.ResponseWriter)
+	}{
+		{name: "400", failure: respond(http.StatusBadRequest, `{}`)},
+		{name: "404", failure: respond(http.StatusNotFound, `{}`)},
+		{name: "quota exceeded", failure: googleError(http.StatusForbidden, youtube.ReasonQuotaExceeded, "quota")},
+		{name: "key invalid", failure: googleError(http.StatusBadRequest, youtube.ReasonKeyInvalid, "bad key")},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var requests atomic.Int32
+			server := sequenceServer(t, &requests, tt.failure, respond(http.StatusOK, validVideoResponse()))
+			defer server.Close()
+
+			client := youtube.NewClient("test-key", youtube.WithBaseURL(server.URL), fastRetry)
+			_, err := client.GetVideoMetadata(context.Background(), "dQw4w9WgXcQ")
+
+			require.ErrorIs(t, err, domain.ErrYouTubeAPI)
+			assert.Equal(t, int32(1), requests.Load())
+		})
+	}
+}
+
+func TestGet_RetriesNetworkErrors(t *testing.T) {
+	var requests atomic.Int32
+	server := sequenceServer(t, &requests,
+		func(w http.ResponseWriter) {
+			// Drop the connection without a response
+			conn, _, err := w.(http.Hijacker).Hijack()
+			require.NoError(t, err)
+			conn.Close()
+		},
+		respond(http.StatusOK, validVideoResponse()),
+	)
+	defer server.Close()
+
+	client := youtube.NewClient("test-key", youtube.WithBaseURL(server.URL), fastRetry)
+	_, err := client.GetVideoMetadata(context.Background(), "dQw4w9WgXcQ")
+
+	require.NoError(t, err)
+	assert.Equal(t, int32(2), requests.Load())
+}
+
+func TestGet_RequestTimeoutRetries(t *testing.T) {
+	var requests atomic.Int32
+	release := make(chan struct{})
+	defer close(release)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if requests.Add(1) == 1 {
+			select {
+			case <-r.Context().Done():
+			case <-release:
+			}
+			return
+		}
+		respond(http.StatusOK, validVideoResponse())(w)
+	}))
+	defer server.Close()
+
+	client := youtube.NewClient("test-key",
+		youtube.WithBaseURL(server.URL),
+		youtube.WithRequestTimeout(50*time.Millisecond),
+		fastRetry,
+	)
+	_, err := client.GetVideoMetadata(context.Background(), "dQw4w9WgXcQ")
+
+	require.NoError(t, err)
+	assert.Equal(t, int32(2), requests.Load())
+}
+
+func TestGet_StopsRetryingWhenContextCanceled(t *testing.T) {
+	var requests atomic.Int32
+	ctx, cancel := context.WithCancel(context.Background())
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		cancel()
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	client := youtube.NewClient("test-key", youtube.WithBaseURL(server.URL), fastRetry)
+	_, err := client.GetVideoMetadata(ctx, "dQw4w9WgXcQ")
+
+	require.Error(t, err)
+	assert.Equal(t, int32(1), requests.Load())
+}
+
+func TestGet_ResponseSizeCap(t *testing.T) {
+	var requests atomic.Int32
+	server := sequenceServer(t, &requests, respond(http.StatusOK, validVideoResponse()))
+	defer server.Close()
+
+	client := youtube.NewClient("test-key", youtube.WithBaseURL(server.URL), youtube.WithMaxResponseBytes(64))
+	_, err := client.GetVideoMetadata(context.Background(), "dQw4w9WgXcQ")
+
+	require.ErrorIs(t, err, domain.ErrYouTubeAPI)
+	assert.Contains(t, err.Error(), "larger than 64 bytes")
+	assert.Equal(t, int32(1), requests.Load())
+}
+
+func TestAPIError_TypedReasons(t *testing.T) {
+	tests := []struct {
+		name   string
+		status int
+		reason string
+		want   error
+	}{
+		{name: "quota exceeded", status: http.StatusForbidden, reason: youtube.ReasonQuotaExceeded, want: domain.ErrQuotaExceeded},
+		{name: "daily limit", status: http.StatusForbidden, reason: youtube.ReasonDailyLimitExceeded, want: domain.ErrQuotaExceeded},
+		{name: "key invalid", status: http.StatusBadRequest, reason: youtube.ReasonKeyInvalid, want: youtube.ErrKeyInvalid},
+		{name: "video not found", status: http.StatusNotFound, reason: youtube.ReasonVideoNotFound, want: domain.ErrNotFound},
+		{name: "playlist not found", status: http.StatusNotFound, reason: youtube.ReasonPlaylistNotFound, want: domain.ErrNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var requests atomic.Int32
+			server := sequenceServer(t, &requests, googleError(tt.status, tt.reason, "details"))
+			defer server.Close()
+
+			client := youtube.NewClient("test-key", youtube.WithBaseURL(server.URL), noRetry)
+			_, err := client.GetVideoMetadata(context.Background(), "dQw4w9WgXcQ")
+
+			require.ErrorIs(t, err, tt.want)
+			require.ErrorIs(t, err, domain.ErrYouTubeAPI)
+
+			var apiErr *youtube.APIError
+			require.ErrorAs(t, err, &apiErr)
+			assert.Equal(t, tt.status, apiErr.StatusCode)
+			assert.Equal(t, tt.reason, apiErr.Reason)
+			assert.Equal(t, "details", apiErr.Message)
+			assert.Contains(t, err.Error(), "("+tt.reason+")")
+		})
+	}
+}
+
+func TestGet_APIKeyNeverInErrors(t *testing.T) {
+	const apiKey = "AIzaSecretKey123"
+
+	t.Run("sent as header, not query", func(t *testing.T) {
+		var gotHeader, gotQueryKey string
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			gotHeader = r.Header.Get("X-Goog-Api-Key")
+			gotQueryKey = r.URL.Query().Get("key")
+			respond(http.StatusOK, validVideoResponse())(w)
+		}))
+		defer server.Close()
+
+		client := youtube.NewClient(apiKey, youtube.WithBaseURL(server.URL))
+		_, err := client.GetVideoMetadata(context.Background(), "dQw4w9WgXcQ")
+
+		require.NoError(t, err)
+		assert.Equal(t, apiKey, gotHeader)
+		assert.Empty(t, gotQueryKey)
+	})
+
+	t.Run("network error", func(t *testing.T) {
+		server := httptest.NewServer(http.NotFoundHandler())
+		server.Close()
+
+		client := youtube.NewClient(apiKey, youtube.WithBaseURL(server.URL), noRetry)
+		_, err := client.GetVideoMetadata(context.Background(), "dQw4w9WgXcQ")
+
+		require.Error(t, err)
+		assert.NotContains(t, err.Error(), apiKey)
+		assert.NotContains(t, err.Error(), server.URL, "request URL is stripped from transport errors")
+	})
+
+	t.Run("echoed in error body", func(t *testing.T) {
+		var requests atomic.Int32
+		server := sequenceServer(t, &requests, googleError(http.StatusBadRequest, youtube.ReasonKeyInvalid, "API key "+apiKey+" not valid"))
+		defer server.Close()
+
+		client := youtube.NewClient(apiKey, youtube.WithBaseURL(server.URL), noRetry)
+		_, err := client.GetVideoMetadata(context.Background(), "dQw4w9WgXcQ")
+
+		require.ErrorIs(t, err, youtube.ErrKeyInvalid)
+		assert.NotContains(t, err.Error(), apiKey)
+		assert.True(t, strings.Contains(err.Error(), "[REDACTED]"))
+	})
+
+	t.Run("straddling the message length limit", func(t *testing.T) {
+		var requests atomic.Int32
+		// The key spans bytes 195-211 of a message cut at 200
+		message := strings.Repeat("x", 195) + apiKey + " not valid"
+		server := sequenceServer(t, &requests, googleError(http.StatusBadRequest, youtube.ReasonKeyInvalid, message))
+		defer server.Close()
+
+		client := youtube.NewClient(apiKey, youtube.WithBaseURL(server.URL), noRetry)
+		_, err := client.GetVideoMetadata(context.Background(), "dQw4w9WgXcQ")
+
+		require.ErrorIs(t, err, youtube.ErrKeyInvalid)
+		assert.NotContains(t, err.Error(), apiKey[:5], "no prefix of the key survives truncation")
+		assert.Contains(t, err.Error(), "[RED")
+	})
+}
+
+func TestGet_ErrorMessageTruncatedOnRuneBoundary(t *testing.T) {
+	var requests atomic.Int32
+	// Each é is two bytes, so byte 200 falls inside one
+	message := "x" + strings.Repeat("é", 150)
+	server := sequenceServer(t, &requests, googleError(http.StatusBadRequest, "badRequest", message))
+	defer server.Close()
+
+	client := youtube.NewClient("test-key", youtube.WithBaseURL(server.URL), noRetry)
+	_, err := client.GetVideoMetadata(context.Background(), "dQw4w9WgXcQ")
+
+	require.Error(t, err)
+	assert.True(t, utf8.ValidString(err.Error()))
+	assert.Contains(t, err.Error(), "x"+strings.Repeat("é", 99)+"...")
+}
+
+func TestGet_ErrorsAreNotRetriedPastBreaker(t *testing.T) {
+	var requests atomic.Int32
+	server := sequenceServer(t, &requests, respond(http.StatusServiceUnavailable, `{}`))
+	defer server.Close()
+
+	clock := &fakeClock{now: quotaTestStart}
+	quota := youtube.NewQuota(newFakeQuotaStore(), youtube.QuotaConfig{FailureThreshold: 2}, youtube.WithQuotaClock(clock.Now))
+	client := youtube.NewClient("test-key", youtube.WithBaseURL(server.URL), youtube.WithQuota(quota), fastRetry)
+
+	_, err := client.GetVideoMetadata(context.Background(), "dQw4w9WgXcQ")
+
+	require.True(t, errors.Is(err, domain.ErrQuotaExceeded), "third attempt hits the open breaker: %v", err)
+	assert.Equal(t, int32(2), requests.Load())
+}
@@ -0,0 +1,65 @@
+package youtube_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/CodeWarrior-debug/perspectize/backend/internal/adapters/youtube"
+	"github.com/CodeWarrior-debug/perspectize/backend/internal/config"
+	"github.com/CodeWarrior-debug/perspectize/backend/internal/core/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewStack_WiresQuotaAndCache(t *testing.T) {
+	server := newCacheServer(t, map[string]string{"vid1": "10", "vid2": "20"})
+	cacheStore := newFakeCacheStore()
+	cfg := config.YouTubeConfig{
+		APIKey: "test-key",
+		Quota:  config.YouTubeQuotaConfig{DailyBudget: 1},
+		HTTP:   config.YouTubeHTTPConfig{MaxAttempts: 1},
+		Cache:  config.YouTubeCacheConfig{Enabled: true, Persistent: true},
+	}
+
+	stack, err := youtube.NewStack(cfg, newFakeQuotaStore(), cacheStore, youtube.WithBaseURL(server.URL))
+	require.NoError(t, err)
+	assert.IsType(t, &youtube.CachingClient{}, stack.Videos)
+
+	_, err = stack.Videos.GetVideoMetadata(context.Background(), "vid1")
+	require.NoError(t, err)
+	assert.Contains(t, cacheStore.entries, "vid1")
+
+	// Served from the cache, so the spent budget does not matter
+	_, err = stack.Videos.GetVideoMetadata(context.Background(), "vid1")
+	require.NoError(t, err)
+
+	_, err = stack.Videos.GetVideoMetadata(context.Background(), "vid2")
+	assert.ErrorIs(t, err, domain.ErrQuotaExceeded)
+	assert.Len(t, server.seen(), 1)
+
+	usage, err := stack.Quota.Usage(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, 1, usage.Used)
+}
+
+func TestNewStack_CacheDisabled(t *testing.T) {
+	stack, err := youtube.NewStack(config.YouTubeConfig{APIKey: "test-key"}, newFakeQuotaStore(), nil)
+	require.NoError(t, err)
+	assert.Same(t, stack.API, stack.Videos)
+}
+
+func TestNewStack_Errors(t *testing.T) {
+	tests := []struct {
+		name string
+		cfg  config.YouTubeConfig
+	}{
+		{"bad response storage", config.YouTubeConfig{ResponseStorage: "some"}},
+		{"persistent cache without store", config.YouTubeConfig{Cache: config.YouTubeCacheConfig{Enabled: true, Persistent: true}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := youtube.NewStack(tt.cfg, newFakeQuotaStore(), nil)
+			assert.Error(t, err)
+		})
+	}
+}
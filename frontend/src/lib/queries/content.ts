@@ -32,16 +32,19 @@ export interface ContentResponse {
 
 export interface CreateContentResponse {
 	createContentFromYouTube: {
-		id: string;
-		name: string;
-		url: string;
-		contentType: string;
-		length: number | null;
-		lengthUnits: string | null;
-		viewCount: number | null;
-		likeCount: number | null;
-		commentCount: number | null;
-		createdAt: string;
+		created: boolean;
+		content: {
+			id: string;
+			name: string;
+			url: string;
+			contentType: string;
+			length: number | null;
+			lengthUnits: string | null;
+			viewCount: number | null;
+			likeCount: number | null;
+			commentCount: number | null;
+			createdAt: string;
+		};
 	};
 }
 
@@ -111,16 +114,19 @@ export const GET_CONTENT = gql`
 export const CREATE_CONTENT_FROM_YOUTUBE = gql`
 	mutation CreateContentFromYouTube($input: CreateContentFromYouTubeInput!) {
 		createContentFromYouTube(input: $input) {
-			id
-			name
-			url
-			contentType
-			length
-			lengthUnits
-			viewCount
-			likeCount
-			commentCount
-			createdAt
+			created
+			content {
+				id
+				name
+				url
+				contentType
+				length
+				lengthUnits
+				viewCount
+				likeCount
+				commentCount
+				createdAt
+			}
 		}
 	}
 `;
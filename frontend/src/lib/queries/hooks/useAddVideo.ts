@@ -14,7 +14,7 @@ export function useAddVideo() {
 			});
 		},
 		onSuccess: (data: CreateContentResponse) => {
-			const name = data?.createContentFromYouTube?.name ?? 'video';
+			const name = data?.createContentFromYouTube?.content?.name ?? 'video';
 			toast.success(`Added: ${name}`);
 			queryClient.invalidateQueries({ queryKey: queryKeys.content.lists() });
 		},
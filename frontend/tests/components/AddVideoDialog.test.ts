@@ -89,7 +89,7 @@ describe('AddVideoDialog mutation callbacks', () => {
 		expect(capturedMutationOptions).toBeDefined();
 
 		capturedMutationOptions.onSuccess({
-			createContentFromYouTube: { name: 'Test Video' }
+			createContentFromYouTube: { created: true, content: { name: 'Test Video' } }
 		});
 
 		expect(mockToastSuccess).toHaveBeenCalledWith('Added: Test Video');
@@ -162,7 +162,7 @@ describe('AddVideoDialog mutation callbacks', () => {
 	it('mutationFn calls graphqlClient.request with correct args', async () => {
 		expect(capturedMutationOptions).toBeDefined();
 		const { graphqlClient } = await import('$lib/queries/client');
-		(graphqlClient.request as any).mockResolvedValue({ createContentFromYouTube: { name: 'Test' } });
+		(graphqlClient.request as any).mockResolvedValue({ createContentFromYouTube: { created: true, content: { name: 'Test' } } });
 		await capturedMutationOptions.mutationFn('https://youtube.com/watch?v=abc123');
 		expect(graphqlClient.request).toHaveBeenCalledWith(
 			expect.anything(),
@@ -132,7 +132,7 @@ describe('AddVideoPopover mutation callbacks', () => {
 		expect(capturedMutationOptions).toBeDefined();
 
 		capturedMutationOptions.onSuccess({
-			createContentFromYouTube: { name: 'Test Video' }
+			createContentFromYouTube: { created: true, content: { name: 'Test Video' } }
 		});
 
 		expect(mockToastSuccess).toHaveBeenCalledWith('Added: Test Video');
@@ -181,7 +181,7 @@ describe('AddVideoPopover mutation callbacks', () => {
 	it('mutationFn calls graphqlClient.request with correct args', async () => {
 		expect(capturedMutationOptions).toBeDefined();
 		const { graphqlClient } = await import('$lib/queries/client');
-		(graphqlClient.request as any).mockResolvedValue({ createContentFromYouTube: { name: 'Test' } });
+		(graphqlClient.request as any).mockResolvedValue({ createContentFromYouTube: { created: true, content: { name: 'Test' } } });
 		await capturedMutationOptions.mutationFn('https://youtube.com/watch?v=abc123');
 		expect(graphqlClient.request).toHaveBeenCalledWith(
 			expect.anything(),
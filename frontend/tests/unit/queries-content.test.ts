@@ -49,16 +49,19 @@ describe('GraphQL query definitions', () => {
 		it('exports CreateContentResponse interface', () => {
 			const response: CreateContentResponse = {
 				createContentFromYouTube: {
-					id: '1',
-					name: 'Test',
-					url: 'https://youtube.com/watch?v=test',
-					contentType: 'VIDEO',
-					length: 100,
-					lengthUnits: 'SECONDS',
-					viewCount: 1000,
-					likeCount: 100,
-					commentCount: 50,
-					createdAt: '2024-01-01',
+					created: true,
+					content: {
+						id: '1',
+						name: 'Test',
+						url: 'https://youtube.com/watch?v=test',
+						contentType: 'VIDEO',
+						length: 100,
+						lengthUnits: 'SECONDS',
+						viewCount: 1000,
+						likeCount: 100,
+						commentCount: 50,
+						createdAt: '2024-01-01',
+					},
 				},
 			};
 			expect(response).toBeDefined();
@@ -163,6 +166,11 @@ describe('GraphQL query definitions', () => {
 			expect(CREATE_CONTENT_FROM_YOUTUBE).toContain('createContentFromYouTube(input: $input)');
 		});
 
+		it('requests the created flag and content payload', () => {
+			expect(CREATE_CONTENT_FROM_YOUTUBE).toContain('created');
+			expect(CREATE_CONTENT_FROM_YOUTUBE).toContain('content {');
+		});
+
 		it('requests essential content fields', () => {
 			expect(CREATE_CONTENT_FROM_YOUTUBE).toContain('id');
 			expect(CREATE_CONTENT_FROM_YOUTUBE).toContain('name');